@@ -76,6 +76,9 @@ type LinoBlockchain struct {
 
 	// global param
 	paramHolder param.ParamHolder
+
+	// upgrade handlers by protocol upgrade name
+	upgradeHandlers map[string]UpgradeHandler
 }
 
 // NewLinoBlockchain - create a Lino Blockchain instance
@@ -99,6 +102,7 @@ func NewLinoBlockchain(
 		CapKeyParamStore:      sdk.NewKVStoreKey(types.ParamKVStoreKey),
 		CapKeyProposalStore:   sdk.NewKVStoreKey(types.ProposalKVStoreKey),
 		CapKeyReputationStore: sdk.NewKVStoreKey(types.ReputationKVStoreKey),
+		upgradeHandlers:       map[string]UpgradeHandler{},
 	}
 	lb.paramHolder = param.NewParamHolder(lb.CapKeyParamStore)
 	lb.accountManager = acc.NewAccountManager(lb.CapKeyAccountStore, lb.paramHolder)
@@ -112,6 +116,7 @@ func NewLinoBlockchain(
 	lb.infraManager = infra.NewInfraManager(lb.CapKeyInfraStore, lb.paramHolder)
 	lb.developerManager = developer.NewDeveloperManager(lb.CapKeyDeveloperStore, lb.paramHolder)
 	lb.proposalManager = proposal.NewProposalManager(lb.CapKeyProposalStore, lb.paramHolder)
+	lb.registerUpgradeHandlers()
 
 	lb.Router().
 		AddRoute(types.AccountRouterName, acc.NewHandler(lb.accountManager, lb.globalManager)).
@@ -197,6 +202,8 @@ func registerEvent(cdc *wire.Codec) {
 	cdc.RegisterConcrete(acc.ReturnCoinEvent{}, "lino/eventReturn", nil)
 	cdc.RegisterConcrete(param.ChangeParamEvent{}, "lino/eventCpe", nil)
 	cdc.RegisterConcrete(proposal.DecideProposalEvent{}, "lino/eventDpe", nil)
+	cdc.RegisterConcrete(proposal.ExpireProposalDepositEvent{}, "lino/eventEpde", nil)
+	cdc.RegisterConcrete(post.SubscriptionEvent{}, "lino/eventSubscription", nil)
 }
 
 // custom logic for lino blockchain initialization
@@ -366,6 +373,9 @@ func (lb *LinoBlockchain) toAppInfra(
 
 // init process for a block, execute time events and fire incompetent validators
 func (lb *LinoBlockchain) beginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	// run store migrations before any state transition at the upgrade height
+	lb.applyUpgrade(ctx)
+
 	chainStartTime, err := lb.globalManager.GetChainStartTime(ctx)
 	if err != nil {
 		panic(err)
@@ -389,10 +399,19 @@ func (lb *LinoBlockchain) beginBlocker(ctx sdk.Context, req abci.RequestBeginBlo
 	}
 
 	tags := global.BeginBlocker(ctx, req, lb.globalManager)
-	actualPenalty := val.BeginBlocker(ctx, req, lb.valManager)
+	actualPenalty, slashes := val.BeginBlocker(ctx, req, lb.valManager)
+	for _, slash := range slashes {
+		slashedCoin, err := vote.SlashDelegations(
+			ctx, slash.Validator, slash.SlashRate, lb.voteManager,
+			lb.globalManager, lb.accountManager, lb.reputationManager)
+		if err != nil {
+			panic(err)
+		}
+		actualPenalty = actualPenalty.Plus(slashedCoin)
+	}
 
-	// add coins back to inflation pool
-	if err := lb.globalManager.AddToValidatorInflationPool(ctx, actualPenalty); err != nil {
+	// penalties and slashed delegations fund the community pool
+	if err := lb.globalManager.AddToCommunityPool(ctx, actualPenalty); err != nil {
 		panic(err)
 	}
 
@@ -436,12 +455,23 @@ func (lb *LinoBlockchain) executeEvents(ctx sdk.Context, eventList []types.Event
 			if err := e.Execute(ctx, lb.accountManager); err != nil {
 				panic(err)
 			}
+		case post.SubscriptionEvent:
+			if err := e.Execute(
+				ctx, lb.postManager, lb.accountManager, lb.globalManager,
+				lb.reputationManager); err != nil {
+				panic(err)
+			}
 		case proposal.DecideProposalEvent:
 			if err := e.Execute(
 				ctx, lb.voteManager, lb.valManager, lb.accountManager, lb.proposalManager,
 				lb.postManager, lb.globalManager); err != nil {
 				panic(err)
 			}
+		case proposal.ExpireProposalDepositEvent:
+			if err := e.Execute(
+				ctx, lb.accountManager, lb.proposalManager, lb.postManager, lb.globalManager); err != nil {
+				panic(err)
+			}
 		case param.ChangeParamEvent:
 			if err := e.Execute(ctx, lb.paramHolder); err != nil {
 				panic(err)
@@ -520,21 +550,87 @@ func (lb *LinoBlockchain) distributeInflationToValidator(ctx sdk.Context) {
 	if err != nil {
 		panic(err)
 	}
-	// give inflation to each validator evenly
-	for i, validator := range lst.OncallValidators {
+	valParam, err := lb.paramHolder.GetValidatorParam(ctx)
+	if err != nil {
+		panic(err)
+	}
+	// online standby validators share a small part of inflation evenly
+	standbyValidators, err := lb.valManager.GetOnlineStandbyValidators(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if len(standbyValidators) > 0 {
+		standbyInflation := types.RatToCoin(coin.ToRat().Mul(valParam.StandbyInflationRate))
+		lb.distributeInflationEvenly(ctx, standbyValidators, standbyInflation)
+		coin = coin.Minus(standbyInflation)
+	}
+
+	if valParam.WeightedInflation {
+		lb.distributeWeightedInflationToValidator(ctx, coin)
+	} else {
+		lb.distributeInflationEvenly(ctx, lst.OncallValidators, coin)
+	}
+	// uptime of next hourly inflation is counted from now on
+	if err := lb.valManager.ResetInflationPeriod(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// give inflation to each validator evenly
+func (lb *LinoBlockchain) distributeInflationEvenly(
+	ctx sdk.Context, validators []types.AccountKey, coin types.Coin) {
+	for i, validator := range validators {
 		var ratPerValidator sdk.Rat
 		if ctx.BlockHeader().Height > types.LinoBlockchainFirstUpdateHeight {
-			ratPerValidator = coin.ToRat().Quo(sdk.NewRat(int64(len(lst.OncallValidators) - i)))
+			ratPerValidator = coin.ToRat().Quo(sdk.NewRat(int64(len(validators) - i)))
 		} else {
-			ratPerValidator = coin.ToRat().Quo(sdk.NewRat(int64(len(lst.OncallValidators) - i))).Round(types.PrecisionFactor)
+			ratPerValidator = coin.ToRat().Quo(sdk.NewRat(int64(len(validators) - i))).Round(types.PrecisionFactor)
 		}
 		coinPerValidator := types.RatToCoin(ratPerValidator)
-		lb.accountManager.AddSavingCoin(
-			ctx, validator, coinPerValidator, "", "", types.ValidatorInflation)
+		lb.payValidatorInflation(ctx, validator, coinPerValidator)
 		coin = coin.Minus(coinPerValidator)
 	}
 }
 
+// give inflation to each validator by deposit and uptime in the hour,
+// unearned inflation goes back to validator inflation pool
+func (lb *LinoBlockchain) distributeWeightedInflationToValidator(ctx sdk.Context, coin types.Coin) {
+	inflations, unearned, err := lb.valManager.GetWeightedInflation(ctx, coin)
+	if err != nil {
+		panic(err)
+	}
+	for _, inflation := range inflations {
+		lb.payValidatorInflation(ctx, inflation.Validator, inflation.Inflation)
+	}
+	if unearned.IsPositive() {
+		if err := lb.globalManager.ReturnValidatorInflation(ctx, unearned); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func (lb *LinoBlockchain) payValidatorInflation(ctx sdk.Context, validator types.AccountKey, coin types.Coin) {
+	// validator keeps commission, the rest is claimed by its delegators,
+	// validator commission rate takes precedence over voter commission rate
+	// which can't be updated once the voter becomes validator
+	commissionRate, hasCommission, err := lb.valManager.GetCommissionRate(ctx, validator)
+	if err != nil {
+		panic(err)
+	}
+	var commission types.Coin
+	if hasCommission {
+		commission, err = lb.voteManager.DistributeIncomeWithCommissionRate(
+			ctx, validator, coin, commissionRate)
+	} else {
+		commission, err = lb.voteManager.DistributeIncome(ctx, validator, coin)
+	}
+	if err != nil {
+		panic(err)
+	}
+	lb.accountManager.AddSavingCoin(
+		ctx, validator, commission, "", "", types.ValidatorInflation)
+}
+
 // distribute inflation to infra provider monthly
 // TODO: encaptulate module event inside module
 func (lb *LinoBlockchain) distributeInflationToInfraProvider(ctx sdk.Context) {
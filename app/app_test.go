@@ -20,6 +20,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/lino-network/lino/param"
+	acc "github.com/lino-network/lino/x/account"
 	devModel "github.com/lino-network/lino/x/developer/model"
 	globalModel "github.com/lino-network/lino/x/global/model"
 	infraModel "github.com/lino-network/lino/x/infra/model"
@@ -199,6 +200,7 @@ func TestGenesisFromConfig(t *testing.T) {
 			ContentCreatorAllocation: sdk.NewRat(65, 100),
 			DeveloperAllocation:      sdk.NewRat(10, 100),
 			ValidatorAllocation:      sdk.NewRat(5, 100),
+			CommunityPoolAllocation:  sdk.NewRat(0, 100),
 		},
 		param.InfraInternalAllocationParam{
 			StorageAllocation: sdk.NewRat(50, 100),
@@ -210,6 +212,10 @@ func TestGenesisFromConfig(t *testing.T) {
 			VoterCoinReturnTimes:           int64(7),
 			DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 			DelegatorCoinReturnTimes:       int64(7),
+			RedelegateIntervalSec:          int64(24 * 3600),
+			DefaultCommissionRate:          sdk.NewRat(10, 100),
+			MaxCommissionChangeRate:        sdk.NewRat(5, 100),
+			CommissionUpdateIntervalSec:    int64(24 * 3600),
 		},
 		param.ProposalParam{
 			ContentCensorshipDecideSec:  int64(24 * 7 * 3600),
@@ -226,6 +232,17 @@ func TestGenesisFromConfig(t *testing.T) {
 			ProtocolUpgradePassRatio:  sdk.NewRat(80, 100),
 			ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 			ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+
+			DepositPeriodSec:          int64(24 * 7 * 3600),
+			InitialDepositRatio:       sdk.NewRat(1, 1),
+			DepositForfeitQuorumRatio: sdk.NewRat(10, 100),
+			DepositForfeitVetoRatio:   sdk.NewRat(33, 100),
+			DepositForfeitToValidator: false,
+
+			ContentCensorshipQuorumRatio: sdk.NewRat(10, 100),
+			ChangeParamQuorumRatio:       sdk.NewRat(20, 100),
+			ProtocolUpgradeQuorumRatio:   sdk.NewRat(30, 100),
+			VetoRatio:                    sdk.NewRat(33, 100),
 		},
 		param.DeveloperParam{
 			DeveloperMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
@@ -243,6 +260,16 @@ func TestGenesisFromConfig(t *testing.T) {
 			PenaltyByzantine:               types.NewCoinFromInt64(1000000 * types.Decimals),
 			ValidatorListSize:              int64(21),
 			AbsentCommitLimitation:         int64(600), // 10min
+			SlashDelegators:                false,
+			AbsentCommitWindow:             int64(1200), // 1h
+			JailDurationSec:                int64(24 * 3600),
+			MaxJailDurationSec:             int64(30 * 24 * 3600),
+			KeyUnbondingSec:                int64(7 * 24 * 3600),
+			WeightedInflation:              false,
+			StandbyListSize:                int64(21),
+			StandbyInflationRate:           sdk.NewRat(5, 100),
+			HeartbeatIntervalSec:           int64(3600),
+			CommissionUpdateIntervalSec:    int64(24 * 3600),
 		},
 		param.CoinDayParam{
 			SecondsToRecoverCoinDay: int64(7 * 24 * 3600),
@@ -262,6 +289,9 @@ func TestGenesisFromConfig(t *testing.T) {
 			ReportOrUpvoteIntervalSec: 24 * 3600,
 			PostIntervalSec:           600,
 			MaxReportReputation:       types.NewCoinFromInt64(100 * types.Decimals),
+			SubscriptionIntervalSec:   30 * 24 * 3600,
+			HideReportCoinDay:         types.NewCoinFromInt64(100000 * types.Decimals),
+			AppealPeriodSec:           7 * 24 * 3600,
 		},
 		param.ReputationParam{
 			BestContentIndexN: 10,
@@ -862,3 +892,103 @@ func TestGlobalTime(t *testing.T) {
 		assert.Equal(t, cs.expectLastBlockTime, lastBlockTime)
 	}
 }
+
+func TestApplyUpgrade(t *testing.T) {
+	lb := newLinoBlockchain(t, 21)
+	ctx := lb.BaseApp.NewContext(true, abci.Header{Height: 1})
+
+	// no upgrade scheduled
+	assert.NotPanics(t, func() { lb.applyUpgrade(ctx) })
+
+	p := lb.proposalManager.CreateProtocolUpgradeProposal(ctx, "v2", 10, "link", "")
+	proposalID, err := lb.proposalManager.AddProposal(ctx, types.AccountKey(user1), p, 10)
+	assert.Nil(t, err)
+	_, err = lb.proposalManager.UpdateProposalStatus(
+		ctx, types.ProtocolUpgrade, proposalID, types.NewCoinFromInt64(0))
+	assert.Nil(t, err)
+	err = lb.proposalManager.ScheduleUpgrade(ctx, proposalID)
+	assert.Nil(t, err)
+
+	// before upgrade height
+	ctx = lb.BaseApp.NewContext(true, abci.Header{Height: 9})
+	assert.NotPanics(t, func() { lb.applyUpgrade(ctx) })
+
+	// binary doesn't have upgrade handler
+	ctx = lb.BaseApp.NewContext(true, abci.Header{Height: 10})
+	assert.Panics(t, func() { lb.applyUpgrade(ctx) })
+
+	applied := false
+	lb.SetUpgradeHandler("v2", func(ctx sdk.Context) sdk.Error {
+		applied = true
+		return nil
+	})
+	assert.NotPanics(t, func() { lb.applyUpgrade(ctx) })
+	assert.True(t, applied)
+
+	plan, err := lb.proposalManager.GetUpgradePlan(ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, plan)
+}
+
+func TestStoreMigrationUpgrade(t *testing.T) {
+	lb := newLinoBlockchain(t, 21)
+	ctx := lb.BaseApp.NewContext(true, abci.Header{Height: 1})
+
+	p := lb.proposalManager.CreateProtocolUpgradeProposal(ctx, StoreMigrationUpgrade, 10, "link", "")
+	proposalID, err := lb.proposalManager.AddProposal(ctx, types.AccountKey(user1), p, 10)
+	assert.Nil(t, err)
+	_, err = lb.proposalManager.UpdateProposalStatus(
+		ctx, types.ProtocolUpgrade, proposalID, types.NewCoinFromInt64(0))
+	assert.Nil(t, err)
+	err = lb.proposalManager.ScheduleUpgrade(ctx, proposalID)
+	assert.Nil(t, err)
+
+	// coin return scheduled before pending returns were tracked
+	baseTime := int64(1000)
+	ctx = lb.BaseApp.NewContext(true, abci.Header{Height: 1, Time: time.Unix(baseTime, 0)})
+	legacyEvents, err := acc.CreateCoinReturnEvents(
+		ctx, types.AccountKey(user1), 2, 100, types.NewCoinFromInt64(200), types.VoteReturnCoin)
+	assert.Nil(t, err)
+	err = lb.globalManager.RegisterCoinReturnEvent(ctx, legacyEvents, 2, 100)
+	assert.Nil(t, err)
+	// coin return which is already tracked
+	trackedEvents, err := lb.accountManager.CreatePendingCoinReturnEvents(
+		ctx, types.AccountKey(user1), 1, 100, types.NewCoinFromInt64(50), types.DelegationReturnCoin)
+	assert.Nil(t, err)
+	err = lb.globalManager.RegisterCoinReturnEvent(ctx, trackedEvents, 1, 100)
+	assert.Nil(t, err)
+
+	// handler shipped with this binary applies the upgrade without halting
+	ctx = lb.BaseApp.NewContext(true, abci.Header{Height: 10, Time: time.Unix(baseTime, 0)})
+	assert.NotPanics(t, func() { lb.applyUpgrade(ctx) })
+	plan, err := lb.proposalManager.GetUpgradePlan(ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, plan)
+
+	voteParam, err := lb.paramHolder.GetVoteParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, sdk.NewRat(10, 100), voteParam.DefaultCommissionRate)
+
+	pendingReturns, err := lb.accountManager.GetPendingReturns(ctx, types.AccountKey(user1))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(pendingReturns))
+	assert.Equal(t, types.DelegationReturnCoin, pendingReturns[0].ReturnType)
+	for i, pendingReturn := range pendingReturns[1:] {
+		assert.Equal(t, types.VoteReturnCoin, pendingReturn.ReturnType)
+		assert.Equal(t, types.NewCoinFromInt64(100), pendingReturn.Remaining)
+		assert.Equal(t, baseTime+100*int64(i+1), pendingReturn.NextReturnAt)
+		assert.Equal(t, int64(1), pendingReturn.RemainingTimes)
+	}
+	for _, unixTime := range []int64{baseTime + 100, baseTime + 200} {
+		for _, event := range lb.globalManager.GetTimeEventListAtTime(ctx, unixTime).Events {
+			assert.NotEqual(t, int64(0), event.(acc.ReturnCoinEvent).ReturnID)
+		}
+	}
+
+	// pending return is removed once its event is executed
+	err = lb.executeEvents(ctx, lb.globalManager.GetTimeEventListAtTime(ctx, baseTime+200).Events)
+	assert.Nil(t, err)
+	pendingReturns, err = lb.accountManager.GetPendingReturns(ctx, types.AccountKey(user1))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(pendingReturns))
+}
@@ -160,6 +160,7 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				ContentCreatorAllocation: sdk.NewRat(65, 100),
 				DeveloperAllocation:      sdk.NewRat(10, 100),
 				ValidatorAllocation:      sdk.NewRat(5, 100),
+				CommunityPoolAllocation:  sdk.NewRat(0, 100),
 			},
 			param.InfraInternalAllocationParam{
 				StorageAllocation: sdk.NewRat(50, 100),
@@ -171,6 +172,10 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				VoterCoinReturnTimes:           int64(7),
 				DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 				DelegatorCoinReturnTimes:       int64(7),
+				RedelegateIntervalSec:          int64(24 * 3600),
+				DefaultCommissionRate:          sdk.NewRat(10, 100),
+				MaxCommissionChangeRate:        sdk.NewRat(5, 100),
+				CommissionUpdateIntervalSec:    int64(24 * 3600),
 			},
 			param.ProposalParam{
 				ContentCensorshipDecideSec:  int64(24 * 7 * 3600),
@@ -187,6 +192,17 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				ProtocolUpgradePassRatio:  sdk.NewRat(80, 100),
 				ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 				ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+
+				DepositPeriodSec:          int64(24 * 7 * 3600),
+				InitialDepositRatio:       sdk.NewRat(1, 1),
+				DepositForfeitQuorumRatio: sdk.NewRat(10, 100),
+				DepositForfeitVetoRatio:   sdk.NewRat(33, 100),
+				DepositForfeitToValidator: false,
+
+				ContentCensorshipQuorumRatio: sdk.NewRat(10, 100),
+				ChangeParamQuorumRatio:       sdk.NewRat(20, 100),
+				ProtocolUpgradeQuorumRatio:   sdk.NewRat(30, 100),
+				VetoRatio:                    sdk.NewRat(33, 100),
 			},
 			param.DeveloperParam{
 				DeveloperMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
@@ -204,6 +220,16 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				PenaltyByzantine:               types.NewCoinFromInt64(1000000 * types.Decimals),
 				ValidatorListSize:              int64(21),
 				AbsentCommitLimitation:         int64(600), // 10min
+				SlashDelegators:                false,
+				AbsentCommitWindow:             int64(1200), // 1h
+				JailDurationSec:                int64(24 * 3600),
+				MaxJailDurationSec:             int64(30 * 24 * 3600),
+				KeyUnbondingSec:                int64(7 * 24 * 3600),
+				WeightedInflation:              false,
+				StandbyListSize:                int64(21),
+				StandbyInflationRate:           sdk.NewRat(5, 100),
+				HeartbeatIntervalSec:           int64(3600),
+				CommissionUpdateIntervalSec:    int64(24 * 3600),
 			},
 			param.CoinDayParam{
 				SecondsToRecoverCoinDay: int64(7 * 24 * 3600),
@@ -223,6 +249,9 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				ReportOrUpvoteIntervalSec: 24 * 3600,
 				PostIntervalSec:           600,
 				MaxReportReputation:       types.NewCoinFromInt64(100 * types.Decimals),
+				SubscriptionIntervalSec:   30 * 24 * 3600,
+				HideReportCoinDay:         types.NewCoinFromInt64(100000 * types.Decimals),
+				AppealPeriodSec:           7 * 24 * 3600,
 			},
 			param.ReputationParam{
 				BestContentIndexN: 10,
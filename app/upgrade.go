@@ -0,0 +1,99 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/lino-network/lino/types"
+	acc "github.com/lino-network/lino/x/account"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StoreMigrationUpgrade - name of protocol upgrade which migrates state stored
+// before new parameters and indexes were added
+const StoreMigrationUpgrade = "store-migration"
+
+// UpgradeHandler - migrate store state at the height of a scheduled protocol upgrade
+type UpgradeHandler func(ctx sdk.Context) sdk.Error
+
+// SetUpgradeHandler - register upgrade handler under the name used by protocol upgrade proposal,
+// must be called before the chain reaches the upgrade height
+func (lb *LinoBlockchain) SetUpgradeHandler(name string, handler UpgradeHandler) {
+	if _, exist := lb.upgradeHandlers[name]; exist {
+		panic(fmt.Sprintf("upgrade handler %s is already registered", name))
+	}
+	lb.upgradeHandlers[name] = handler
+}
+
+// registerUpgradeHandlers - upgrade handlers shipped with this binary
+func (lb *LinoBlockchain) registerUpgradeHandlers() {
+	lb.SetUpgradeHandler(StoreMigrationUpgrade, lb.migrateStore)
+}
+
+// migrateStore - set default value to new parameters and build indexes for existing records
+func (lb *LinoBlockchain) migrateStore(ctx sdk.Context) sdk.Error {
+	if err := lb.paramHolder.MigrateParam(ctx); err != nil {
+		return err
+	}
+	if err := lb.postManager.RebuildPostIndexes(ctx); err != nil {
+		return err
+	}
+	// relationships stored before donation amount was tracked are backfilled from post donations
+	donationAmounts, err := lb.postManager.GetDonationAmounts(ctx)
+	if err != nil {
+		return err
+	}
+	if err := lb.accountManager.RebuildSupporterRanks(ctx, donationAmounts); err != nil {
+		return err
+	}
+	if err := lb.voteManager.RebuildVoteHistory(ctx); err != nil {
+		return err
+	}
+	// coin return events scheduled before pending returns were tracked are indexed one event each
+	return lb.globalManager.UpdateTimeEvents(
+		ctx, func(unixTime int64, event types.Event) (types.Event, sdk.Error) {
+			return lb.trackScheduledCoinReturn(ctx, unixTime, event)
+		})
+}
+
+// trackScheduledCoinReturn - link an untracked coin return event to a new pending return of its user
+func (lb *LinoBlockchain) trackScheduledCoinReturn(
+	ctx sdk.Context, unixTime int64, event types.Event) (types.Event, sdk.Error) {
+	returnEvent, ok := event.(acc.ReturnCoinEvent)
+	if !ok || returnEvent.ReturnID != 0 {
+		return event, nil
+	}
+	returnID, err := lb.accountManager.AddScheduledPendingReturn(
+		ctx, returnEvent.Username, returnEvent.ReturnType, returnEvent.Amount, unixTime)
+	if err != nil {
+		return nil, err
+	}
+	returnEvent.ReturnID = returnID
+	return returnEvent, nil
+}
+
+// apply scheduled upgrade at upgrade height, halt if this binary doesn't know the upgrade
+func (lb *LinoBlockchain) applyUpgrade(ctx sdk.Context) {
+	plan, err := lb.proposalManager.GetUpgradePlan(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if plan == nil || ctx.BlockHeader().Height < plan.Height {
+		return
+	}
+
+	handler, exist := lb.upgradeHandlers[plan.Name]
+	if !exist {
+		msg := fmt.Sprintf(
+			"UPGRADE %s NEEDED at height %d, proposal %s", plan.Name, plan.Height, plan.ProposalID)
+		ctx.Logger().Error(msg)
+		panic(msg)
+	}
+	if err := handler(ctx); err != nil {
+		panic(err)
+	}
+	if err := lb.proposalManager.ClearUpgradePlan(ctx); err != nil {
+		panic(err)
+	}
+	ctx.Logger().Info(fmt.Sprintf("applied upgrade %s at height %d", plan.Name, ctx.BlockHeader().Height))
+}
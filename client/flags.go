@@ -52,12 +52,37 @@ const (
 	FlagSourceAuthor            = "source-author"
 	FlagSourcePostID            = "source-post-ID"
 	FlagRedistributionSplitRate = "redistribution-split-rate"
+	FlagSubscriber              = "subscriber"
+	FlagCreator                 = "creator"
+	FlagPeriods                 = "periods"
+	FlagTags                    = "tags"
+	FlagCursor                  = "cursor"
+	FlagLimit                   = "limit"
+	FlagComment                 = "comment"
+	FlagSkipDeleted             = "skip-deleted"
 
 	// Vote
-	FlagVoter      = "voter"
-	FlagProposalID = "proposal-id"
-	FlagResult     = "result"
-	FlagLink       = "link"
+	FlagVoter          = "voter"
+	FlagDstVoter       = "dst-voter"
+	FlagCommissionRate = "commission-rate"
+	FlagProposalID     = "proposal-id"
+	FlagResult         = "result"
+	FlagAbstain        = "abstain"
+	FlagVeto           = "veto"
+	FlagDepositor      = "depositor"
+	FlagLink           = "link"
+	FlagUpgradeName    = "upgrade-name"
+	FlagUpgradeHeight  = "upgrade-height"
+	FlagReason         = "reason"
+	FlagParamFile      = "param-file"
+
+	// Validator
+	FlagPrivValidatorFile       = "priv-validator-file"
+	FlagMoniker                 = "moniker"
+	FlagDetails                 = "details"
+	FlagIdentity                = "identity"
+	FlagMaxCommissionRate       = "max-commission-rate"
+	FlagMaxCommissionChangeRate = "max-commission-change-rate"
 )
 
 // LineBreak can be included in a command list to provide a blank line
@@ -0,0 +1,38 @@
+package client
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PageIndex selects a page of values from an ordered index returned by
+// a subspace query, from the largest key to the smallest. Cursor is the key
+// suffix after prefix, page starts right after cursor and an empty cursor
+// starts from the largest key. Values matched by skip are not counted into the
+// page. Returned cursor is used to get next page, it's empty if there is no more value.
+func PageIndex(
+	kvs []sdk.KVPair, prefix []byte, cursor string, limit int,
+	skip func(value []byte) (bool, error)) ([][]byte, string, error) {
+	values := [][]byte{}
+	nextCursor := ""
+	for i := len(kvs) - 1; i >= 0; i-- {
+		key := string(kvs[i].Key[len(prefix):])
+		if cursor != "" && key >= cursor {
+			continue
+		}
+		if skip != nil {
+			skipped, err := skip(kvs[i].Value)
+			if err != nil {
+				return nil, "", err
+			}
+			if skipped {
+				continue
+			}
+		}
+		if limit > 0 && len(values) == limit {
+			return values, nextCursor, nil
+		}
+		values = append(values, kvs[i].Value)
+		nextCursor = key
+	}
+	return values, "", nil
+}
@@ -0,0 +1,79 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestPageIndex(t *testing.T) {
+	prefix := []byte("index/")
+	kvs := []sdk.KVPair{
+		{Key: []byte("index/100"), Value: []byte("p1")},
+		{Key: []byte("index/200"), Value: []byte("p2")},
+		{Key: []byte("index/300"), Value: []byte("p3")},
+	}
+	skipP2 := func(value []byte) (bool, error) { return string(value) == "p2", nil }
+
+	testCases := []struct {
+		testName     string
+		kvs          []sdk.KVPair
+		cursor       string
+		limit        int
+		skip         func([]byte) (bool, error)
+		expectValues [][]byte
+		expectCursor string
+	}{
+		{
+			testName:     "empty index",
+			kvs:          nil,
+			expectValues: [][]byte{},
+			expectCursor: "",
+		},
+		{
+			testName:     "no limit",
+			kvs:          kvs,
+			expectValues: [][]byte{[]byte("p3"), []byte("p2"), []byte("p1")},
+			expectCursor: "",
+		},
+		{
+			testName:     "first page",
+			kvs:          kvs,
+			limit:        2,
+			expectValues: [][]byte{[]byte("p3"), []byte("p2")},
+			expectCursor: "200",
+		},
+		{
+			testName:     "last page",
+			kvs:          kvs,
+			cursor:       "200",
+			limit:        2,
+			expectValues: [][]byte{[]byte("p1")},
+			expectCursor: "",
+		},
+		{
+			testName:     "skipped value is not counted into page",
+			kvs:          kvs,
+			limit:        1,
+			cursor:       "300",
+			skip:         skipP2,
+			expectValues: [][]byte{[]byte("p1")},
+			expectCursor: "",
+		},
+	}
+	for _, tc := range testCases {
+		values, cursor, err := PageIndex(tc.kvs, prefix, tc.cursor, tc.limit, tc.skip)
+		if err != nil {
+			t.Errorf("%s: failed to page index, got err %v", tc.testName, err)
+		}
+		assert.Equal(t, tc.expectValues, values, tc.testName)
+		assert.Equal(t, tc.expectCursor, cursor, tc.testName)
+	}
+
+	skipErr := errors.New("skip error")
+	_, _, err := PageIndex(kvs, prefix, "", 0, func([]byte) (bool, error) { return false, skipErr })
+	assert.Equal(t, skipErr, err)
+}
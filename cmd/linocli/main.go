@@ -100,6 +100,14 @@ func main() {
 		client.PostCommands(
 			postcmd.DonateTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			postcmd.SubscribeTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			postcmd.CancelSubscriptionTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			validatorcmd.DepositValidatorTxCmd(cdc),
@@ -112,6 +120,22 @@ func main() {
 		client.PostCommands(
 			validatorcmd.RevokeTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			validatorcmd.UnjailTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			validatorcmd.RotateKeyTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			validatorcmd.UpdateTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			validatorcmd.HeartbeatTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			delegationcmd.DelegateTxCmd(cdc),
@@ -120,6 +144,14 @@ func main() {
 		client.PostCommands(
 			delegationcmd.WithdrawDelegateTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			delegationcmd.RedelegateTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			delegationcmd.ClaimDelegatorRewardTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.GetCommands(
 			delegatecmd.GetDelegationCmd(types.VoteKVStoreKey, cdc),
@@ -133,6 +165,10 @@ func main() {
 		client.PostCommands(
 			votecmd.WithdrawVoterTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			votecmd.UpdateCommissionTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.GetCommands(
 			votecmd.GetVoterCmd(types.VoteKVStoreKey, cdc),
@@ -146,15 +182,55 @@ func main() {
 		client.GetCommands(
 			proposalcmd.GetExpiredProposalCmd(types.VoteKVStoreKey, cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			proposalcmd.GetUpgradePlanCmd(types.ProposalKVStoreKey, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			proposalcmd.GetPendingProposalCmd(types.ProposalKVStoreKey, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			proposalcmd.GetProposalDepositCmd(types.ProposalKVStoreKey, cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			proposalcmd.VoteProposalTxCmd(cdc),
 		)...)
+	proposalCmd := &cobra.Command{
+		Use:   "proposal",
+		Short: "Proposal subcommands",
+	}
+	proposalCmd.AddCommand(
+		proposalcmd.SubmitProposalCmd(types.ParamKVStoreKey, cdc),
+	)
+	linocliCmd.AddCommand(proposalCmd)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			proposalcmd.WithdrawProposalVoteTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			proposalcmd.DepositProposalTxCmd(cdc),
+		)...)
 
 	linocliCmd.AddCommand(
 		client.GetCommands(
 			votecmd.GetVoteCmd(types.VoteKVStoreKey, cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			votecmd.GetProposalTallyCmd(types.VoteKVStoreKey, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			votecmd.GetProposalVotesCmd(types.VoteKVStoreKey, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			votecmd.GetVoteHistoryCmd(types.VoteKVStoreKey, cdc),
+		)...)
 
 	linocliCmd.AddCommand(
 		client.PostCommands(
@@ -197,6 +273,14 @@ func main() {
 		client.GetCommands(
 			acccmd.GetAccountsCmd(types.AccountKVStoreKey, cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			acccmd.GetSupportersCmd(types.AccountKVStoreKey, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			acccmd.GetPendingReturnsCmd(types.AccountKVStoreKey, cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.GetCommands(
 			postcmd.GetPostCmd(types.PostKVStoreKey, cdc),
@@ -205,6 +289,18 @@ func main() {
 		client.GetCommands(
 			postcmd.GetPostsCmd(types.PostKVStoreKey, cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetSubscriptionsCmd(types.PostKVStoreKey, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetPostsByTagCmd(types.PostKVStoreKey, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetPostDonatorsCmd(types.PostKVStoreKey, cdc),
+		)...)
 
 	linocliCmd.AddCommand(
 		client.GetCommands(
@@ -232,6 +328,14 @@ func main() {
 		client.GetCommands(
 			validatorcmd.GetValidatorCmd(types.ValidatorKVStoreKey, cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			validatorcmd.GetValidatorStatsCmd(types.ValidatorKVStoreKey, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			validatorcmd.GetStandbyValidatorsCmd(types.ValidatorKVStoreKey, cdc),
+		)...)
 
 	// add proxy, version and key info
 	linocliCmd.AddCommand(
@@ -33,6 +33,10 @@ func (cpe ChangeParamEvent) Execute(ctx sdk.Context, ph ParamHolder) sdk.Error {
 		return ph.setAccountParam(ctx, &parameter)
 	case PostParam:
 		return ph.setPostParam(ctx, &parameter)
+	case CoinDayParam:
+		return ph.setCoinDayParam(ctx, &parameter)
+	case ReputationParam:
+		return ph.setReputationParam(ctx, &parameter)
 	default:
 		return ErrInvalidaParameter()
 	}
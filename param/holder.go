@@ -1,6 +1,8 @@
 package param
 
 import (
+	"encoding/json"
+
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/lino-network/lino/types"
 
@@ -52,6 +54,7 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		ContentCreatorAllocation: sdk.NewRat(65, 100),
 		DeveloperAllocation:      sdk.NewRat(10, 100),
 		ValidatorAllocation:      sdk.NewRat(5, 100),
+		CommunityPoolAllocation:  sdk.NewRat(0, 100),
 	}
 	if err := ph.setGlobalAllocationParam(ctx, globalAllocationParam); err != nil {
 		return err
@@ -69,6 +72,9 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		ReportOrUpvoteIntervalSec: 24 * 3600,
 		PostIntervalSec:           600,
 		MaxReportReputation:       types.NewCoinFromInt64(100 * types.Decimals),
+		SubscriptionIntervalSec:   30 * 24 * 3600,
+		HideReportCoinDay:         types.NewCoinFromInt64(100000 * types.Decimals),
+		AppealPeriodSec:           7 * 24 * 3600,
 	}
 	if err := ph.setPostParam(ctx, postParam); err != nil {
 		return err
@@ -106,6 +112,16 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		PenaltyByzantine:               types.NewCoinFromInt64(1000000 * types.Decimals),
 		ValidatorListSize:              int64(21),
 		AbsentCommitLimitation:         int64(600), // 30min
+		SlashDelegators:                false,
+		AbsentCommitWindow:             int64(1200), // 1h
+		JailDurationSec:                int64(24 * 3600),
+		MaxJailDurationSec:             int64(30 * 24 * 3600),
+		KeyUnbondingSec:                int64(7 * 24 * 3600),
+		WeightedInflation:              false,
+		StandbyListSize:                int64(21),
+		StandbyInflationRate:           sdk.NewRat(5, 100),
+		HeartbeatIntervalSec:           int64(3600),
+		CommissionUpdateIntervalSec:    int64(24 * 3600),
 	}
 	if err := ph.setValidatorParam(ctx, validatorParam); err != nil {
 		return err
@@ -117,6 +133,10 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		VoterCoinReturnTimes:           int64(7),
 		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DelegatorCoinReturnTimes:       int64(7),
+		RedelegateIntervalSec:          int64(24 * 3600),
+		DefaultCommissionRate:          sdk.NewRat(10, 100),
+		MaxCommissionChangeRate:        sdk.NewRat(5, 100),
+		CommissionUpdateIntervalSec:    int64(24 * 3600),
 	}
 	if err := ph.setVoteParam(ctx, voteParam); err != nil {
 		return err
@@ -138,6 +158,17 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		ProtocolUpgradePassRatio:  sdk.NewRat(80, 100),
 		ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 		ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+
+		DepositPeriodSec:          int64(7 * 24 * 3600),
+		InitialDepositRatio:       sdk.NewRat(1, 1),
+		DepositForfeitQuorumRatio: sdk.NewRat(10, 100),
+		DepositForfeitVetoRatio:   sdk.NewRat(33, 100),
+		DepositForfeitToValidator: false,
+
+		ContentCensorshipQuorumRatio: sdk.NewRat(10, 100),
+		ChangeParamQuorumRatio:       sdk.NewRat(20, 100),
+		ProtocolUpgradeQuorumRatio:   sdk.NewRat(30, 100),
+		VetoRatio:                    sdk.NewRat(33, 100),
 	}
 	if err := ph.setProposalParam(ctx, proposalParam); err != nil {
 		return err
@@ -269,6 +300,7 @@ func (ph ParamHolder) GetGlobalAllocationParam(
 	if err := ph.cdc.UnmarshalJSON(allocationBytes, allocation); err != nil {
 		return nil, ErrFailedToUnmarshalGlobalAllocationParam(err)
 	}
+	fillGlobalAllocationParam(allocation, storedFields(allocationBytes))
 	return allocation, nil
 }
 
@@ -298,6 +330,7 @@ func (ph ParamHolder) GetPostParam(ctx sdk.Context) (*PostParam, sdk.Error) {
 	if err := ph.cdc.UnmarshalJSON(paramBytes, param); err != nil {
 		return nil, ErrFailedToUnmarshalPostParam(err)
 	}
+	fillPostParam(param, storedFields(paramBytes))
 	return param, nil
 }
 
@@ -326,6 +359,7 @@ func (ph ParamHolder) GetVoteParam(ctx sdk.Context) (*VoteParam, sdk.Error) {
 	if err := ph.cdc.UnmarshalJSON(paramBytes, param); err != nil {
 		return nil, ErrFailedToUnmarshalVoteParam(err)
 	}
+	fillVoteParam(param, storedFields(paramBytes))
 	return param, nil
 }
 
@@ -340,6 +374,7 @@ func (ph ParamHolder) GetProposalParam(ctx sdk.Context) (*ProposalParam, sdk.Err
 	if err := ph.cdc.UnmarshalJSON(paramBytes, param); err != nil {
 		return nil, ErrFailedToUnmarshalProposalParam(err)
 	}
+	fillProposalParam(param, storedFields(paramBytes))
 	return param, nil
 }
 
@@ -354,6 +389,7 @@ func (ph ParamHolder) GetValidatorParam(ctx sdk.Context) (*ValidatorParam, sdk.E
 	if err := ph.cdc.UnmarshalJSON(paramBytes, param); err != nil {
 		return nil, ErrFailedToUnmarshalValidatorParam(err)
 	}
+	fillValidatorParam(param, storedFields(paramBytes))
 	return param, nil
 }
 
@@ -439,6 +475,146 @@ func (ph ParamHolder) UpdateGlobalGrowthRate(ctx sdk.Context, growthRate sdk.Rat
 	return nil
 }
 
+// MigrateParam - store default value of parameters added after genesis, getters
+// already fill in missing parameters so that they are safe to use before migration
+func (ph ParamHolder) MigrateParam(ctx sdk.Context) sdk.Error {
+	globalAllocationParam, err := ph.GetGlobalAllocationParam(ctx)
+	if err != nil {
+		return err
+	}
+	if err := ph.setGlobalAllocationParam(ctx, globalAllocationParam); err != nil {
+		return err
+	}
+	postParam, err := ph.GetPostParam(ctx)
+	if err != nil {
+		return err
+	}
+	if err := ph.setPostParam(ctx, postParam); err != nil {
+		return err
+	}
+	validatorParam, err := ph.GetValidatorParam(ctx)
+	if err != nil {
+		return err
+	}
+	if err := ph.setValidatorParam(ctx, validatorParam); err != nil {
+		return err
+	}
+	voteParam, err := ph.GetVoteParam(ctx)
+	if err != nil {
+		return err
+	}
+	if err := ph.setVoteParam(ctx, voteParam); err != nil {
+		return err
+	}
+	proposalParam, err := ph.GetProposalParam(ctx)
+	if err != nil {
+		return err
+	}
+	return ph.setProposalParam(ctx, proposalParam)
+}
+
+// storedFields - json field names of stored parameter, fields added after the
+// parameter was stored are missing. Missing field can't be told from zero value
+// after unmarshal, since zero is a valid value for some parameters
+func storedFields(paramBytes []byte) map[string]bool {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(paramBytes, &fields); err != nil {
+		return map[string]bool{}
+	}
+	res := map[string]bool{}
+	for field := range fields {
+		res[field] = true
+	}
+	return res
+}
+
+func fillGlobalAllocationParam(param *GlobalAllocationParam, fields map[string]bool) {
+	if !fields["community_pool_allocation"] {
+		param.CommunityPoolAllocation = sdk.NewRat(0, 100)
+	}
+}
+
+func fillPostParam(param *PostParam, fields map[string]bool) {
+	if !fields["subscription_interval_sec"] {
+		param.SubscriptionIntervalSec = 30 * 24 * 3600
+	}
+	if !fields["hide_report_coin_day"] {
+		param.HideReportCoinDay = types.NewCoinFromInt64(100000 * types.Decimals)
+	}
+	if !fields["appeal_period_sec"] {
+		param.AppealPeriodSec = 7 * 24 * 3600
+	}
+}
+
+func fillValidatorParam(param *ValidatorParam, fields map[string]bool) {
+	if !fields["absent_commit_window"] {
+		param.AbsentCommitWindow = int64(1200)
+	}
+	if !fields["jail_duration_second"] {
+		param.JailDurationSec = int64(24 * 3600)
+	}
+	if !fields["max_jail_duration_second"] {
+		param.MaxJailDurationSec = int64(30 * 24 * 3600)
+	}
+	if !fields["key_unbonding_second"] {
+		param.KeyUnbondingSec = int64(7 * 24 * 3600)
+	}
+	if !fields["standby_list_size"] {
+		param.StandbyListSize = int64(21)
+	}
+	if !fields["standby_inflation_rate"] {
+		param.StandbyInflationRate = sdk.NewRat(5, 100)
+	}
+	if !fields["heartbeat_interval_second"] {
+		param.HeartbeatIntervalSec = int64(3600)
+	}
+	if !fields["commission_update_interval_second"] {
+		param.CommissionUpdateIntervalSec = int64(24 * 3600)
+	}
+}
+
+func fillVoteParam(param *VoteParam, fields map[string]bool) {
+	if !fields["redelegate_interval_second"] {
+		param.RedelegateIntervalSec = int64(24 * 3600)
+	}
+	if !fields["default_commission_rate"] {
+		param.DefaultCommissionRate = sdk.NewRat(10, 100)
+	}
+	if !fields["max_commission_change_rate"] {
+		param.MaxCommissionChangeRate = sdk.NewRat(5, 100)
+	}
+	if !fields["commission_update_interval_second"] {
+		param.CommissionUpdateIntervalSec = int64(24 * 3600)
+	}
+}
+
+func fillProposalParam(param *ProposalParam, fields map[string]bool) {
+	if !fields["deposit_period_second"] {
+		param.DepositPeriodSec = int64(7 * 24 * 3600)
+	}
+	if !fields["initial_deposit_ratio"] {
+		param.InitialDepositRatio = sdk.NewRat(1, 1)
+	}
+	if !fields["deposit_forfeit_quorum_ratio"] {
+		param.DepositForfeitQuorumRatio = sdk.NewRat(10, 100)
+	}
+	if !fields["deposit_forfeit_veto_ratio"] {
+		param.DepositForfeitVetoRatio = sdk.NewRat(33, 100)
+	}
+	if !fields["content_censorship_quorum_ratio"] {
+		param.ContentCensorshipQuorumRatio = sdk.NewRat(10, 100)
+	}
+	if !fields["change_param_quorum_ratio"] {
+		param.ChangeParamQuorumRatio = sdk.NewRat(20, 100)
+	}
+	if !fields["protocol_upgrade_quorum_ratio"] {
+		param.ProtocolUpgradeQuorumRatio = sdk.NewRat(30, 100)
+	}
+	if !fields["veto_ratio"] {
+		param.VetoRatio = sdk.NewRat(33, 100)
+	}
+}
+
 func (ph ParamHolder) setValidatorParam(ctx sdk.Context, param *ValidatorParam) sdk.Error {
 	store := ctx.KVStore(ph.key)
 	paramBytes, err := ph.cdc.MarshalJSON(*param)
@@ -1,6 +1,7 @@
 package param
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/store"
@@ -35,6 +36,7 @@ func TestGlobalAllocationParam(t *testing.T) {
 		InfraAllocation:          sdk.NewRat(1, 100),
 		DeveloperAllocation:      sdk.NewRat(1, 100),
 		ValidatorAllocation:      sdk.NewRat(97, 100),
+		CommunityPoolAllocation:  sdk.NewRat(0, 100),
 	}
 	err := ph.setGlobalAllocationParam(ctx, &parameter)
 	assert.Nil(t, err)
@@ -108,6 +110,16 @@ func TestValidatorParam(t *testing.T) {
 		PenaltyByzantine:               types.NewCoinFromInt64(1000000 * types.Decimals),
 		ValidatorListSize:              int64(21),
 		AbsentCommitLimitation:         int64(100),
+		SlashDelegators:                true,
+		AbsentCommitWindow:             int64(1200), // 1h
+		JailDurationSec:                int64(24 * 3600),
+		MaxJailDurationSec:             int64(30 * 24 * 3600),
+		KeyUnbondingSec:                int64(7 * 24 * 3600),
+		WeightedInflation:              true,
+		StandbyListSize:                int64(21),
+		StandbyInflationRate:           sdk.NewRat(5, 100),
+		HeartbeatIntervalSec:           int64(3600),
+		CommissionUpdateIntervalSec:    int64(24 * 3600),
 	}
 	err := ph.setValidatorParam(ctx, &parameter)
 	assert.Nil(t, err)
@@ -126,6 +138,10 @@ func TestVoteParam(t *testing.T) {
 		VoterCoinReturnTimes:           int64(7),
 		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DelegatorCoinReturnTimes:       int64(7),
+		RedelegateIntervalSec:          int64(24 * 3600),
+		DefaultCommissionRate:          sdk.NewRat(10, 100),
+		MaxCommissionChangeRate:        sdk.NewRat(5, 100),
+		CommissionUpdateIntervalSec:    int64(24 * 3600),
 	}
 	err := ph.setVoteParam(ctx, &parameter)
 	assert.Nil(t, err)
@@ -154,6 +170,17 @@ func TestProposalParam(t *testing.T) {
 		ProtocolUpgradePassRatio:  sdk.NewRat(80, 100),
 		ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 		ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+
+		DepositPeriodSec:          int64(7 * 24 * 3600),
+		InitialDepositRatio:       sdk.NewRat(1, 1),
+		DepositForfeitQuorumRatio: sdk.NewRat(10, 100),
+		DepositForfeitVetoRatio:   sdk.NewRat(33, 100),
+		DepositForfeitToValidator: false,
+
+		ContentCensorshipQuorumRatio: sdk.NewRat(10, 100),
+		ChangeParamQuorumRatio:       sdk.NewRat(20, 100),
+		ProtocolUpgradeQuorumRatio:   sdk.NewRat(30, 100),
+		VetoRatio:                    sdk.NewRat(33, 100),
 	}
 	err := ph.setProposalParam(ctx, &parameter)
 	assert.Nil(t, err)
@@ -222,6 +249,7 @@ func TestInitParam(t *testing.T) {
 		ContentCreatorAllocation: sdk.NewRat(65, 100),
 		DeveloperAllocation:      sdk.NewRat(10, 100),
 		ValidatorAllocation:      sdk.NewRat(5, 100),
+		CommunityPoolAllocation:  sdk.NewRat(0, 100),
 	}
 
 	infraInternalAllocationParam := InfraInternalAllocationParam{
@@ -255,6 +283,16 @@ func TestInitParam(t *testing.T) {
 		PenaltyByzantine:               types.NewCoinFromInt64(1000000 * types.Decimals),
 		ValidatorListSize:              int64(21),
 		AbsentCommitLimitation:         int64(600),
+		SlashDelegators:                false,
+		AbsentCommitWindow:             int64(1200), // 1h
+		JailDurationSec:                int64(24 * 3600),
+		MaxJailDurationSec:             int64(30 * 24 * 3600),
+		KeyUnbondingSec:                int64(7 * 24 * 3600),
+		WeightedInflation:              false,
+		StandbyListSize:                int64(21),
+		StandbyInflationRate:           sdk.NewRat(5, 100),
+		HeartbeatIntervalSec:           int64(3600),
+		CommissionUpdateIntervalSec:    int64(24 * 3600),
 	}
 
 	voteParam := VoteParam{
@@ -263,6 +301,10 @@ func TestInitParam(t *testing.T) {
 		VoterCoinReturnTimes:           int64(7),
 		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DelegatorCoinReturnTimes:       int64(7),
+		RedelegateIntervalSec:          int64(24 * 3600),
+		DefaultCommissionRate:          sdk.NewRat(10, 100),
+		MaxCommissionChangeRate:        sdk.NewRat(5, 100),
+		CommissionUpdateIntervalSec:    int64(24 * 3600),
 	}
 	proposalParam := ProposalParam{
 		ContentCensorshipDecideSec:  int64(7 * 24 * 3600),
@@ -280,6 +322,17 @@ func TestInitParam(t *testing.T) {
 		ProtocolUpgradePassRatio:  sdk.NewRat(80, 100),
 		ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 		ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+
+		DepositPeriodSec:          int64(7 * 24 * 3600),
+		InitialDepositRatio:       sdk.NewRat(1, 1),
+		DepositForfeitQuorumRatio: sdk.NewRat(10, 100),
+		DepositForfeitVetoRatio:   sdk.NewRat(33, 100),
+		DepositForfeitToValidator: false,
+
+		ContentCensorshipQuorumRatio: sdk.NewRat(10, 100),
+		ChangeParamQuorumRatio:       sdk.NewRat(20, 100),
+		ProtocolUpgradeQuorumRatio:   sdk.NewRat(30, 100),
+		VetoRatio:                    sdk.NewRat(33, 100),
 	}
 
 	coinDayParam := CoinDayParam{
@@ -300,6 +353,9 @@ func TestInitParam(t *testing.T) {
 		ReportOrUpvoteIntervalSec: int64(24 * 3600),
 		PostIntervalSec:           int64(600),
 		MaxReportReputation:       types.NewCoinFromInt64(100 * types.Decimals),
+		SubscriptionIntervalSec:   int64(30 * 24 * 3600),
+		HideReportCoinDay:         types.NewCoinFromInt64(100000 * types.Decimals),
+		AppealPeriodSec:           int64(7 * 24 * 3600),
 	}
 	checkStorage(t, ctx, ph, globalAllocationParam, infraInternalAllocationParam,
 		evaluateOfContentValueParam, developerParam, validatorParam, voteParam,
@@ -315,6 +371,7 @@ func TestInitParamFromConfig(t *testing.T) {
 		ContentCreatorAllocation: sdk.NewRat(65, 100),
 		DeveloperAllocation:      sdk.NewRat(10, 100),
 		ValidatorAllocation:      sdk.NewRat(5, 100),
+		CommunityPoolAllocation:  sdk.NewRat(0, 100),
 	}
 
 	infraInternalAllocationParam := InfraInternalAllocationParam{
@@ -348,6 +405,16 @@ func TestInitParamFromConfig(t *testing.T) {
 		PenaltyByzantine:               types.NewCoinFromInt64(1000000 * types.Decimals),
 		ValidatorListSize:              int64(21),
 		AbsentCommitLimitation:         int64(600),
+		SlashDelegators:                false,
+		AbsentCommitWindow:             int64(1200), // 1h
+		JailDurationSec:                int64(24 * 3600),
+		MaxJailDurationSec:             int64(30 * 24 * 3600),
+		KeyUnbondingSec:                int64(7 * 24 * 3600),
+		WeightedInflation:              false,
+		StandbyListSize:                int64(21),
+		StandbyInflationRate:           sdk.NewRat(5, 100),
+		HeartbeatIntervalSec:           int64(3600),
+		CommissionUpdateIntervalSec:    int64(24 * 3600),
 	}
 
 	voteParam := VoteParam{
@@ -356,6 +423,10 @@ func TestInitParamFromConfig(t *testing.T) {
 		VoterCoinReturnTimes:           int64(7),
 		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DelegatorCoinReturnTimes:       int64(7),
+		RedelegateIntervalSec:          int64(24 * 3600),
+		DefaultCommissionRate:          sdk.NewRat(10, 100),
+		MaxCommissionChangeRate:        sdk.NewRat(5, 100),
+		CommissionUpdateIntervalSec:    int64(24 * 3600),
 	}
 	proposalParam := ProposalParam{
 		ContentCensorshipDecideSec:  int64(7 * 24 * 3600),
@@ -373,6 +444,17 @@ func TestInitParamFromConfig(t *testing.T) {
 		ProtocolUpgradePassRatio:  sdk.NewRat(80, 100),
 		ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 		ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+
+		DepositPeriodSec:          int64(7 * 24 * 3600),
+		InitialDepositRatio:       sdk.NewRat(1, 1),
+		DepositForfeitQuorumRatio: sdk.NewRat(10, 100),
+		DepositForfeitVetoRatio:   sdk.NewRat(33, 100),
+		DepositForfeitToValidator: false,
+
+		ContentCensorshipQuorumRatio: sdk.NewRat(10, 100),
+		ChangeParamQuorumRatio:       sdk.NewRat(20, 100),
+		ProtocolUpgradeQuorumRatio:   sdk.NewRat(30, 100),
+		VetoRatio:                    sdk.NewRat(33, 100),
 	}
 
 	coinDayParam := CoinDayParam{
@@ -393,6 +475,9 @@ func TestInitParamFromConfig(t *testing.T) {
 		ReportOrUpvoteIntervalSec: int64(24 * 3600),
 		PostIntervalSec:           int64(600),
 		MaxReportReputation:       types.NewCoinFromInt64(100 * types.Decimals),
+		SubscriptionIntervalSec:   int64(30 * 24 * 3600),
+		HideReportCoinDay:         types.NewCoinFromInt64(100000 * types.Decimals),
+		AppealPeriodSec:           int64(7 * 24 * 3600),
 	}
 	repParam := ReputationParam{
 		BestContentIndexN: 10,
@@ -509,3 +594,71 @@ func TestUpdateGlobalGrowthRate(t *testing.T) {
 		assert.Equal(t, globalParam.GlobalGrowthRate, tc.expectGrowthRate)
 	}
 }
+
+func TestMigrateParam(t *testing.T) {
+	ph := NewParamHolder(TestKVStoreKey)
+	ctx := getContext()
+	err := ph.InitParam(ctx)
+	assert.Nil(t, err)
+	globalAllocationParam, _ := ph.GetGlobalAllocationParam(ctx)
+	postParam, _ := ph.GetPostParam(ctx)
+	validatorParam, _ := ph.GetValidatorParam(ctx)
+	voteParam, _ := ph.GetVoteParam(ctx)
+	proposalParam, _ := ph.GetProposalParam(ctx)
+
+	// parameters stored before new fields were added
+	removeFields := func(key []byte, fields ...string) {
+		store := ctx.KVStore(TestKVStoreKey)
+		stored := map[string]interface{}{}
+		assert.Nil(t, json.Unmarshal(store.Get(key), &stored))
+		for _, field := range fields {
+			delete(stored, field)
+		}
+		bz, err := json.Marshal(stored)
+		assert.Nil(t, err)
+		store.Set(key, bz)
+	}
+	removeFields(GetAllocationParamKey(), "community_pool_allocation")
+	removeFields(GetPostParamKey(), "subscription_interval_sec", "hide_report_coin_day", "appeal_period_sec")
+	// zero value set by governance is not a missing parameter
+	validatorParam.StandbyListSize = 0
+	err = ph.setValidatorParam(ctx, validatorParam)
+	assert.Nil(t, err)
+	removeFields(GetValidatorParamKey(), "absent_commit_window", "jail_duration_second",
+		"max_jail_duration_second", "key_unbonding_second", "standby_list_size",
+		"standby_inflation_rate", "heartbeat_interval_second", "commission_update_interval_second")
+	removeFields(GetProposalParamKey(), "deposit_period_second", "initial_deposit_ratio",
+		"deposit_forfeit_quorum_ratio", "deposit_forfeit_veto_ratio", "content_censorship_quorum_ratio",
+		"change_param_quorum_ratio", "protocol_upgrade_quorum_ratio", "veto_ratio")
+
+	// parameter already set is kept
+	voteParam.RedelegateIntervalSec = 100
+	err = ph.setVoteParam(ctx, voteParam)
+	assert.Nil(t, err)
+	removeFields(GetVoteParamKey(), "default_commission_rate", "max_commission_change_rate",
+		"commission_update_interval_second")
+
+	// missing parameters are filled in before migration
+	unmigratedPostParam, err := ph.GetPostParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, *postParam, *unmigratedPostParam)
+
+	err = ph.MigrateParam(ctx)
+	assert.Nil(t, err)
+
+	resultGlobalAllocationParam, err := ph.GetGlobalAllocationParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, *globalAllocationParam, *resultGlobalAllocationParam)
+	resultPostParam, err := ph.GetPostParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, *postParam, *resultPostParam)
+	resultValidatorParam, err := ph.GetValidatorParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, *validatorParam, *resultValidatorParam)
+	resultVoteParam, err := ph.GetVoteParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, *voteParam, *resultVoteParam)
+	resultProposalParam, err := ph.GetProposalParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, *proposalParam, *resultProposalParam)
+}
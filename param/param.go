@@ -24,12 +24,14 @@ type EvaluateOfContentValueParam struct {
 // ContentCreatorAllocation - percentage for all content creator related allocation
 // DeveloperAllocation - percentage of inflation for developers
 // ValidatorAllocation - percentage of inflation for validators
+// CommunityPoolAllocation - percentage of inflation for community pool
 type GlobalAllocationParam struct {
 	GlobalGrowthRate         sdk.Rat `json:"global_growth_rate"`
 	InfraAllocation          sdk.Rat `json:"infra_allocation"`
 	ContentCreatorAllocation sdk.Rat `json:"content_creator_allocation"`
 	DeveloperAllocation      sdk.Rat `json:"developer_allocation"`
 	ValidatorAllocation      sdk.Rat `json:"validator_allocation"`
+	CommunityPoolAllocation  sdk.Rat `json:"community_pool_allocation"`
 }
 
 // InfraInternalAllocationParam - infra internal allocation parameters
@@ -46,28 +48,48 @@ type InfraInternalAllocationParam struct {
 // VoterCoinReturnTimes - when withdraw or revoke, the deposit return to voter by return event
 // DelegatorCoinReturnIntervalSec - when withdraw or revoke, the deposit return to delegator by return event
 // DelegatorCoinReturnTimes - when withdraw or revoke, the deposit return to delegator by return event
+// RedelegateIntervalSec - minimum seconds between two redelegations of a delegator
+// DefaultCommissionRate - share of income kept by voter which never declared a commission rate
+// MaxCommissionChangeRate - maximum change of voter commission rate in one update
+// CommissionUpdateIntervalSec - minimum seconds between two commission rate updates of a voter
 type VoteParam struct {
 	MinStakeIn                     types.Coin `json:"min_stake_in"`
 	VoterCoinReturnIntervalSec     int64      `json:"voter_coin_return_interval_second"`
 	VoterCoinReturnTimes           int64      `json:"voter_coin_return_times"`
 	DelegatorCoinReturnIntervalSec int64      `json:"delegator_coin_return_interval_second"`
 	DelegatorCoinReturnTimes       int64      `json:"delegator_coin_return_times"`
+	RedelegateIntervalSec          int64      `json:"redelegate_interval_second"`
+	DefaultCommissionRate          sdk.Rat    `json:"default_commission_rate"`
+	MaxCommissionChangeRate        sdk.Rat    `json:"max_commission_change_rate"`
+	CommissionUpdateIntervalSec    int64      `json:"commission_update_interval_second"`
 }
 
 // ProposalParam - proposal parameters
 // ContentCensorshipDecideSec - seconds after content censorship proposal created till expired
 // ContentCensorshipMinDeposit - minimum deposit to propose content censorship proposal
 // ContentCensorshipPassRatio - upvote and downvote ratio for content censorship proposal
-// ContentCensorshipPassVotes - minimum voting power required to pass content censorship proposal
+// ContentCensorshipPassVotes - minimum voting power required to pass content censorship proposal,
+// only used before LinoBlockchainFirstUpdateHeight
 // ChangeParamDecideSec - seconds after parameter change proposal created till expired
 // ChangeParamExecutionSec - seconds after parameter change proposal pass till execution
 // ChangeParamMinDeposit - minimum deposit to propose parameter change proposal
 // ChangeParamPassRatio - upvote and downvote ratio for parameter change proposal
-// ChangeParamPassVotes - minimum voting power required to pass parameter change proposal
+// ChangeParamPassVotes - minimum voting power required to pass parameter change proposal,
+// only used before LinoBlockchainFirstUpdateHeight
 // ProtocolUpgradeDecideSec - seconds after protocol upgrade proposal created till expired
 // ProtocolUpgradeMinDeposit - minimum deposit to propose protocol upgrade proposal
 // ProtocolUpgradePassRatio - upvote and downvote ratio for protocol upgrade proposal
-// ProtocolUpgradePassVotes - minimum voting power required to pass protocol upgrade proposal
+// ProtocolUpgradePassVotes - minimum voting power required to pass protocol upgrade proposal,
+// only used before LinoBlockchainFirstUpdateHeight
+// DepositPeriodSec - seconds to collect min deposit before proposal is revoked
+// InitialDepositRatio - ratio of min deposit paid by creator when proposal is submitted
+// DepositForfeitQuorumRatio - deposit is forfeited if total votes is below this ratio of quorum
+// DepositForfeitVetoRatio - deposit is forfeited if veto votes share of total votes exceeds this ratio
+// DepositForfeitToValidator - forfeited deposit goes to validator inflation pool instead of being burned
+// ContentCensorshipQuorumRatio - ratio of total lino stake required to vote on content censorship proposal
+// ChangeParamQuorumRatio - ratio of total lino stake required to vote on parameter change proposal
+// ProtocolUpgradeQuorumRatio - ratio of total lino stake required to vote on protocol upgrade proposal
+// VetoRatio - proposal fails if veto votes share of total votes exceeds this ratio
 type ProposalParam struct {
 	ContentCensorshipDecideSec  int64      `json:"content_censorship_decide_second"`
 	ContentCensorshipMinDeposit types.Coin `json:"content_censorship_min_deposit"`
@@ -82,6 +104,16 @@ type ProposalParam struct {
 	ProtocolUpgradeMinDeposit   types.Coin `json:"protocol_upgrade_min_deposit"`
 	ProtocolUpgradePassRatio    sdk.Rat    `json:"protocol_upgrade_pass_ratio"`
 	ProtocolUpgradePassVotes    types.Coin `json:"protocol_upgrade_pass_votes"`
+	DepositPeriodSec            int64      `json:"deposit_period_second"`
+	InitialDepositRatio         sdk.Rat    `json:"initial_deposit_ratio"`
+	DepositForfeitQuorumRatio   sdk.Rat    `json:"deposit_forfeit_quorum_ratio"`
+	DepositForfeitVetoRatio     sdk.Rat    `json:"deposit_forfeit_veto_ratio"`
+	DepositForfeitToValidator   bool       `json:"deposit_forfeit_to_validator"`
+
+	ContentCensorshipQuorumRatio sdk.Rat `json:"content_censorship_quorum_ratio"`
+	ChangeParamQuorumRatio       sdk.Rat `json:"change_param_quorum_ratio"`
+	ProtocolUpgradeQuorumRatio   sdk.Rat `json:"protocol_upgrade_quorum_ratio"`
+	VetoRatio                    sdk.Rat `json:"veto_ratio"`
 }
 
 // DeveloperParam - developer parameters
@@ -107,6 +139,18 @@ type DeveloperParam struct {
 // minus PenaltyByzantine amount of Coin from validator deposit
 // ValidatorListSize - size of oncall validator
 // AbsentCommitLimitation - absent block limitation till penalty
+// SlashDelegators - if true, byzantine and absent commit penalties are also applied to
+// delegations under the validator, in proportion to penalty over validator deposit
+// AbsentCommitWindow - number of latest blocks absent commits are counted in
+// JailDurationSec - jail period for the first offence, doubled for each repeated offence
+// MaxJailDurationSec - upper bound of escalating jail period
+// KeyUnbondingSec - byzantine evidence against a rotated consensus key is tracked till this period ends
+// WeightedInflation - if true, validator inflation is weighted by deposit and uptime in the hour,
+// unearned inflation goes back to validator inflation pool
+// StandbyListSize - size of ranked standby validators waiting to be promoted
+// StandbyInflationRate - share of validator inflation given to online standby validators
+// HeartbeatIntervalSec - standby validator is online if it sent heartbeat within this period
+// CommissionUpdateIntervalSec - minimum seconds between two commission rate updates of a validator
 type ValidatorParam struct {
 	ValidatorMinWithdraw           types.Coin `json:"validator_min_withdraw"`
 	ValidatorMinVotingDeposit      types.Coin `json:"validator_min_voting_deposit"`
@@ -118,6 +162,16 @@ type ValidatorParam struct {
 	PenaltyByzantine               types.Coin `json:"penalty_byzantine"`
 	ValidatorListSize              int64      `json:"validator_list_size"`
 	AbsentCommitLimitation         int64      `json:"absent_commit_limitation"`
+	SlashDelegators                bool       `json:"slash_delegators"`
+	AbsentCommitWindow             int64      `json:"absent_commit_window"`
+	JailDurationSec                int64      `json:"jail_duration_second"`
+	MaxJailDurationSec             int64      `json:"max_jail_duration_second"`
+	KeyUnbondingSec                int64      `json:"key_unbonding_second"`
+	WeightedInflation              bool       `json:"weighted_inflation"`
+	StandbyListSize                int64      `json:"standby_list_size"`
+	StandbyInflationRate           sdk.Rat    `json:"standby_inflation_rate"`
+	HeartbeatIntervalSec           int64      `json:"heartbeat_interval_second"`
+	CommissionUpdateIntervalSec    int64      `json:"commission_update_interval_second"`
 }
 
 // CoinDayParam - coin day parameters
@@ -150,10 +204,17 @@ type AccountParam struct {
 // PostParam - post parameters
 // ReportOrUpvoteIntervalSec - report interval second
 // PostIntervalSec - post interval second
+// MaxReportReputation - reputation needed to fully penalize a post
+// SubscriptionIntervalSec - interval between two payments of a subscription
+// HideReportCoinDay - report coin day needed to hide a post automatically
+// AppealPeriodSec - time window for author to appeal a censored post
 type PostParam struct {
 	ReportOrUpvoteIntervalSec int64      `json:"report_or_upvote_interval_second"`
 	PostIntervalSec           int64      `json:"post_interval_sec"`
 	MaxReportReputation       types.Coin `json:"max_report_reputation"`
+	SubscriptionIntervalSec   int64      `json:"subscription_interval_sec"`
+	HideReportCoinDay         types.Coin `json:"hide_report_coin_day"`
+	AppealPeriodSec           int64      `json:"appeal_period_sec"`
 }
 
 // BestContentIndexN - hard cap of how many content can be indexed every round.
@@ -61,6 +61,7 @@ func TestForceValidatorVote(t *testing.T) {
 		ContentCreatorAllocation: sdk.NewRat(1, 100),
 		DeveloperAllocation:      sdk.NewRat(1, 100),
 		ValidatorAllocation:      sdk.NewRat(97, 100),
+		CommunityPoolAllocation:  sdk.NewRat(0, 100),
 	}
 
 	changeAllocationMsg := proposal.NewChangeGlobalAllocationParamMsg(accountName, desc, "")
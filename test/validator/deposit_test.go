@@ -223,8 +223,9 @@ func TestFireIncompetentValidator(t *testing.T) {
 	if err != nil {
 		t.Errorf("%s: failed to get validator, got err %v", testName, err)
 	}
-	if val1.AbsentCommit != 0 {
-		t.Errorf("%s: expect 0 absent commit for val1, got %v", testName, val1.AbsentCommit)
+	// absent commit in window is not recovered by signing
+	if val1.AbsentCommit != 1 {
+		t.Errorf("%s: expect 1 absent commit for val1, got %v", testName, val1.AbsentCommit)
 	}
 
 	// set val0 to miss 601 times
@@ -242,9 +243,9 @@ func TestFireIncompetentValidator(t *testing.T) {
 		lb.Commit()
 	}
 
-	// check val0 is gone
+	// check val0 is jailed
 	test.CheckOncallValidatorList(t, "validator0", false, lb)
-	test.CheckAllValidatorList(t, "validator0", false, lb)
+	test.CheckAllValidatorList(t, "validator0", true, lb)
 }
 
 func TestFireIncompetentValidatorAndThenAddOneWithHighestDepositAsSupplement(t *testing.T) {
@@ -318,9 +319,9 @@ func TestFireIncompetentValidatorAndThenAddOneWithHighestDepositAsSupplement(t *
 		lb.Commit()
 	}
 
-	// check val0 is gone
+	// check val0 is jailed
 	test.CheckOncallValidatorList(t, "validator0", false, lb)
-	test.CheckAllValidatorList(t, "validator0", false, lb)
+	test.CheckAllValidatorList(t, "validator0", true, lb)
 
 	// check altval0 joins oncall validator, but altval1 not
 	test.CheckOncallValidatorList(t, "altval0", true, lb)
@@ -391,9 +392,9 @@ func TestFireIncompetentValidatorAndThenAddOneMoreValidator(t *testing.T) {
 		lb.Commit()
 	}
 
-	// check val0 is gone
+	// check val0 is jailed
 	test.CheckOncallValidatorList(t, "validator0", false, lb)
-	test.CheckAllValidatorList(t, "validator0", false, lb)
+	test.CheckAllValidatorList(t, "validator0", true, lb)
 
 	// add one more validator
 	newAccountResetPriv := secp256k1.GenPrivKey()
@@ -90,6 +90,12 @@ func (coin Coin) String() string {
 	return fmt.Sprintf("coin:%v", coin.Amount)
 }
 
+// IsNil - returns true if coin amount is unset, which happens when a coin
+// field is decoded from a record stored before the field was added
+func (coin Coin) IsNil() bool {
+	return coin.Amount == (sdk.Int{})
+}
+
 // IsZero - returns if this represents no money
 func (coin Coin) IsZero() bool {
 	return coin.Amount.Sign() == 0
@@ -40,9 +40,19 @@ const (
 	ProposalRevoked = ProposalResult(2)
 
 	// Different proposal types
-	ChangeParam       = ProposalType(0)
-	ContentCensorship = ProposalType(1)
-	ProtocolUpgrade   = ProposalType(2)
+	ChangeParam        = ProposalType(0)
+	ContentCensorship  = ProposalType(1)
+	ProtocolUpgrade    = ProposalType(2)
+	ContentAppeal      = ProposalType(3)
+	TextProposal       = ProposalType(4)
+	CommunityPoolSpend = ProposalType(5)
+
+	// Different post moderation states
+	PostVisible  = ModerationState(0)
+	PostHidden   = ModerationState(1)
+	PostCensored = ModerationState(2)
+	PostAppealed = ModerationState(3)
+	PostRestored = ModerationState(4)
 
 	// Different donation types
 	DirectDeposit = DonationType(0)
@@ -63,6 +73,8 @@ const (
 	ProposalReturnCoin   = TransferDetailType(11)
 	GenesisCoin          = TransferDetailType(12)
 	ClaimInterest        = TransferDetailType(13)
+	ClaimDelegatorReward = TransferDetailType(14)
+	CommunityPoolGrant   = TransferDetailType(15)
 
 	// Different possible outcomes
 	TransferOut      = TransferDetailType(20)
@@ -73,6 +85,7 @@ const (
 	DeveloperDeposit = TransferDetailType(25)
 	InfraDeposit     = TransferDetailType(26)
 	ProposalDeposit  = TransferDetailType(27)
+	DelegationSlash  = TransferDetailType(28)
 
 	// punishment type
 	UnknownPunish      = PunishType(0)
@@ -102,6 +115,9 @@ const (
 	// MaxPostContentLength - maximum length of post content
 	MaxPostContentLength = 1000
 
+	// MaximumSubscriptionPeriods - maximum number of periods of a subscription
+	MaximumSubscriptionPeriods = 120
+
 	// KeySeparator - separate different key component
 	KeySeparator = "/"
 
@@ -132,12 +148,21 @@ const (
 	// MaximumLinkURL - maximum length of Links URL
 	MaximumLinkURL = 100
 
+	// MaximumUpgradeNameLength - maximum length of protocol upgrade name
+	MaximumUpgradeNameLength = 64
+
 	// MaximumLengthOfPostID - maximum length of post ID
 	MaximumLengthOfPostID = 50
 
 	// MaximumNumOfLinks - maximum number of links per post
 	MaximumNumOfLinks = 10
 
+	// MaximumNumOfTags - maximum number of tags per post
+	MaximumNumOfTags = 5
+
+	// MaximumTagLength - maximum length of a post tag
+	MaximumTagLength = 30
+
 	// MaximumLengthOfDeveloperWebsite - maximum length of developer website
 	MaximumLengthOfDeveloperWebsite = 100
 
@@ -147,9 +172,21 @@ const (
 	// MaximumLengthOfAppMetadata - maximum length of developer App meta data
 	MaximumLengthOfAppMetadata = 1000
 
+	// MaximumValidatorMonikerLength - maximum length of validator moniker
+	MaximumValidatorMonikerLength = 70
+
+	// MaximumValidatorIdentityLength - maximum length of validator identity
+	MaximumValidatorIdentityLength = 64
+
+	// MaximumValidatorDetailsLength - maximum length of validator details
+	MaximumValidatorDetailsLength = 280
+
 	// MaximumLengthOfProposalReason - maximum length of proposal reason
 	MaximumLengthOfProposalReason = 1000
 
+	// MaximumBestContentIndexN - maximum number of content can be indexed every reputation round
+	MaximumBestContentIndexN = 100
+
 	// InitAccountWithFullCoinDayMemo - init account with full coin day memo
 	InitAccountWithFullCoinDayMemo = "open account deposit"
 
@@ -168,6 +205,10 @@ const (
 	// CoinDayRecordIntervalSec - coin day record in the same interval bucket will be merged
 	CoinDayRecordIntervalSec = 1200
 
+	// MinBlockIntervalSec - lower bound of seconds between two blocks, used to
+	// estimate the highest height chain can reach in a period
+	MinBlockIntervalSec = 1
+
 	// LinoBlockchainFirstUpdateHeight - first blockchain update
 	LinoBlockchainFirstUpdateHeight = 156000
 
@@ -100,6 +100,10 @@ const (
 	CodeGetLastPostAt                        sdk.CodeType = 360
 	CodeUpdateLastPostAt                     sdk.CodeType = 361
 	CodeFrozenMoneyListTooLong               sdk.CodeType = 362
+	CodeFailedToMarshalSupporter             sdk.CodeType = 363
+	CodeFailedToUnmarshalSupporter           sdk.CodeType = 364
+	CodeFailedToMarshalPendingReturns        sdk.CodeType = 365
+	CodeFailedToUnmarshalPendingReturns      sdk.CodeType = 366
 
 	// Lino post errors reserve 400 ~ 499
 	CodePostMetaNotFound                     sdk.CodeType = 400
@@ -143,16 +147,43 @@ const (
 	CodeCreatePostSourceInvalid              sdk.CodeType = 438
 	CodeGetSourcePost                        sdk.CodeType = 439
 	CodePostTooOften                         sdk.CodeType = 440
+	CodeSubscriptionNotFound                 sdk.CodeType = 441
+	CodeFailedToMarshalSubscription          sdk.CodeType = 442
+	CodeFailedToUnmarshalSubscription        sdk.CodeType = 443
+	CodeSubscriptionAlreadyExist             sdk.CodeType = 444
+	CodeInvalidSubscriptionPeriods           sdk.CodeType = 445
+	CodeSubscribePostIsDeleted               sdk.CodeType = 446
+	CodeTooManyTags                          sdk.CodeType = 447
+	CodeInvalidTag                           sdk.CodeType = 448
+	CodeCensoredPostInfoNotFound             sdk.CodeType = 449
+	CodeInvalidModerationState               sdk.CodeType = 450
+	CodeUpdatePostIsCensored                 sdk.CodeType = 451
+	CodeDonatePostIsCensored                 sdk.CodeType = 452
+	CodeSubscribePostIsCensored              sdk.CodeType = 453
 
 	// Lino validator errors reserve 500 ~ 599
-	CodeValidatorNotFound              sdk.CodeType = 500
-	CodeValidatorListNotFound          sdk.CodeType = 501
-	CodeFailedToMarshalValidator       sdk.CodeType = 502
-	CodeFailedToMarshalValidatorList   sdk.CodeType = 503
-	CodeFailedToUnmarshalValidator     sdk.CodeType = 504
-	CodeFailedToUnmarshalValidatorList sdk.CodeType = 505
-	CodeUnbalancedAccount              sdk.CodeType = 506
-	CodeValidatorPubKeyAlreadyExist    sdk.CodeType = 507
+	CodeValidatorNotFound               sdk.CodeType = 500
+	CodeValidatorListNotFound           sdk.CodeType = 501
+	CodeFailedToMarshalValidator        sdk.CodeType = 502
+	CodeFailedToMarshalValidatorList    sdk.CodeType = 503
+	CodeFailedToUnmarshalValidator      sdk.CodeType = 504
+	CodeFailedToUnmarshalValidatorList  sdk.CodeType = 505
+	CodeUnbalancedAccount               sdk.CodeType = 506
+	CodeValidatorPubKeyAlreadyExist     sdk.CodeType = 507
+	CodeValidatorNotJailed              sdk.CodeType = 508
+	CodeValidatorStillJailed            sdk.CodeType = 509
+	CodeValidatorJailed                 sdk.CodeType = 510
+	CodeKeyRotationTooOften             sdk.CodeType = 511
+	CodeInvalidValidatorPubKey          sdk.CodeType = 512
+	CodeFailedToMarshalPenaltyHistory   sdk.CodeType = 513
+	CodeFailedToUnmarshalPenaltyHistory sdk.CodeType = 514
+	CodeInvalidValidatorDescription     sdk.CodeType = 515
+	CodeInvalidValidatorCommissionRate  sdk.CodeType = 516
+	CodeCommissionRateExceedMaxRate     sdk.CodeType = 517
+	CodeCommissionRateChangeTooLarge    sdk.CodeType = 518
+	CodeCommissionUpdateTooOften        sdk.CodeType = 519
+	CodeCommissionLimitImmutable        sdk.CodeType = 520
+	CodeCommissionLimitRequired         sdk.CodeType = 521
 
 	// Lino global errors reserve 600 ~ 699
 	CodeInfraInflationCoinConversion           sdk.CodeType = 600
@@ -181,22 +212,36 @@ const (
 	CodeLinoStakeStatisticNotFound             sdk.CodeType = 623
 	CodeFailedToUnmarshalLinoStakeStatistic    sdk.CodeType = 624
 	CodePastDayIsNegative                      sdk.CodeType = 625
+	CodeCommunityPoolInsufficient              sdk.CodeType = 626
+	CodeIllegalSubscriptionInterval            sdk.CodeType = 627
 
 	// Vote errors reserve 700 ~ 799
-	CodeVoterNotFound                  sdk.CodeType = 700
-	CodeVoteNotFound                   sdk.CodeType = 701
-	CodeReferenceListNotFound          sdk.CodeType = 702
-	CodeDelegationNotFound             sdk.CodeType = 703
-	CodeFailedToMarshalVoter           sdk.CodeType = 704
-	CodeFailedToMarshalVote            sdk.CodeType = 705
-	CodeFailedToMarshalDelegation      sdk.CodeType = 706
-	CodeFailedToMarshalReferenceList   sdk.CodeType = 707
-	CodeFailedToUnmarshalVoter         sdk.CodeType = 708
-	CodeFailedToUnmarshalVote          sdk.CodeType = 709
-	CodeFailedToUnmarshalDelegation    sdk.CodeType = 710
-	CodeFailedToUnmarshalReferenceList sdk.CodeType = 711
-	CodeValidatorCannotRevoke          sdk.CodeType = 712
-	CodeVoteAlreadyExist               sdk.CodeType = 713
+	CodeVoterNotFound                    sdk.CodeType = 700
+	CodeVoteNotFound                     sdk.CodeType = 701
+	CodeReferenceListNotFound            sdk.CodeType = 702
+	CodeDelegationNotFound               sdk.CodeType = 703
+	CodeFailedToMarshalVoter             sdk.CodeType = 704
+	CodeFailedToMarshalVote              sdk.CodeType = 705
+	CodeFailedToMarshalDelegation        sdk.CodeType = 706
+	CodeFailedToMarshalReferenceList     sdk.CodeType = 707
+	CodeFailedToUnmarshalVoter           sdk.CodeType = 708
+	CodeFailedToUnmarshalVote            sdk.CodeType = 709
+	CodeFailedToUnmarshalDelegation      sdk.CodeType = 710
+	CodeFailedToUnmarshalReferenceList   sdk.CodeType = 711
+	CodeValidatorCannotRevoke            sdk.CodeType = 712
+	CodeRedelegateTooOften               sdk.CodeType = 714
+	CodeRedelegateToSameVoter            sdk.CodeType = 715
+	CodeRewardPoolNotFound               sdk.CodeType = 716
+	CodeDelegatorRewardNotFound          sdk.CodeType = 717
+	CodeFailedToMarshalRewardPool        sdk.CodeType = 718
+	CodeFailedToMarshalDelegatorReward   sdk.CodeType = 719
+	CodeFailedToUnmarshalRewardPool      sdk.CodeType = 720
+	CodeFailedToUnmarshalDelegatorReward sdk.CodeType = 721
+	CodeInvalidCommissionRate            sdk.CodeType = 722
+	CodeVoterCommissionUpdateTooOften    sdk.CodeType = 723
+	CodeVoterCommissionChangeTooLarge    sdk.CodeType = 724
+	CodeValidatorCannotUpdateCommission  sdk.CodeType = 725
+	CodeRedelegateToSelf                 sdk.CodeType = 726
 
 	// Lino infra errors reserve 800 ~ 899
 	CodeInfraProviderNotFound              sdk.CodeType = 800
@@ -281,4 +326,18 @@ const (
 	CodeInvalidLink                     sdk.CodeType = 1115
 	CodeIllegalParameter                sdk.CodeType = 1116
 	CodeReasonTooLong                   sdk.CodeType = 1117
+	CodeNotPostAuthor                   sdk.CodeType = 1118
+	CodeCensorshipPostIsCensored        sdk.CodeType = 1119
+	CodeInvalidVoteOption               sdk.CodeType = 1120
+	CodeRecipientNotFound               sdk.CodeType = 1121
+	CodeUpgradePlanNotFound             sdk.CodeType = 1122
+	CodeFailedToMarshalUpgradePlan      sdk.CodeType = 1123
+	CodeFailedToUnmarshalUpgradePlan    sdk.CodeType = 1124
+	CodeInvalidUpgradeName              sdk.CodeType = 1125
+	CodeInvalidUpgradeHeight            sdk.CodeType = 1126
+	CodeDepositNotFound                 sdk.CodeType = 1127
+	CodeFailedToMarshalDeposit          sdk.CodeType = 1128
+	CodeFailedToUnmarshalDeposit        sdk.CodeType = 1129
+	CodeNotPendingProposal              sdk.CodeType = 1130
+	CodeUpgradeHeightTooLow             sdk.CodeType = 1131
 )
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // GetBankCmd returns a query bank that will display the
@@ -150,3 +151,129 @@ func (c commander) getAccountsCmd(cmd *cobra.Command, args []string) error {
 	}
 	return nil
 }
+
+// GetSupportersCmd returns a query command that will display supporters
+// of a user across all posts from the largest donation amount to the smallest
+func GetSupportersCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "supporters <username>",
+		Short: "Query top supporters of a user",
+		RunE:  cmdr.getSupportersCmd,
+	}
+	cmd.Flags().String(client.FlagCursor, "", "cursor returned by previous page, empty for the top supporters")
+	cmd.Flags().Int(client.FlagLimit, 20, "max number of supporters in one page")
+	return cmd
+}
+
+func (c commander) getSupportersCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide an valid username")
+	}
+
+	prefix := model.GetSupporterRankPrefix(types.AccountKey(args[0]))
+	resKVs, err := ctx.QuerySubspace(c.cdc, prefix, c.storeName)
+	if err != nil {
+		return err
+	}
+	values, nextCursor, err := client.PageIndex(
+		resKVs, prefix, viper.GetString(client.FlagCursor), viper.GetInt(client.FlagLimit), nil)
+	if err != nil {
+		return err
+	}
+
+	supporters := []model.Supporter{}
+	for _, value := range values {
+		var supporter model.Supporter
+		if err := c.cdc.UnmarshalJSON(value, &supporter); err != nil {
+			return err
+		}
+		supporters = append(supporters, supporter)
+	}
+
+	if err := client.PrintIndent(supporters, nextCursor); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetPendingReturnsCmd returns a query command that will display coin returns
+// of a user which haven't been fully paid
+func GetPendingReturnsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "pending-returns <username>",
+		Short: "Query pending coin returns of a user",
+		RunE:  cmdr.getPendingReturnsCmd,
+	}
+}
+
+// pendingReturn - pending coin return with readable source
+type pendingReturn struct {
+	Source         string     `json:"source"`
+	Remaining      types.Coin `json:"remaining"`
+	NextReturnAt   int64      `json:"next_return_at"`
+	RemainingTimes int64      `json:"remaining_times"`
+	IntervalSec    int64      `json:"interval_second"`
+}
+
+func getReturnSource(returnType types.TransferDetailType) string {
+	switch returnType {
+	case types.VoteReturnCoin:
+		return "voter"
+	case types.DelegationReturnCoin:
+		return "delegation"
+	case types.ValidatorReturnCoin:
+		return "validator"
+	case types.DeveloperReturnCoin:
+		return "developer"
+	case types.InfraReturnCoin:
+		return "infra"
+	case types.ProposalReturnCoin:
+		return "proposal"
+	}
+	return "unknown"
+}
+
+func (c commander) getPendingReturnsCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide an valid username")
+	}
+
+	res, err := ctx.Query(model.GetPendingReturnsKey(types.AccountKey(args[0])), c.storeName)
+	if err != nil {
+		return err
+	}
+	pendingReturns := new(model.PendingReturns)
+	if len(res) > 0 {
+		if err := c.cdc.UnmarshalJSON(res, pendingReturns); err != nil {
+			return err
+		}
+	}
+
+	returns := []pendingReturn{}
+	for _, r := range pendingReturns.Returns {
+		returns = append(returns, pendingReturn{
+			Source:         getReturnSource(r.ReturnType),
+			Remaining:      r.Remaining,
+			NextReturnAt:   r.NextReturnAt,
+			RemainingTimes: r.RemainingTimes,
+			IntervalSec:    r.IntervalSec,
+		})
+	}
+
+	output, err := json.MarshalIndent(returns, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
@@ -5,11 +5,13 @@ import (
 	types "github.com/lino-network/lino/types"
 )
 
-// ReturnCoinEvent - return a certain amount of coin to an account
+// ReturnCoinEvent - return a certain amount of coin to an account,
+// ReturnID links the event to user's pending return, zero if not tracked
 type ReturnCoinEvent struct {
 	Username   types.AccountKey         `json:"username"`
 	Amount     types.Coin               `json:"amount"`
 	ReturnType types.TransferDetailType `json:"return_type"`
+	ReturnID   int64                    `json:"return_id"`
 }
 
 // Execute - execute coin return events
@@ -23,7 +25,10 @@ func (event ReturnCoinEvent) Execute(ctx sdk.Context, am AccountManager) sdk.Err
 		event.ReturnType); err != nil {
 		return err
 	}
-	return nil
+	if event.ReturnID == 0 {
+		return nil
+	}
+	return am.settlePendingReturn(ctx, event.Username, event.ReturnID, event.Amount)
 }
 
 // CreateCoinReturnEvents - create coin return events
@@ -50,3 +55,25 @@ func CreateCoinReturnEvents(
 	}
 	return events, nil
 }
+
+// CreatePendingCoinReturnEvents - create coin return events and track them
+// in user's pending returns till all events are executed
+func (accManager AccountManager) CreatePendingCoinReturnEvents(
+	ctx sdk.Context, username types.AccountKey, times int64, interval int64, coin types.Coin,
+	returnType types.TransferDetailType) ([]types.Event, sdk.Error) {
+	events, err := CreateCoinReturnEvents(ctx, username, times, interval, coin, returnType)
+	if err != nil {
+		return nil, err
+	}
+	returnID, err := accManager.addPendingReturn(
+		ctx, username, returnType, coin, ctx.BlockHeader().Time.Unix()+interval, interval, times)
+	if err != nil {
+		return nil, err
+	}
+	for i, event := range events {
+		returnEvent := event.(ReturnCoinEvent)
+		returnEvent.ReturnID = returnID
+		events[i] = returnEvent
+	}
+	return events, nil
+}
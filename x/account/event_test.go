@@ -8,6 +8,7 @@ import (
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/account/model"
 	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestCreateCoinReturnEvents(t *testing.T) {
@@ -182,3 +183,72 @@ func TestReturnCoinEvent(t *testing.T) {
 		}
 	}
 }
+
+func TestPendingCoinReturnEvents(t *testing.T) {
+	ctx, am, _ := setupTest(t, 1)
+	createTestAccount(ctx, am, "user1")
+
+	baseTime := int64(1000)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Height: 1, Time: time.Unix(baseTime, 0)})
+	events, err := am.CreatePendingCoinReturnEvents(
+		ctx, "user1", 2, 3600, types.NewCoinFromInt64(100), types.ValidatorReturnCoin)
+	assert.Nil(t, err)
+	_, err = am.CreatePendingCoinReturnEvents(
+		ctx, "user1", 1, 7200, types.NewCoinFromInt64(10), types.DelegationReturnCoin)
+	assert.Nil(t, err)
+	for _, event := range events {
+		assert.Equal(t, int64(1), event.(ReturnCoinEvent).ReturnID)
+	}
+
+	pendingReturns, err := am.GetPendingReturns(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, []model.PendingReturn{
+		{
+			ReturnID:       1,
+			ReturnType:     types.ValidatorReturnCoin,
+			Remaining:      types.NewCoinFromInt64(100),
+			NextReturnAt:   baseTime + 3600,
+			RemainingTimes: 2,
+			IntervalSec:    3600,
+		},
+		{
+			ReturnID:       2,
+			ReturnType:     types.DelegationReturnCoin,
+			Remaining:      types.NewCoinFromInt64(10),
+			NextReturnAt:   baseTime + 7200,
+			RemainingTimes: 1,
+			IntervalSec:    7200,
+		},
+	}, pendingReturns)
+
+	// each executed event is settled in pending return
+	err = events[0].(ReturnCoinEvent).Execute(ctx, am)
+	assert.Nil(t, err)
+	pendingReturns, err = am.GetPendingReturns(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(50), pendingReturns[0].Remaining)
+	assert.Equal(t, baseTime+7200, pendingReturns[0].NextReturnAt)
+	assert.Equal(t, int64(1), pendingReturns[0].RemainingTimes)
+
+	// pending return is removed after last event
+	err = events[1].(ReturnCoinEvent).Execute(ctx, am)
+	assert.Nil(t, err)
+	pendingReturns, err = am.GetPendingReturns(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(pendingReturns))
+	assert.Equal(t, int64(2), pendingReturns[0].ReturnID)
+
+	// untracked event doesn't change pending returns
+	err = ReturnCoinEvent{
+		Username: "user1", Amount: types.NewCoinFromInt64(1), ReturnType: types.DelegationReturnCoin,
+	}.Execute(ctx, am)
+	assert.Nil(t, err)
+	pendingReturns, err = am.GetPendingReturns(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(10), pendingReturns[0].Remaining)
+
+	// user without pending return
+	pendingReturns, err = am.GetPendingReturns(ctx, "user2")
+	assert.Nil(t, err)
+	assert.Equal(t, []model.PendingReturn{}, pendingReturns)
+}
@@ -417,6 +417,34 @@ func (accManager AccountManager) AddBalanceHistory(
 	return nil
 }
 
+// AddStakePenaltyHistory - record coin slashed from user's stake in balance history,
+// saving is not changed since the coin is not in saving
+func (accManager AccountManager) AddStakePenaltyHistory(
+	ctx sdk.Context, username types.AccountKey, coin types.Coin, from types.AccountKey,
+	memo string, detailType types.TransferDetailType) sdk.Error {
+	if coin.IsZero() {
+		return nil
+	}
+	accountBank, err := accManager.storage.GetBankFromAccountKey(ctx, username)
+	if err != nil {
+		return err
+	}
+	if err := accManager.AddBalanceHistory(
+		ctx, username, accountBank.NumOfTx, model.Detail{
+			Amount:     coin,
+			DetailType: detailType,
+			From:       from,
+			To:         username,
+			Balance:    accountBank.Saving,
+			CreatedAt:  ctx.BlockHeader().Time.Unix(),
+			Memo:       memo,
+		}); err != nil {
+		return err
+	}
+	accountBank.NumOfTx++
+	return accManager.storage.SetBankFromAccountKey(ctx, username, accountBank)
+}
+
 // UpdateJSONMeta - update user JONS meta data
 func (accManager AccountManager) UpdateJSONMeta(
 	ctx sdk.Context, username types.AccountKey, JSONMeta string) sdk.Error {
@@ -791,25 +819,48 @@ func (accManager AccountManager) CheckUserTPSCapacity(
 	return nil
 }
 
-// UpdateDonationRelationship - increase donation relationship times by 1
+// UpdateDonationRelationship - increase donation relationship times by 1,
+// add amount to total donation and update supporter rank of me
 func (accManager AccountManager) UpdateDonationRelationship(
-	ctx sdk.Context, me, other types.AccountKey) sdk.Error {
+	ctx sdk.Context, me, other types.AccountKey, amount types.Coin) sdk.Error {
 	relationship, err := accManager.storage.GetRelationship(ctx, me, other)
 	if err != nil {
 		return err
 	}
 	if relationship == nil {
 		relationship = &model.Relationship{
-			DonationTimes: 0,
+			DonationTimes:  0,
+			DonationAmount: types.NewCoinFromInt64(0),
 		}
 	}
+	// relationship stored before donation amount was tracked
+	if relationship.DonationAmount.IsNil() {
+		relationship.DonationAmount = types.NewCoinFromInt64(0)
+	}
+	accManager.storage.DeleteSupporterRank(ctx, me, relationship.DonationAmount, other)
 	relationship.DonationTimes++
+	relationship.DonationAmount = relationship.DonationAmount.Plus(amount)
 	if err := accManager.storage.SetRelationship(ctx, me, other, relationship); err != nil {
 		return err
 	}
+	supporter := &model.Supporter{
+		Username:       other,
+		DonationTimes:  relationship.DonationTimes,
+		DonationAmount: relationship.DonationAmount,
+	}
+	if err := accManager.storage.SetSupporterRank(ctx, me, supporter); err != nil {
+		return err
+	}
 	return nil
 }
 
+// RebuildSupporterRanks - index supporters of relationships created before supporter rank,
+// donation amount of these relationships is backfilled from donationAmounts
+func (accManager AccountManager) RebuildSupporterRanks(
+	ctx sdk.Context, donationAmounts map[types.AccountKey]map[types.AccountKey]types.Coin) sdk.Error {
+	return accManager.storage.RebuildSupporterRanks(ctx, donationAmounts)
+}
+
 // AuthorizePermission - userA authorize permission to userB (currently only support auth to a developer)
 func (accManager AccountManager) AuthorizePermission(
 	ctx sdk.Context, me types.AccountKey, authorizedUser types.AccountKey,
@@ -1055,6 +1106,81 @@ func (accManager AccountManager) updateTXFromPendingCoinDayQueue(
 	return nil
 }
 
+// GetPendingReturns - get coin returns which haven't been fully paid to the user
+func (accManager AccountManager) GetPendingReturns(
+	ctx sdk.Context, username types.AccountKey) ([]model.PendingReturn, sdk.Error) {
+	pendingReturns, err := accManager.storage.GetPendingReturns(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	if pendingReturns == nil {
+		return []model.PendingReturn{}, nil
+	}
+	return pendingReturns.Returns, nil
+}
+
+// AddScheduledPendingReturn - track a coin return event scheduled before pending returns
+// were tracked, each such event is paid in one installment at returnAt
+func (accManager AccountManager) AddScheduledPendingReturn(
+	ctx sdk.Context, username types.AccountKey, returnType types.TransferDetailType,
+	coin types.Coin, returnAt int64) (int64, sdk.Error) {
+	return accManager.addPendingReturn(ctx, username, returnType, coin, returnAt, 0, 1)
+}
+
+func (accManager AccountManager) addPendingReturn(
+	ctx sdk.Context, username types.AccountKey, returnType types.TransferDetailType,
+	coin types.Coin, nextReturnAt, interval, times int64) (int64, sdk.Error) {
+	pendingReturns, err := accManager.storage.GetPendingReturns(ctx, username)
+	if err != nil {
+		return 0, err
+	}
+	if pendingReturns == nil {
+		pendingReturns = &model.PendingReturns{NextReturnID: 1}
+	}
+	returnID := pendingReturns.NextReturnID
+	pendingReturns.NextReturnID++
+	pendingReturns.Returns = append(pendingReturns.Returns, model.PendingReturn{
+		ReturnID:       returnID,
+		ReturnType:     returnType,
+		Remaining:      coin,
+		NextReturnAt:   nextReturnAt,
+		RemainingTimes: times,
+		IntervalSec:    interval,
+	})
+	if err := accManager.storage.SetPendingReturns(ctx, username, pendingReturns); err != nil {
+		return 0, err
+	}
+	return returnID, nil
+}
+
+// settlePendingReturn - update pending return after one installment is paid,
+// pending return is removed after last installment
+func (accManager AccountManager) settlePendingReturn(
+	ctx sdk.Context, username types.AccountKey, returnID int64, amount types.Coin) sdk.Error {
+	pendingReturns, err := accManager.storage.GetPendingReturns(ctx, username)
+	if err != nil {
+		return err
+	}
+	if pendingReturns == nil {
+		return nil
+	}
+	for i, pendingReturn := range pendingReturns.Returns {
+		if pendingReturn.ReturnID != returnID {
+			continue
+		}
+		pendingReturn.Remaining = pendingReturn.Remaining.Minus(amount)
+		pendingReturn.RemainingTimes--
+		pendingReturn.NextReturnAt += pendingReturn.IntervalSec
+		if pendingReturn.RemainingTimes <= 0 {
+			pendingReturns.Returns = append(pendingReturns.Returns[:i], pendingReturns.Returns[i+1:]...)
+		} else {
+			pendingReturns.Returns[i] = pendingReturn
+		}
+		return accManager.storage.SetPendingReturns(ctx, username, pendingReturns)
+	}
+	return nil
+}
+
 // AddFrozenMoney - add frozen money to user's frozen money list
 func (accManager AccountManager) AddFrozenMoney(
 	ctx sdk.Context, username types.AccountKey,
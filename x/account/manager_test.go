@@ -2316,7 +2316,7 @@ func TestDonationRelationship(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		err := am.UpdateDonationRelationship(ctx, tc.user, tc.donateTo)
+		err := am.UpdateDonationRelationship(ctx, tc.user, tc.donateTo, types.NewCoinFromInt64(1))
 		if err != nil {
 			t.Errorf("%s: failed to update donation relationship, got err %v", tc.testName, err)
 		}
@@ -2331,6 +2331,34 @@ func TestDonationRelationship(t *testing.T) {
 	}
 }
 
+func TestSupporterRank(t *testing.T) {
+	ctx, am, _ := setupTest(t, 1)
+	creator := types.AccountKey("creator")
+	user1 := types.AccountKey("user1")
+	user2 := types.AccountKey("user2")
+
+	createTestAccount(ctx, am, string(creator))
+	createTestAccount(ctx, am, string(user1))
+	createTestAccount(ctx, am, string(user2))
+
+	err := am.UpdateDonationRelationship(ctx, creator, user1, types.NewCoinFromInt64(300))
+	assert.Nil(t, err)
+	err = am.UpdateDonationRelationship(ctx, creator, user2, types.NewCoinFromInt64(200))
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Supporter{
+		{Username: user1, DonationTimes: 1, DonationAmount: types.NewCoinFromInt64(300)},
+		{Username: user2, DonationTimes: 1, DonationAmount: types.NewCoinFromInt64(200)},
+	}, getSupporterRank(t, ctx, creator))
+
+	// user2 donates again and becomes the top supporter
+	err = am.UpdateDonationRelationship(ctx, creator, user2, types.NewCoinFromInt64(200))
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Supporter{
+		{Username: user2, DonationTimes: 2, DonationAmount: types.NewCoinFromInt64(400)},
+		{Username: user1, DonationTimes: 1, DonationAmount: types.NewCoinFromInt64(300)},
+	}, getSupporterRank(t, ctx, creator))
+}
+
 func TestAccountRecoverNormalCase(t *testing.T) {
 	testName := "TestAccountRecoverNormalCase"
 
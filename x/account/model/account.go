@@ -112,7 +112,16 @@ type RewardHistory struct {
 
 // Relationship - relation between two users
 type Relationship struct {
-	DonationTimes int64 `json:"donation_times"`
+	DonationTimes  int64      `json:"donation_times"`
+	DonationAmount types.Coin `json:"donation_amount"`
+}
+
+// Supporter - aggregated donations from a supporter to a creator,
+// ranked by donation amount in supporter rank
+type Supporter struct {
+	Username       types.AccountKey `json:"username"`
+	DonationTimes  int64            `json:"donation_times"`
+	DonationAmount types.Coin       `json:"donation_amount"`
 }
 
 // BalanceHistory - records all transactions belong to the user
@@ -134,3 +143,20 @@ type Detail struct {
 	CreatedAt  int64                    `json:"created_at"`
 	Memo       string                   `json:"memo"`
 }
+
+// PendingReturns - coin returns which haven't been fully paid to the user,
+// NextReturnID is the id of next created pending return
+type PendingReturns struct {
+	Returns      []PendingReturn `json:"returns"`
+	NextReturnID int64           `json:"next_return_id"`
+}
+
+// PendingReturn - coin returned to the user in installments
+type PendingReturn struct {
+	ReturnID       int64                    `json:"return_id"`
+	ReturnType     types.TransferDetailType `json:"return_type"`
+	Remaining      types.Coin               `json:"remaining"`
+	NextReturnAt   int64                    `json:"next_return_at"`
+	RemainingTimes int64                    `json:"remaining_times"`
+	IntervalSec    int64                    `json:"interval_second"`
+}
@@ -137,3 +137,23 @@ func ErrFailedToUnmarshalBalanceHistory(err error) sdk.Error {
 func ErrFailedToUnmarshalRewardHistory(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalRewardHistory, fmt.Sprintf("failed to unmarshal reward history: %s", err.Error()))
 }
+
+// ErrFailedToMarshalSupporter - error if marshal supporter failed
+func ErrFailedToMarshalSupporter(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalSupporter, fmt.Sprintf("failed to marshal supporter: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalSupporter - error if unmarshal supporter failed
+func ErrFailedToUnmarshalSupporter(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalSupporter, fmt.Sprintf("failed to unmarshal supporter: %s", err.Error()))
+}
+
+// ErrFailedToMarshalPendingReturns - error if marshal pending returns failed
+func ErrFailedToMarshalPendingReturns(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalPendingReturns, fmt.Sprintf("failed to marshal pending returns: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalPendingReturns - error if unmarshal pending returns failed
+func ErrFailedToUnmarshalPendingReturns(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalPendingReturns, fmt.Sprintf("failed to unmarshal pending returns: %s", err.Error()))
+}
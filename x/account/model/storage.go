@@ -3,6 +3,7 @@ package model
 import (
 	"encoding/hex"
 	"strconv"
+	"strings"
 
 	"github.com/lino-network/lino/types"
 	crypto "github.com/tendermint/tendermint/crypto"
@@ -23,8 +24,13 @@ var (
 	accountBalanceHistorySubstore      = []byte{0x08}
 	accountGrantPubKeySubstore         = []byte{0x09}
 	accountRewardHistorySubstore       = []byte{0x0a}
+	accountSupporterRankSubstore       = []byte{0x0b}
+	accountPendingReturnsSubstore      = []byte{0x0c}
 )
 
+// amountIndexWidth - digits of zero padded coin amount in supporter rank
+const amountIndexWidth = 40
+
 // AccountStorage - account storage
 type AccountStorage struct {
 	// The (unexposed) key used to access the store from the Context.
@@ -288,6 +294,70 @@ func (as AccountStorage) SetRelationship(ctx sdk.Context, me types.AccountKey, o
 	return nil
 }
 
+// SetSupporterRank - index supporter of a user by total donation amount
+func (as AccountStorage) SetSupporterRank(ctx sdk.Context, me types.AccountKey, supporter *Supporter) sdk.Error {
+	store := ctx.KVStore(as.key)
+	supporterByte, err := as.cdc.MarshalJSON(*supporter)
+	if err != nil {
+		return ErrFailedToMarshalSupporter(err)
+	}
+	store.Set(getSupporterRankKey(me, supporter.DonationAmount, supporter.Username), supporterByte)
+	return nil
+}
+
+// DeleteSupporterRank - remove supporter from supporter rank of a user
+func (as AccountStorage) DeleteSupporterRank(
+	ctx sdk.Context, me types.AccountKey, amount types.Coin, supporter types.AccountKey) {
+	store := ctx.KVStore(as.key)
+	store.Delete(getSupporterRankKey(me, amount, supporter))
+}
+
+// RebuildSupporterRanks - index supporters of all relationships. Donation amount
+// of relationship stored before the amount was tracked is taken from donationAmounts,
+// which is keyed by the user receiving donations and then the supporter
+func (as AccountStorage) RebuildSupporterRanks(
+	ctx sdk.Context, donationAmounts map[types.AccountKey]map[types.AccountKey]types.Coin) sdk.Error {
+	store := ctx.KVStore(as.key)
+	iter := sdk.KVStorePrefixIterator(store, accountRelationshipSubstore)
+	var keys [][]byte
+	var relationships []Relationship
+	for ; iter.Valid(); iter.Next() {
+		var relationship Relationship
+		if err := as.cdc.UnmarshalJSON(iter.Value(), &relationship); err != nil {
+			iter.Close()
+			return ErrFailedToUnmarshalRelationship(err)
+		}
+		keys = append(keys, iter.Key())
+		relationships = append(relationships, relationship)
+	}
+	iter.Close()
+
+	for i, key := range keys {
+		// key is "relationship substore" + "me" + "/" + "other", username has no separator
+		names := strings.SplitN(string(key[len(accountRelationshipSubstore):]), types.KeySeparator, 2)
+		me, other := types.AccountKey(names[0]), types.AccountKey(names[1])
+		relationship := relationships[i]
+		if relationship.DonationAmount.IsNil() {
+			relationship.DonationAmount = types.NewCoinFromInt64(0)
+			if amount, exist := donationAmounts[me][other]; exist {
+				relationship.DonationAmount = amount
+			}
+			if err := as.SetRelationship(ctx, me, other, &relationship); err != nil {
+				return err
+			}
+		}
+		supporter := &Supporter{
+			Username:       other,
+			DonationTimes:  relationship.DonationTimes,
+			DonationAmount: relationship.DonationAmount,
+		}
+		if err := as.SetSupporterRank(ctx, me, supporter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetRelationship - returns the relationship between two accounts
 func (as AccountStorage) GetBalanceHistory(
 	ctx sdk.Context, me types.AccountKey, bucketSlot int64) (*BalanceHistory, sdk.Error) {
@@ -349,6 +419,32 @@ func (as AccountStorage) DeleteRewardHistory(ctx sdk.Context, me types.AccountKe
 	return
 }
 
+// GetPendingReturns - returns pending coin returns of a user, returns nil if not exist
+func (as AccountStorage) GetPendingReturns(ctx sdk.Context, me types.AccountKey) (*PendingReturns, sdk.Error) {
+	store := ctx.KVStore(as.key)
+	pendingReturnsBytes := store.Get(GetPendingReturnsKey(me))
+	if pendingReturnsBytes == nil {
+		return nil, nil
+	}
+	pendingReturns := new(PendingReturns)
+	if err := as.cdc.UnmarshalJSON(pendingReturnsBytes, pendingReturns); err != nil {
+		return nil, ErrFailedToUnmarshalPendingReturns(err)
+	}
+	return pendingReturns, nil
+}
+
+// SetPendingReturns - sets pending coin returns of a user
+func (as AccountStorage) SetPendingReturns(
+	ctx sdk.Context, me types.AccountKey, pendingReturns *PendingReturns) sdk.Error {
+	store := ctx.KVStore(as.key)
+	pendingReturnsBytes, err := as.cdc.MarshalJSON(*pendingReturns)
+	if err != nil {
+		return ErrFailedToMarshalPendingReturns(err)
+	}
+	store.Set(GetPendingReturnsKey(me), pendingReturnsBytes)
+	return nil
+}
+
 // GetAccountInfoPrefix - "account info substore"
 func GetAccountInfoPrefix() []byte {
 	return accountInfoSubstore
@@ -398,6 +494,23 @@ func getRelationshipPrefix(me types.AccountKey) []byte {
 	return append(append(accountRelationshipSubstore, me...), types.KeySeparator...)
 }
 
+// GetSupporterRankPrefix - "supporter rank substore" + "me"
+func GetSupporterRankPrefix(me types.AccountKey) []byte {
+	return append(append(accountSupporterRankSubstore, me...), types.KeySeparator...)
+}
+
+// GetSupporterRankCursor - zero padded "amount" + "supporter" + "/", which keeps
+// supporter rank sorted by amount. It's also the pagination cursor of a supporter
+func GetSupporterRankCursor(amount types.Coin, supporter types.AccountKey) string {
+	amountStr := amount.Amount.String()
+	return strings.Repeat("0", amountIndexWidth-len(amountStr)) + amountStr +
+		types.KeySeparator + string(supporter) + types.KeySeparator
+}
+
+func getSupporterRankKey(me types.AccountKey, amount types.Coin, supporter types.AccountKey) []byte {
+	return append(GetSupporterRankPrefix(me), GetSupporterRankCursor(amount, supporter)...)
+}
+
 func getPendingCoinDayQueueKey(accKey types.AccountKey) []byte {
 	return append(accountPendingCoinDayQueueSubstore, accKey...)
 }
@@ -449,3 +562,8 @@ func (as AccountStorage) IterateAccounts(ctx sdk.Context, process func(AccountIn
 		iter.Next()
 	}
 }
+
+// GetPendingReturnsKey - "pending returns substore" + "username"
+func GetPendingReturnsKey(me types.AccountKey) []byte {
+	return append(accountPendingReturnsSubstore, me...)
+}
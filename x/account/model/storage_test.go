@@ -108,7 +108,7 @@ func TestAccountRelationShip(t *testing.T) {
 	as := NewAccountStorage(TestKVStoreKey)
 	ctx := getContext()
 
-	relationship := Relationship{}
+	relationship := Relationship{DonationTimes: 1, DonationAmount: types.NewCoinFromInt64(100)}
 	err := as.SetRelationship(
 		ctx, types.AccountKey("me"), types.AccountKey("other"), &relationship)
 	assert.Nil(t, err)
@@ -118,6 +118,80 @@ func TestAccountRelationShip(t *testing.T) {
 	assert.Equal(t, relationship, *resultPtr, "Account relationship should be equal")
 }
 
+func TestSupporterRank(t *testing.T) {
+	as := NewAccountStorage(TestKVStoreKey)
+	ctx := getContext()
+	me := types.AccountKey("me")
+	supporter1 := Supporter{Username: "user1", DonationTimes: 1, DonationAmount: types.NewCoinFromInt64(100)}
+	supporter2 := Supporter{Username: "user2", DonationTimes: 3, DonationAmount: types.NewCoinFromInt64(2000)}
+	supporter3 := Supporter{Username: "user3", DonationTimes: 2, DonationAmount: types.NewCoinFromInt64(300)}
+
+	for _, supporter := range []Supporter{supporter1, supporter2, supporter3} {
+		err := as.SetSupporterRank(ctx, me, &supporter)
+		assert.Nil(t, err)
+	}
+	err := as.SetSupporterRank(ctx, types.AccountKey("other"), &supporter1)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []Supporter{supporter2, supporter3, supporter1}, getSupporterRank(t, as, ctx, me))
+
+	as.DeleteSupporterRank(ctx, me, supporter2.DonationAmount, supporter2.Username)
+	assert.Equal(t, []Supporter{supporter3, supporter1}, getSupporterRank(t, as, ctx, me))
+}
+
+func TestRebuildSupporterRanks(t *testing.T) {
+	as := NewAccountStorage(TestKVStoreKey)
+	ctx := getContext()
+	me := types.AccountKey("me")
+	user1 := types.AccountKey("user1")
+	user2 := types.AccountKey("user2")
+	user3 := types.AccountKey("user3")
+
+	// relationship stored before donation amount was tracked
+	store := ctx.KVStore(TestKVStoreKey)
+	store.Set(getRelationshipKey(me, user1), []byte(`{"donation_times":"2"}`))
+	relationship, err := as.GetRelationship(ctx, me, user1)
+	assert.Nil(t, err)
+	assert.True(t, relationship.DonationAmount.IsNil())
+	err = as.SetRelationship(
+		ctx, me, user2, &Relationship{DonationTimes: 1, DonationAmount: types.NewCoinFromInt64(100)})
+	assert.Nil(t, err)
+
+	store.Set(getRelationshipKey(me, user3), []byte(`{"donation_times":"1"}`))
+
+	// donation amount of relationship stored before amount was tracked comes from donations,
+	// tracked donation amount is kept
+	donationAmounts := map[types.AccountKey]map[types.AccountKey]types.Coin{
+		me: {user1: types.NewCoinFromInt64(300), user2: types.NewCoinFromInt64(1)},
+	}
+	err = as.RebuildSupporterRanks(ctx, donationAmounts)
+	assert.Nil(t, err)
+	relationship, err = as.GetRelationship(ctx, me, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, Relationship{DonationTimes: 2, DonationAmount: types.NewCoinFromInt64(300)}, *relationship)
+	relationship, err = as.GetRelationship(ctx, me, user3)
+	assert.Nil(t, err)
+	assert.Equal(t, Relationship{DonationTimes: 1, DonationAmount: types.NewCoinFromInt64(0)}, *relationship)
+	assert.Equal(t, []Supporter{
+		{Username: user1, DonationTimes: 2, DonationAmount: types.NewCoinFromInt64(300)},
+		{Username: user2, DonationTimes: 1, DonationAmount: types.NewCoinFromInt64(100)},
+		{Username: user3, DonationTimes: 1, DonationAmount: types.NewCoinFromInt64(0)},
+	}, getSupporterRank(t, as, ctx, me))
+}
+
+// getSupporterRank - supporters of me from the largest donation amount to the smallest
+func getSupporterRank(t *testing.T, as AccountStorage, ctx sdk.Context, me types.AccountKey) []Supporter {
+	supporters := []Supporter{}
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(TestKVStoreKey), GetSupporterRankPrefix(me))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var supporter Supporter
+		assert.Nil(t, as.cdc.UnmarshalJSON(iter.Value(), &supporter))
+		supporters = append([]Supporter{supporter}, supporters...)
+	}
+	return supporters
+}
+
 func TestAccountBalanceHistory(t *testing.T) {
 	as := NewAccountStorage(TestKVStoreKey)
 	ctx := getContext()
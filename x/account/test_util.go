@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -190,3 +191,17 @@ func checkRewardHistory(
 		t.Errorf("%s: diff account rewards, got %v, want %v", testName, len(rewardHistoryPtr.Details), wantNumOfReward)
 	}
 }
+
+// getSupporterRank - supporters of me from the largest donation amount to the smallest
+func getSupporterRank(t *testing.T, ctx sdk.Context, me types.AccountKey) []model.Supporter {
+	supporters := []model.Supporter{}
+	cdc := wire.NewCodec()
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(testAccountKVStoreKey), model.GetSupporterRankPrefix(me))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var supporter model.Supporter
+		assert.Nil(t, cdc.UnmarshalJSON(iter.Value(), &supporter))
+		supporters = append([]model.Supporter{supporter}, supporters...)
+	}
+	return supporters
+}
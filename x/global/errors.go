@@ -21,3 +21,13 @@ func ErrAmountOfConsumptionExponent() sdk.Error {
 func ErrGetPastDay() sdk.Error {
 	return types.NewError(types.CodeFailedToGetAmountOfConsumptionExponent, "get past day failed")
 }
+
+// ErrIllegalSubscriptionInterval - error if subscription interval isn't positive
+func ErrIllegalSubscriptionInterval(intervalSec int64) sdk.Error {
+	return types.NewError(types.CodeIllegalSubscriptionInterval, fmt.Sprintf("illegal subscription interval %v", intervalSec))
+}
+
+// ErrCommunityPoolInsufficient - error if community pool doesn't have enough coin to spend
+func ErrCommunityPoolInsufficient(pool, spend types.Coin) sdk.Error {
+	return types.NewError(types.CodeCommunityPoolInsufficient, fmt.Sprintf("community pool %v is less than %v", pool, spend))
+}
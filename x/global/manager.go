@@ -140,6 +140,33 @@ func (gm GlobalManager) RemoveTimeEventList(ctx sdk.Context, unixTime int64) sdk
 	return gm.storage.RemoveTimeEventList(ctx, unixTime)
 }
 
+// UpdateTimeEvents - replace each scheduled time event with the result of update,
+// events are visited in the order they will be executed
+func (gm GlobalManager) UpdateTimeEvents(
+	ctx sdk.Context, update func(unixTime int64, event types.Event) (types.Event, sdk.Error)) sdk.Error {
+	times, err := gm.storage.GetTimeEventListTimes(ctx)
+	if err != nil {
+		return err
+	}
+	for _, unixTime := range times {
+		eventList, err := gm.storage.GetTimeEventList(ctx, unixTime)
+		if err != nil {
+			return err
+		}
+		for i, event := range eventList.Events {
+			updated, err := update(unixTime, event)
+			if err != nil {
+				return err
+			}
+			eventList.Events[i] = updated
+		}
+		if err := gm.storage.SetTimeEventList(ctx, unixTime, eventList); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetConsumptionFrictionRate - get consumption friction rate
 func (gm GlobalManager) GetConsumptionFrictionRate(ctx sdk.Context) (sdk.Rat, sdk.Error) {
 	consumptionMeta, err := gm.storage.GetConsumptionMeta(ctx)
@@ -226,6 +253,19 @@ func (gm GlobalManager) MinusLinoStakeFromStat(ctx sdk.Context, linoStake types.
 	return nil
 }
 
+// GetTotalLinoStake - get total lino power at current day
+func (gm GlobalManager) GetTotalLinoStake(ctx sdk.Context) (types.Coin, sdk.Error) {
+	pastDay, err := gm.GetPastDay(ctx, ctx.BlockHeader().Time.Unix())
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	linoStakeStat, err := gm.storage.GetLinoStakeStat(ctx, pastDay)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return linoStakeStat.TotalLinoStake, nil
+}
+
 // GetInterestSince - get interest from unix time till now (exclusive)
 func (gm GlobalManager) GetInterestSince(ctx sdk.Context, unixTime int64, linoStake types.Coin) (types.Coin, sdk.Error) {
 	startDay, err := gm.GetPastDay(ctx, unixTime)
@@ -301,6 +341,39 @@ func (gm GlobalManager) RegisterProposalDecideEvent(
 	return nil
 }
 
+// RegisterDeferredContentRewardEvent - register a content reward event again after
+// consumption freezing period, consumption window is not changed since it's
+// already counted when the event was first registered
+func (gm GlobalManager) RegisterDeferredContentRewardEvent(ctx sdk.Context, event types.Event) sdk.Error {
+	consumptionMeta, err := gm.storage.GetConsumptionMeta(ctx)
+	if err != nil {
+		return err
+	}
+	if err := gm.registerEventAtTime(
+		ctx, ctx.BlockHeader().Time.Unix()+consumptionMeta.ConsumptionFreezingPeriodSec, event); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RegisterSubscriptionEvent - register next subscription payment event,
+// which will be executed after one subscription interval
+func (gm GlobalManager) RegisterSubscriptionEvent(ctx sdk.Context, event types.Event) sdk.Error {
+	postParam, err := gm.paramHolder.GetPostParam(ctx)
+	if err != nil {
+		return err
+	}
+	// zero interval would execute the payment again in the same block
+	if postParam.SubscriptionIntervalSec <= 0 {
+		return ErrIllegalSubscriptionInterval(postParam.SubscriptionIntervalSec)
+	}
+	if err := gm.registerEventAtTime(
+		ctx, ctx.BlockHeader().Time.Unix()+postParam.SubscriptionIntervalSec, event); err != nil {
+		return err
+	}
+	return nil
+}
+
 // RegisterParamChangeEvent - register parameter change event
 func (gm GlobalManager) RegisterParamChangeEvent(ctx sdk.Context, event types.Event) sdk.Error {
 	// param will be changed in one day
@@ -362,8 +435,11 @@ func (gm GlobalManager) DistributeHourlyInflation(ctx sdk.Context) sdk.Error {
 		types.RatToCoin(thisHourInflation.ToRat().Mul(globalAllocation.ValidatorAllocation))
 	infraInflation :=
 		types.RatToCoin(thisHourInflation.ToRat().Mul(globalAllocation.InfraAllocation))
+	communityInflation :=
+		types.RatToCoin(thisHourInflation.ToRat().Mul(globalAllocation.CommunityPoolAllocation))
 	developerInflation :=
-		thisHourInflation.Minus(contentCreatorInflation).Minus(validatorInflation).Minus(infraInflation)
+		thisHourInflation.Minus(contentCreatorInflation).Minus(validatorInflation).
+			Minus(infraInflation).Minus(communityInflation)
 	consumptionMeta.ConsumptionRewardPool = consumptionMeta.ConsumptionRewardPool.Plus(contentCreatorInflation)
 	if err := gm.storage.SetConsumptionMeta(ctx, consumptionMeta); err != nil {
 		return err
@@ -373,6 +449,7 @@ func (gm GlobalManager) DistributeHourlyInflation(ctx sdk.Context) sdk.Error {
 	pool.InfraInflationPool = pool.InfraInflationPool.Plus(infraInflation)
 	pool.ValidatorInflationPool = pool.ValidatorInflationPool.Plus(validatorInflation)
 	pool.DeveloperInflationPool = pool.DeveloperInflationPool.Plus(developerInflation)
+	pool.CommunityPool = pool.CommunityPool.Plus(communityInflation)
 	if err := gm.storage.SetInflationPool(ctx, pool); err != nil {
 		return err
 	}
@@ -484,6 +561,74 @@ func (gm GlobalManager) AddToValidatorInflationPool(ctx sdk.Context, coin types.
 	return nil
 }
 
+// AddToCommunityPool - add coin to community pool
+func (gm GlobalManager) AddToCommunityPool(ctx sdk.Context, coin types.Coin) sdk.Error {
+	pool, err := gm.storage.GetInflationPool(ctx)
+	if err != nil {
+		return err
+	}
+	pool.CommunityPool = pool.CommunityPool.Plus(coin)
+	if err := gm.storage.SetInflationPool(ctx, pool); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetCommunityPool - get coin in community pool
+func (gm GlobalManager) GetCommunityPool(ctx sdk.Context) (types.Coin, sdk.Error) {
+	pool, err := gm.storage.GetInflationPool(ctx)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return pool.CommunityPool, nil
+}
+
+// SpendFromCommunityPool - take coin out of community pool, spent coin is counted in total lino coin
+func (gm GlobalManager) SpendFromCommunityPool(ctx sdk.Context, coin types.Coin) sdk.Error {
+	pool, err := gm.storage.GetInflationPool(ctx)
+	if err != nil {
+		return err
+	}
+	if !pool.CommunityPool.IsGTE(coin) {
+		return ErrCommunityPoolInsufficient(pool.CommunityPool, coin)
+	}
+	pool.CommunityPool = pool.CommunityPool.Minus(coin)
+	if err := gm.addTotalLinoCoin(ctx, coin); err != nil {
+		return err
+	}
+	if err := gm.storage.SetInflationPool(ctx, pool); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ReturnValidatorInflation - return unearned validator inflation to pool,
+// returned coin is not counted in total lino coin till it is issued again
+func (gm GlobalManager) ReturnValidatorInflation(ctx sdk.Context, coin types.Coin) sdk.Error {
+	globalMeta, err := gm.storage.GetGlobalMeta(ctx)
+	if err != nil {
+		return err
+	}
+	globalMeta.TotalLinoCoin = globalMeta.TotalLinoCoin.Minus(coin)
+	if err := gm.storage.SetGlobalMeta(ctx, globalMeta); err != nil {
+		return err
+	}
+	return gm.AddToValidatorInflationPool(ctx, coin)
+}
+
+// BurnCoin - remove coin from circulation, burned coin is deducted from total lino coin
+func (gm GlobalManager) BurnCoin(ctx sdk.Context, coin types.Coin) sdk.Error {
+	globalMeta, err := gm.storage.GetGlobalMeta(ctx)
+	if err != nil {
+		return err
+	}
+	globalMeta.TotalLinoCoin = globalMeta.TotalLinoCoin.Minus(coin)
+	if err := gm.storage.SetGlobalMeta(ctx, globalMeta); err != nil {
+		return err
+	}
+	return nil
+}
+
 // GetValidatorHourlyInflation - get validator hourly inflation
 func (gm GlobalManager) GetValidatorHourlyInflation(ctx sdk.Context) (types.Coin, sdk.Error) {
 	pool, err := gm.storage.GetInflationPool(ctx)
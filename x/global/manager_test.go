@@ -1056,6 +1056,40 @@ func TestGetValidatorHourlyInflation(t *testing.T) {
 	assert.Equal(t, globalMeta.TotalLinoCoin, types.NewCoinFromInt64(10000*types.Decimals).Plus(totalValidatorInflation))
 }
 
+func TestReturnValidatorInflation(t *testing.T) {
+	ctx, gm := setupTest(t)
+	totalValidatorInflation := types.NewCoinFromInt64(10000 * 100)
+	unearned := types.NewCoinFromInt64(1000 * 100)
+	inflationPool := &model.InflationPool{
+		ValidatorInflationPool: totalValidatorInflation,
+	}
+	err := gm.storage.SetInflationPool(ctx, inflationPool)
+	assert.Nil(t, err)
+	_, err = gm.GetValidatorHourlyInflation(ctx)
+	assert.Nil(t, err)
+	err = gm.ReturnValidatorInflation(ctx, unearned)
+	assert.Nil(t, err)
+
+	pool, err := gm.storage.GetInflationPool(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, unearned, pool.ValidatorInflationPool)
+	globalMeta, err := gm.storage.GetGlobalMeta(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, globalMeta.TotalLinoCoin,
+		types.NewCoinFromInt64(10000*types.Decimals).Plus(totalValidatorInflation).Minus(unearned))
+}
+
+func TestBurnCoin(t *testing.T) {
+	ctx, gm := setupTest(t)
+	burned := types.NewCoinFromInt64(1000 * types.Decimals)
+	err := gm.BurnCoin(ctx, burned)
+	assert.Nil(t, err)
+
+	globalMeta, err := gm.storage.GetGlobalMeta(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(10000*types.Decimals).Minus(burned), globalMeta.TotalLinoCoin)
+}
+
 func TestGetInfraMonthlyInflation(t *testing.T) {
 	ctx, gm := setupTest(t)
 	totalInfraInflation := types.NewCoinFromInt64(10000 * 100)
@@ -1136,6 +1170,76 @@ func TestAddToValidatorInflationPool(t *testing.T) {
 	}
 }
 
+func TestCommunityPool(t *testing.T) {
+	ctx, gm := setupTest(t)
+	inflationPool := &model.InflationPool{
+		CommunityPool: types.NewCoinFromInt64(0),
+	}
+	err := gm.storage.SetInflationPool(ctx, inflationPool)
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName        string
+		add             types.Coin
+		spend           types.Coin
+		expectErr       sdk.Error
+		expectPool      types.Coin
+		expectTotalLino types.Coin
+	}{
+		{
+			testName:        "add 100 to community pool",
+			add:             types.NewCoinFromInt64(100),
+			spend:           types.NewCoinFromInt64(0),
+			expectErr:       nil,
+			expectPool:      types.NewCoinFromInt64(100),
+			expectTotalLino: types.NewCoinFromInt64(10000 * types.Decimals),
+		},
+		{
+			testName:        "spend 40 from community pool",
+			add:             types.NewCoinFromInt64(0),
+			spend:           types.NewCoinFromInt64(40),
+			expectErr:       nil,
+			expectPool:      types.NewCoinFromInt64(60),
+			expectTotalLino: types.NewCoinFromInt64(10000*types.Decimals + 40),
+		},
+		{
+			testName:        "spend more than community pool",
+			add:             types.NewCoinFromInt64(0),
+			spend:           types.NewCoinFromInt64(61),
+			expectErr:       ErrCommunityPoolInsufficient(types.NewCoinFromInt64(60), types.NewCoinFromInt64(61)),
+			expectPool:      types.NewCoinFromInt64(60),
+			expectTotalLino: types.NewCoinFromInt64(10000*types.Decimals + 40),
+		},
+	}
+
+	for _, tc := range testCases {
+		err := gm.AddToCommunityPool(ctx, tc.add)
+		if err != nil {
+			t.Errorf("%s: failed to add to community pool, got err %v", tc.testName, err)
+		}
+		err = gm.SpendFromCommunityPool(ctx, tc.spend)
+		if !assert.Equal(t, tc.expectErr, err) {
+			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.expectErr)
+		}
+		pool, err := gm.storage.GetInflationPool(ctx)
+		if err != nil {
+			t.Errorf("%s: failed to get inflation pool, got err %v", tc.testName, err)
+		}
+		if !pool.CommunityPool.IsEqual(tc.expectPool) {
+			t.Errorf("%s: diff community pool, got %v, want %v", tc.testName,
+				pool.CommunityPool, tc.expectPool)
+		}
+		globalMeta, err := gm.storage.GetGlobalMeta(ctx)
+		if err != nil {
+			t.Errorf("%s: failed to get global meta, got err %v", tc.testName, err)
+		}
+		if !globalMeta.TotalLinoCoin.IsEqual(tc.expectTotalLino) {
+			t.Errorf("%s: diff total lino coin, got %v, want %v", tc.testName,
+				globalMeta.TotalLinoCoin, tc.expectTotalLino)
+		}
+	}
+}
+
 func TestAddConsumption(t *testing.T) {
 	ctx, gm := setupTest(t)
 
@@ -1425,6 +1529,20 @@ func TestMinusLinoStakeFromStat(t *testing.T) {
 	}
 }
 
+func TestGetTotalLinoStake(t *testing.T) {
+	ctx, gm := setupTest(t)
+
+	totalStake, err := gm.GetTotalLinoStake(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(0), totalStake)
+
+	err = gm.AddLinoStakeToStat(ctx, types.NewCoinFromInt64(10*types.Decimals))
+	assert.Nil(t, err)
+	totalStake, err = gm.GetTotalLinoStake(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(10*types.Decimals), totalStake)
+}
+
 func TestAddToDeveloperInflationPool(t *testing.T) {
 	ctx, gm := setupTest(t)
 
@@ -1757,3 +1875,48 @@ func TestRegisterParamChangeEvent(t *testing.T) {
 		assert.Equal(t, timeEventList.Events, tc.expectEventList)
 	}
 }
+
+func TestRegisterSubscriptionEvent(t *testing.T) {
+	ctx, gm := setupTest(t)
+	baseTime := time.Now().Unix()
+	postParam, _ := gm.paramHolder.GetPostParam(ctx)
+
+	testCases := []struct {
+		testName        string
+		atTime          int64
+		expectEventList []types.Event
+	}{
+		{
+			testName: "register subscription event at empty time slot",
+			atTime:   baseTime,
+			expectEventList: []types.Event{
+				testEvent{},
+			},
+		},
+		{
+			testName: "register second subscription event",
+			atTime:   baseTime,
+			expectEventList: []types.Event{
+				testEvent{},
+				testEvent{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(tc.atTime, 0)})
+		err := gm.RegisterSubscriptionEvent(ctx, testEvent{})
+		if err != nil {
+			t.Errorf("%s: failed to register subscription event, got err %v", tc.testName, err)
+		}
+		timeEventList := gm.GetTimeEventListAtTime(ctx, tc.atTime+postParam.SubscriptionIntervalSec)
+		assert.Equal(t, timeEventList.Events, tc.expectEventList)
+	}
+
+	// zero interval is rejected instead of registering at current time
+	postParam.SubscriptionIntervalSec = 0
+	err := param.ChangeParamEvent{Param: *postParam}.Execute(ctx, gm.paramHolder)
+	assert.Nil(t, err)
+	err = gm.RegisterSubscriptionEvent(ctx, testEvent{})
+	assert.Equal(t, ErrIllegalSubscriptionInterval(0), err)
+}
@@ -42,10 +42,12 @@ type TPS struct {
 // DistributedContentCreatorInflationPool inflation alrady distributed
 // DeveloperInflationPool inflation pool for developer
 // ValidatorInflationPool inflation pool for validator
+// CommunityPool pool funded by inflation and penalties, spent by proposal
 type InflationPool struct {
 	InfraInflationPool     types.Coin `json:"infra_inflation_pool"`
 	DeveloperInflationPool types.Coin `json:"developer_inflation_pool"`
 	ValidatorInflationPool types.Coin `json:"validator_inflation_pool"`
+	CommunityPool          types.Coin `json:"community_pool"`
 }
 
 // ConsumptionMeta
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"sort"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/wire"
@@ -42,6 +43,7 @@ func NewGlobalStorage(key sdk.StoreKey) GlobalStorage {
 	cdc.RegisterConcrete(param.BandwidthParam{}, "param/bandwidth", nil)
 	cdc.RegisterConcrete(param.AccountParam{}, "param/account", nil)
 	cdc.RegisterConcrete(param.PostParam{}, "param/post", nil)
+	cdc.RegisterConcrete(param.ReputationParam{}, "param/reputation", nil)
 
 	wire.RegisterCrypto(cdc)
 	return GlobalStorage{
@@ -150,6 +152,29 @@ func (gs GlobalStorage) RemoveTimeEventList(ctx sdk.Context, unixTime int64) sdk
 	return nil
 }
 
+// GetTimeEventListTimes - get unix time of all scheduled time event lists in ascending order
+func (gs GlobalStorage) GetTimeEventListTimes(ctx sdk.Context) ([]int64, sdk.Error) {
+	store := ctx.KVStore(gs.key)
+	iter := sdk.KVStorePrefixIterator(store, timeEventListSubStore)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	times := []int64{}
+	for _, key := range keys {
+		// key is "time event list substore" + "unix time", keys are not sorted by time
+		unixTime, err := strconv.ParseInt(string(key[len(timeEventListSubStore):]), 10, 64)
+		if err != nil {
+			return nil, ErrFailedToUnmarshalTimeEventList(err)
+		}
+		times = append(times, unixTime)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+	return times, nil
+}
+
 // SetLinoStakeStat - set lino power statistic at given day
 func (gs GlobalStorage) SetLinoStakeStat(ctx sdk.Context, day int64, lps *LinoStakeStat) sdk.Error {
 	store := ctx.KVStore(gs.key)
@@ -211,6 +236,10 @@ func (gs GlobalStorage) GetInflationPool(ctx sdk.Context) (*InflationPool, sdk.E
 	if err := gs.cdc.UnmarshalJSON(inflationPoolBytes, inflationPool); err != nil {
 		return nil, ErrFailedToUnmarshalInflationPool(err)
 	}
+	// community pool is not stored in inflation pool before it was introduced
+	if inflationPool.CommunityPool.IsNil() {
+		inflationPool.CommunityPool = types.NewCoinFromInt64(0)
+	}
 	return inflationPool, nil
 }
 
@@ -73,6 +73,49 @@ func TestGlobalStorageGenesis(t *testing.T) {
 		InfraInflationPool:     types.NewCoinFromInt64(0),
 		DeveloperInflationPool: types.NewCoinFromInt64(0),
 		ValidatorInflationPool: types.NewCoinFromInt64(0),
+		CommunityPool:          types.NewCoinFromInt64(0),
 	}
 	checkGlobalStorage(t, ctx, gm, globalMeta, consumptionMeta, inflationPool)
 }
+
+func TestInflationPoolWithoutCommunityPool(t *testing.T) {
+	gm := NewGlobalStorage(TestGlobalKVStoreKey)
+	ctx := getContext()
+
+	// inflation pool stored before community pool was introduced
+	store := ctx.KVStore(TestGlobalKVStoreKey)
+	store.Set(GetInflationPoolKey(), []byte(
+		`{"infra_inflation_pool":{"amount":"1"},"developer_inflation_pool":{"amount":"2"},"validator_inflation_pool":{"amount":"3"}}`))
+
+	inflationPool, err := gm.GetInflationPool(ctx)
+	assert.Nil(t, err)
+	assert.True(t, inflationPool.CommunityPool.IsZero())
+	assert.True(t, inflationPool.InfraInflationPool.IsEqual(types.NewCoinFromInt64(1)))
+
+	inflationPool.CommunityPool = inflationPool.CommunityPool.Plus(types.NewCoinFromInt64(4))
+	err = gm.SetInflationPool(ctx, inflationPool)
+	assert.Nil(t, err)
+	inflationPool, err = gm.GetInflationPool(ctx)
+	assert.Nil(t, err)
+	assert.True(t, inflationPool.CommunityPool.IsEqual(types.NewCoinFromInt64(4)))
+}
+
+func TestGetTimeEventListTimes(t *testing.T) {
+	gm := NewGlobalStorage(TestGlobalKVStoreKey)
+	ctx := getContext()
+	err := InitGlobalStorage(t, ctx, gm)
+	assert.Nil(t, err)
+
+	times, err := gm.GetTimeEventListTimes(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{}, times)
+
+	// keys of these times are not in time order
+	for _, unixTime := range []int64{100, 9, 20} {
+		err := gm.SetTimeEventList(ctx, unixTime, &types.TimeEventList{Events: []types.Event{}})
+		assert.Nil(t, err)
+	}
+	times, err = gm.GetTimeEventListTimes(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{9, 20, 100}, times)
+}
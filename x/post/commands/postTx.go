@@ -29,6 +29,7 @@ func PostTxCmd(cdc *wire.Codec) *cobra.Command {
 	cmd.Flags().String(client.FlagSourceAuthor, "", "source post author name")
 	cmd.Flags().String(client.FlagSourcePostID, "", "source post id")
 	cmd.Flags().String(client.FlagRedistributionSplitRate, "0", "redistribution split rate")
+	cmd.Flags().StringSlice(client.FlagTags, nil, "comma separated tags of the post")
 	return cmd
 }
 
@@ -47,6 +48,7 @@ func sendPostTx(cdc *wire.Codec) client.CommandTxCallback {
 			SourceAuthor:            types.AccountKey(viper.GetString(client.FlagSourceAuthor)),
 			SourcePostID:            viper.GetString(client.FlagSourcePostID),
 			RedistributionSplitRate: viper.GetString(client.FlagRedistributionSplitRate),
+			Tags:                    viper.GetStringSlice(client.FlagTags),
 		}
 
 		// build and sign the transaction, then broadcast to Tendermint
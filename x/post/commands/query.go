@@ -3,9 +3,11 @@ package commands
 import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/client/core"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/post/model"
 )
@@ -40,23 +42,14 @@ func (c commander) getPostCmd(cmd *cobra.Command, args []string) error {
 	postID := args[1]
 	postKey := types.GetPermlink(types.AccountKey(author), postID)
 
-	res, err := ctx.Query(model.GetPostInfoKey(postKey), c.storeName)
+	postInfo, err := c.getPostInfo(ctx, postKey)
 	if err != nil {
 		return err
 	}
-	postInfo := new(model.PostInfo)
-	if err := c.cdc.UnmarshalJSON(res, postInfo); err != nil {
-		return err
-	}
-
-	res, err = ctx.Query(model.GetPostMetaKey(postKey), c.storeName)
+	postMeta, err := c.getPostMeta(ctx, postKey)
 	if err != nil {
 		return err
 	}
-	postMeta := new(model.PostMeta)
-	if err := c.cdc.UnmarshalJSON(res, postMeta); err != nil {
-		return err
-	}
 
 	if err := client.PrintIndent(postInfo, postMeta); err != nil {
 		return err
@@ -65,18 +58,23 @@ func (c commander) getPostCmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-// GetPostsCmd returns a query post that will display the
-// info and meta of the post at a given author and postID
+// GetPostsCmd returns a query command that will display
+// posts or comments of an author from newest to oldest
 func GetPostsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	cmdr := commander{
 		storeName,
 		cdc,
 	}
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "posts <author>",
 		Short: "Query posts of an author",
 		RunE:  cmdr.getPostsCmd,
 	}
+	cmd.Flags().Bool(client.FlagComment, false, "query comments instead of top level posts")
+	cmd.Flags().Bool(client.FlagSkipDeleted, false, "skip deleted posts")
+	cmd.Flags().String(client.FlagCursor, "", "cursor returned by previous page, empty for the newest posts")
+	cmd.Flags().Int(client.FlagLimit, 20, "max number of posts in one page")
+	return cmd
 }
 
 func (c commander) getPostsCmd(cmd *cobra.Command, args []string) error {
@@ -88,21 +86,220 @@ func (c commander) getPostsCmd(cmd *cobra.Command, args []string) error {
 	// find the key to look up the account
 	author := types.AccountKey(args[0])
 
-	resKVs, err := ctx.QuerySubspace(
-		c.cdc, append(model.GetPostInfoPrefix(author), types.PermlinkSeparator...), c.storeName)
+	prefix := model.GetAuthorPostIndexPrefix(author, viper.GetBool(client.FlagComment))
+	resKVs, err := ctx.QuerySubspace(c.cdc, prefix, c.storeName)
+	if err != nil {
+		return err
+	}
+
+	var skip func([]byte) (bool, error)
+	if viper.GetBool(client.FlagSkipDeleted) {
+		skip = func(permlink []byte) (bool, error) {
+			postMeta, err := c.getPostMeta(ctx, types.Permlink(permlink))
+			if err != nil {
+				return false, err
+			}
+			return postMeta.IsDeleted, nil
+		}
+	}
+	permlinks, nextCursor, err := client.PageIndex(
+		resKVs, prefix, viper.GetString(client.FlagCursor), viper.GetInt(client.FlagLimit), skip)
 	if err != nil {
 		return err
 	}
 	var posts []model.PostInfo
+	for _, permlink := range permlinks {
+		info, err := c.getPostInfo(ctx, types.Permlink(permlink))
+		if err != nil {
+			return err
+		}
+		posts = append(posts, *info)
+	}
+
+	if err := client.PrintIndent(posts, nextCursor); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSubscriptionsCmd returns a query command that will display
+// all ongoing subscriptions to a creator
+func GetSubscriptionsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "subscriptions <creator>",
+		Short: "Query subscriptions to a creator",
+		RunE:  cmdr.getSubscriptionsCmd,
+	}
+}
+
+func (c commander) getSubscriptionsCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide an valid creator")
+	}
+
+	creator := types.AccountKey(args[0])
+
+	resKVs, err := ctx.QuerySubspace(c.cdc, model.GetSubscriptionPrefix(creator), c.storeName)
+	if err != nil {
+		return err
+	}
+	var subscriptions []model.Subscription
 	for _, KV := range resKVs {
-		var info model.PostInfo
-		if err := c.cdc.UnmarshalJSON(KV.Value, &info); err != nil {
+		var subscription model.Subscription
+		if err := c.cdc.UnmarshalJSON(KV.Value, &subscription); err != nil {
+			return err
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	if err := client.PrintIndent(subscriptions); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetPostsByTagCmd returns a query command that will display
+// posts with a given tag from newest to oldest
+func GetPostsByTagCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "posts-by-tag <tag>",
+		Short: "Query posts with a tag",
+		RunE:  cmdr.getPostsByTagCmd,
+	}
+	cmd.Flags().String(client.FlagCursor, "", "cursor returned by previous page, empty for the newest posts")
+	cmd.Flags().Int(client.FlagLimit, 20, "max number of posts in one page")
+	return cmd
+}
+
+func (c commander) getPostsByTagCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide an valid tag")
+	}
+
+	prefix := model.GetPostTagPrefix(args[0])
+	resKVs, err := ctx.QuerySubspace(c.cdc, prefix, c.storeName)
+	if err != nil {
+		return err
+	}
+	// hidden post is kept in tag index till it's censored
+	skipHidden := func(permlink []byte) (bool, error) {
+		postMeta, err := c.getPostMeta(ctx, types.Permlink(permlink))
+		if err != nil {
+			return false, err
+		}
+		return postMeta.IsHidden(), nil
+	}
+	permlinks, nextCursor, err := client.PageIndex(
+		resKVs, prefix, viper.GetString(client.FlagCursor), viper.GetInt(client.FlagLimit), skipHidden)
+	if err != nil {
+		return err
+	}
+
+	posts := []model.PostInfo{}
+	for _, permlink := range permlinks {
+		info, err := c.getPostInfo(ctx, types.Permlink(permlink))
+		if err != nil {
+			return err
+		}
+		posts = append(posts, *info)
+	}
+
+	if err := client.PrintIndent(posts, nextCursor); err != nil {
+		return err
+	}
+	return nil
+}
+
+// getPostInfo - get post info, content of hidden post is not shown
+func (c commander) getPostInfo(ctx core.CoreContext, permlink types.Permlink) (*model.PostInfo, error) {
+	res, err := ctx.Query(model.GetPostInfoKey(permlink), c.storeName)
+	if err != nil {
+		return nil, err
+	}
+	postInfo := new(model.PostInfo)
+	if err := c.cdc.UnmarshalJSON(res, postInfo); err != nil {
+		return nil, err
+	}
+	postMeta, err := c.getPostMeta(ctx, permlink)
+	if err != nil {
+		return nil, err
+	}
+	if postMeta.IsHidden() {
+		postInfo.Title = ""
+		postInfo.Content = ""
+		postInfo.Links = nil
+		postInfo.Tags = nil
+	}
+	return postInfo, nil
+}
+
+func (c commander) getPostMeta(ctx core.CoreContext, permlink types.Permlink) (*model.PostMeta, error) {
+	res, err := ctx.Query(model.GetPostMetaKey(permlink), c.storeName)
+	if err != nil {
+		return nil, err
+	}
+	postMeta := new(model.PostMeta)
+	if err := c.cdc.UnmarshalJSON(res, postMeta); err != nil {
+		return nil, err
+	}
+	return postMeta, nil
+}
+
+// GetPostDonatorsCmd returns a query command that will display
+// donators of a post from the largest total amount to the smallest
+func GetPostDonatorsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "donators <author> <postID>",
+		Short: "Query top donators of a post",
+		RunE:  cmdr.getPostDonatorsCmd,
+	}
+	cmd.Flags().String(client.FlagCursor, "", "cursor returned by previous page, empty for the top donators")
+	cmd.Flags().Int(client.FlagLimit, 20, "max number of donators in one page")
+	return cmd
+}
+
+func (c commander) getPostDonatorsCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
+		return errors.New("You must provide an valid author and post id")
+	}
+
+	permlink := types.GetPermlink(types.AccountKey(args[0]), args[1])
+	prefix := model.GetPostDonationRankPrefix(permlink)
+	resKVs, err := ctx.QuerySubspace(c.cdc, prefix, c.storeName)
+	if err != nil {
+		return err
+	}
+	values, nextCursor, err := client.PageIndex(
+		resKVs, prefix, viper.GetString(client.FlagCursor), viper.GetInt(client.FlagLimit), nil)
+	if err != nil {
+		return err
+	}
+
+	donationsList := []model.Donations{}
+	for _, value := range values {
+		var donations model.Donations
+		if err := c.cdc.UnmarshalJSON(value, &donations); err != nil {
 			return err
 		}
-		posts = append(posts, info)
+		donationsList = append(donationsList, donations)
 	}
 
-	if err := client.PrintIndent(posts); err != nil {
+	if err := client.PrintIndent(donationsList, nextCursor); err != nil {
 		return err
 	}
 	return nil
@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	post "github.com/lino-network/lino/x/post"
+)
+
+// SubscribeTxCmd will create a subscribe tx and sign it with the given key
+func SubscribeTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscribe",
+		Short: "subscribe to a creator, pay to the target post every period",
+		RunE:  sendSubscribeTx(cdc),
+	}
+	cmd.Flags().String(client.FlagSubscriber, "", "subscriber of this transaction")
+	cmd.Flags().String(client.FlagCreator, "", "creator of the target post")
+	cmd.Flags().String(client.FlagPostID, "", "post id of the target post")
+	cmd.Flags().String(client.FlagAmount, "", "amount paid every period")
+	cmd.Flags().Int64(client.FlagPeriods, 1, "number of periods")
+	return cmd
+}
+
+// send subscribe transaction to the blockchain
+func sendSubscribeTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		msg := post.NewSubscribeMsg(
+			viper.GetString(client.FlagSubscriber), viper.GetString(client.FlagCreator),
+			viper.GetString(client.FlagPostID), types.LNO(viper.GetString(client.FlagAmount)),
+			viper.GetInt64(client.FlagPeriods), "")
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
+
+// CancelSubscriptionTxCmd will create a cancel subscription tx and sign it with the given key
+func CancelSubscriptionTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-subscription",
+		Short: "cancel subscription to a creator",
+		RunE:  sendCancelSubscriptionTx(cdc),
+	}
+	cmd.Flags().String(client.FlagSubscriber, "", "subscriber of this transaction")
+	cmd.Flags().String(client.FlagCreator, "", "creator to unsubscribe")
+	return cmd
+}
+
+// send cancel subscription transaction to the blockchain
+func sendCancelSubscriptionTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		msg := post.NewCancelSubscriptionMsg(
+			viper.GetString(client.FlagSubscriber), viper.GetString(client.FlagCreator))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -24,6 +24,7 @@ func UpdatePostTxCmd(cdc *wire.Codec) *cobra.Command {
 	cmd.Flags().String(client.FlagPostID, "", "post id to identify this post for the author")
 	cmd.Flags().String(client.FlagTitle, "", "title for the post")
 	cmd.Flags().String(client.FlagContent, "", "content for the post")
+	cmd.Flags().StringSlice(client.FlagTags, nil, "comma separated tags of the post, tags are unchanged if empty")
 	return cmd
 }
 
@@ -35,7 +36,7 @@ func sendUpdatePostTx(cdc *wire.Codec) client.CommandTxCallback {
 		msg := post.NewUpdatePostMsg(
 			viper.GetString(client.FlagAuthor), viper.GetString(client.FlagPostID),
 			viper.GetString(client.FlagTitle), viper.GetString(client.FlagContent),
-			[]types.IDToURLMapping(nil))
+			[]types.IDToURLMapping(nil), viper.GetStringSlice(client.FlagTags))
 
 		// build and sign the transaction, then broadcast to Tendermint
 		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
@@ -147,3 +147,53 @@ func ErrNoUsername() sdk.Error {
 func ErrInvalidMemo() sdk.Error {
 	return types.NewError(types.CodeInvalidMemo, fmt.Sprintf("invalid memo"))
 }
+
+// ErrSubscriptionNotFound - error when subscription is not found
+func ErrSubscriptionNotFound(subscriber, creator types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeSubscriptionNotFound, fmt.Sprintf("subscription from %v to %v is not found", subscriber, creator))
+}
+
+// ErrSubscriptionAlreadyExist - error when subscriber already subscribes to creator
+func ErrSubscriptionAlreadyExist(subscriber, creator types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeSubscriptionAlreadyExist, fmt.Sprintf("subscription from %v to %v already exists", subscriber, creator))
+}
+
+// ErrInvalidSubscriptionPeriods - error when number of subscription periods is invalid
+func ErrInvalidSubscriptionPeriods() sdk.Error {
+	return types.NewError(types.CodeInvalidSubscriptionPeriods, fmt.Sprintf("invalid subscription periods"))
+}
+
+// ErrSubscribePostIsDeleted - error when subscribe to a deleted post
+func ErrSubscribePostIsDeleted(permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodeSubscribePostIsDeleted, fmt.Sprintf("subscribe to post %s failed, post is deleted", permlink))
+}
+
+// ErrTooManyTags - error when post has too many tags
+func ErrTooManyTags() sdk.Error {
+	return types.NewError(types.CodeTooManyTags, fmt.Sprintf("too many tags"))
+}
+
+// ErrInvalidTag - error when post tag is invalid
+func ErrInvalidTag(tag string) sdk.Error {
+	return types.NewError(types.CodeInvalidTag, fmt.Sprintf("invalid tag: %v", tag))
+}
+
+// ErrInvalidModerationState - error when post can't be moderated in current moderation state
+func ErrInvalidModerationState(permlink types.Permlink, state types.ModerationState) sdk.Error {
+	return types.NewError(types.CodeInvalidModerationState, fmt.Sprintf("post %v can't be moderated in moderation state %v", permlink, state))
+}
+
+// ErrUpdatePostIsCensored - error when update a hidden or censored post
+func ErrUpdatePostIsCensored(permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodeUpdatePostIsCensored, fmt.Sprintf("update post failed, post %v is hidden or censored", permlink))
+}
+
+// ErrDonatePostIsCensored - error when donate to a hidden or censored post
+func ErrDonatePostIsCensored(permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodeDonatePostIsCensored, fmt.Sprintf("donate to post %s failed, post is hidden or censored", permlink))
+}
+
+// ErrSubscribePostIsCensored - error when subscribe to a hidden or censored post
+func ErrSubscribePostIsCensored(permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodeSubscribePostIsCensored, fmt.Sprintf("subscribe to post %s failed, post is hidden or censored", permlink))
+}
@@ -1,6 +1,8 @@
 package post
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/wire"
 
 	"github.com/lino-network/lino/types"
@@ -18,6 +20,7 @@ func init() {
 
 	cdc.RegisterInterface((*types.Event)(nil), nil)
 	cdc.RegisterConcrete(RewardEvent{}, "event/reward", nil)
+	cdc.RegisterConcrete(SubscriptionEvent{}, "event/subscription", nil)
 }
 
 // RewardEvent - when donation occurred, a reward event will be register
@@ -40,6 +43,10 @@ func (event RewardEvent) Execute(
 	vm vote.VoteManager, rm rep.ReputationManager) sdk.Error {
 
 	permlink := types.GetPermlink(event.PostAuthor, event.PostID)
+	// reward of a post under appeal is deferred until the appeal is decided
+	if isWithheld, err := pm.IsRewardWithheld(ctx, permlink); isWithheld && err == nil {
+		return gm.RegisterDeferredContentRewardEvent(ctx, event)
+	}
 	// check if post is deleted
 	rep, err := rm.GetSumRep(ctx, permlink)
 	if err != nil {
@@ -52,6 +59,9 @@ func (event RewardEvent) Execute(
 	if isDeleted, err := pm.IsDeleted(ctx, permlink); isDeleted || err != nil {
 		paneltyScore = sdk.OneRat()
 	}
+	if isCensored, err := pm.IsCensored(ctx, permlink); isCensored || err != nil {
+		paneltyScore = sdk.OneRat()
+	}
 	reward, err := gm.GetRewardAndPopFromWindow(ctx, event.Evaluate, paneltyScore)
 	if err != nil {
 		return err
@@ -77,3 +87,83 @@ func (event RewardEvent) Execute(
 	}
 	return nil
 }
+
+// SubscriptionEvent - pull one period payment of a subscription from
+// subscriber's saving and donate it to creator's post. If the subscription
+// can't be paid the subscription lapses.
+type SubscriptionEvent struct {
+	Subscriber   types.AccountKey `json:"subscriber"`
+	Creator      types.AccountKey `json:"creator"`
+	SubscribedAt int64            `json:"subscribed_at"`
+	Period       int64            `json:"period"`
+}
+
+// Execute - execute subscription event
+func (event SubscriptionEvent) Execute(
+	ctx sdk.Context, pm PostManager, am acc.AccountManager,
+	gm global.GlobalManager, rm rep.ReputationManager) sdk.Error {
+	// subscription has been cancelled or finished
+	if !pm.DoesSubscriptionExist(ctx, event.Creator, event.Subscriber) {
+		return nil
+	}
+	subscription, err := pm.GetSubscription(ctx, event.Creator, event.Subscriber)
+	if err != nil {
+		return err
+	}
+	// event belongs to a cancelled subscription or the period is already paid
+	if subscription.CreatedAt != event.SubscribedAt || subscription.PaidPeriods+1 != event.Period {
+		return nil
+	}
+
+	permlink := types.GetPermlink(subscription.Creator, subscription.PostID)
+	if !am.DoesAccountExist(ctx, event.Subscriber) || !am.DoesAccountExist(ctx, event.Creator) ||
+		!pm.DoesPostExist(ctx, permlink) {
+		return pm.CancelSubscription(ctx, event.Creator, event.Subscriber)
+	}
+	if isDeleted, err := pm.IsDeleted(ctx, permlink); isDeleted || err != nil {
+		return pm.CancelSubscription(ctx, event.Creator, event.Subscriber)
+	}
+	if isHidden, err := pm.IsHidden(ctx, permlink); isHidden || err != nil {
+		return pm.CancelSubscription(ctx, event.Creator, event.Subscriber)
+	}
+
+	coinDayBeforeDonate, err := am.GetCoinDay(ctx, event.Subscriber)
+	if err != nil {
+		return err
+	}
+	// subscription lapses if subscriber can't afford current period
+	if err := am.MinusSavingCoinWithFullCoinDay(
+		ctx, event.Subscriber, subscription.Amount, event.Creator,
+		fmt.Sprintf("subscribe to post: %v, period: %v/%v",
+			string(permlink), event.Period, subscription.TotalPeriods),
+		types.DonationOut); err != nil {
+		return pm.CancelSubscription(ctx, event.Creator, event.Subscriber)
+	}
+	coinDayAfterDonate, err := am.GetCoinDay(ctx, event.Subscriber)
+	if err != nil {
+		return err
+	}
+	if err := processDonation(
+		ctx, event.Subscriber, subscription.Amount, coinDayBeforeDonate.Minus(coinDayAfterDonate),
+		subscription.Creator, subscription.PostID, subscription.FromApp, am, pm, gm, rm); err != nil {
+		return err
+	}
+
+	finished, err := pm.AddSubscriptionPaidPeriod(ctx, event.Creator, event.Subscriber)
+	if err != nil {
+		return err
+	}
+	if finished {
+		return nil
+	}
+	// subscription lapses if next payment can't be scheduled
+	if err := gm.RegisterSubscriptionEvent(ctx, SubscriptionEvent{
+		Subscriber:   event.Subscriber,
+		Creator:      event.Creator,
+		SubscribedAt: event.SubscribedAt,
+		Period:       event.Period + 1,
+	}); err != nil {
+		return pm.CancelSubscription(ctx, event.Creator, event.Subscriber)
+	}
+	return nil
+}
@@ -197,3 +197,119 @@ func TestRewardEvent(t *testing.T) {
 		}
 	}
 }
+
+func TestSubscriptionEvent(t *testing.T) {
+	ctx, am, ph, pm, gm, dm, _, rm := setupTest(t, 1)
+	handler := NewHandler(pm, am, gm, dm, rm)
+	postParam, err := ph.GetPostParam(ctx)
+	assert.Nil(t, err)
+
+	creator, postID := createTestPost(t, ctx, "creator", "postID", am, pm, "0")
+	subscriber := createTestAccount(t, ctx, am, "subscriber")
+	err = am.AddSavingCoin(
+		ctx, subscriber, types.NewCoinFromInt64(25*types.Decimals), referrer, "", types.TransferIn)
+	assert.Nil(t, err)
+	result := handler(ctx, NewSubscribeMsg(string(subscriber), string(creator), postID, types.LNO("10"), 3, ""))
+	assert.Equal(t, sdk.Result{}, result)
+	subscribedAt := ctx.BlockHeader().Time.Unix()
+
+	testCases := []struct {
+		testName               string
+		event                  SubscriptionEvent
+		expectSubscriberSaving types.Coin
+		expectPaidPeriods      int64
+		expectLapsed           bool
+		expectRegisteredEvent  types.Event
+	}{
+		{
+			testName: "event of unpaid period is ignored",
+			event: SubscriptionEvent{
+				Subscriber: subscriber, Creator: creator, SubscribedAt: subscribedAt, Period: 3},
+			expectSubscriberSaving: types.NewCoinFromInt64(16 * types.Decimals),
+			expectPaidPeriods:      1,
+		},
+		{
+			testName: "event of previous subscription is ignored",
+			event: SubscriptionEvent{
+				Subscriber: subscriber, Creator: creator, SubscribedAt: subscribedAt - 1, Period: 2},
+			expectSubscriberSaving: types.NewCoinFromInt64(16 * types.Decimals),
+			expectPaidPeriods:      1,
+		},
+		{
+			testName: "pay second period",
+			event: SubscriptionEvent{
+				Subscriber: subscriber, Creator: creator, SubscribedAt: subscribedAt, Period: 2},
+			expectSubscriberSaving: types.NewCoinFromInt64(6 * types.Decimals),
+			expectPaidPeriods:      2,
+			expectRegisteredEvent: SubscriptionEvent{
+				Subscriber: subscriber, Creator: creator, SubscribedAt: subscribedAt, Period: 3},
+		},
+		{
+			testName: "subscription lapses if saving is insufficient",
+			event: SubscriptionEvent{
+				Subscriber: subscriber, Creator: creator, SubscribedAt: subscribedAt, Period: 3},
+			expectSubscriberSaving: types.NewCoinFromInt64(6 * types.Decimals),
+			expectLapsed:           true,
+		},
+		{
+			testName: "event of lapsed subscription is ignored",
+			event: SubscriptionEvent{
+				Subscriber: subscriber, Creator: creator, SubscribedAt: subscribedAt, Period: 3},
+			expectSubscriberSaving: types.NewCoinFromInt64(6 * types.Decimals),
+			expectLapsed:           true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.event.Execute(ctx, pm, am, gm, rm)
+		if err != nil {
+			t.Errorf("%s: failed to execute, got err %v", tc.testName, err)
+		}
+		saving, err := am.GetSavingFromBank(ctx, subscriber)
+		if err != nil {
+			t.Errorf("%s: failed to get saving from bank, got err %v", tc.testName, err)
+		}
+		if !saving.IsEqual(tc.expectSubscriberSaving) {
+			t.Errorf("%s: diff subscriber saving, got %v, want %v", tc.testName, saving, tc.expectSubscriberSaving)
+		}
+		if tc.expectLapsed {
+			assert.False(t, pm.DoesSubscriptionExist(ctx, creator, subscriber))
+			continue
+		}
+		subscription, err := pm.GetSubscription(ctx, creator, subscriber)
+		if err != nil {
+			t.Errorf("%s: failed to get subscription, got err %v", tc.testName, err)
+		}
+		if subscription.PaidPeriods != tc.expectPaidPeriods {
+			t.Errorf("%s: diff paid periods, got %v, want %v", tc.testName, subscription.PaidPeriods, tc.expectPaidPeriods)
+		}
+		if tc.expectRegisteredEvent != nil {
+			eventList := gm.GetTimeEventListAtTime(ctx, ctx.BlockHeader().Time.Unix()+postParam.SubscriptionIntervalSec)
+			assert.Equal(t, tc.expectRegisteredEvent, eventList.Events[len(eventList.Events)-1])
+		}
+	}
+}
+
+func TestSubscriptionEventOfCensoredPost(t *testing.T) {
+	ctx, am, _, pm, gm, dm, _, rm := setupTest(t, 1)
+	handler := NewHandler(pm, am, gm, dm, rm)
+
+	creator, postID := createTestPost(t, ctx, "creator", "postID", am, pm, "0")
+	subscriber := createTestAccount(t, ctx, am, "subscriber")
+	err := am.AddSavingCoin(
+		ctx, subscriber, types.NewCoinFromInt64(25*types.Decimals), referrer, "", types.TransferIn)
+	assert.Nil(t, err)
+	result := handler(ctx, NewSubscribeMsg(string(subscriber), string(creator), postID, types.LNO("10"), 3, ""))
+	assert.Equal(t, sdk.Result{}, result)
+
+	err = pm.CensorPost(ctx, types.GetPermlink(creator, postID))
+	assert.Nil(t, err)
+	event := SubscriptionEvent{
+		Subscriber: subscriber, Creator: creator, SubscribedAt: ctx.BlockHeader().Time.Unix(), Period: 2}
+	err = event.Execute(ctx, pm, am, gm, rm)
+	assert.Nil(t, err)
+	assert.False(t, pm.DoesSubscriptionExist(ctx, creator, subscriber))
+	saving, err := am.GetSavingFromBank(ctx, subscriber)
+	assert.Nil(t, err)
+	assert.True(t, saving.IsEqual(types.NewCoinFromInt64(16*types.Decimals)))
+}
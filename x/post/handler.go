@@ -31,6 +31,10 @@ func NewHandler(
 			return handleUpdatePostMsg(ctx, msg, pm, am)
 		case DeletePostMsg:
 			return handleDeletePostMsg(ctx, msg, pm, am)
+		case SubscribeMsg:
+			return handleSubscribeMsg(ctx, msg, pm, am, gm, dm, rm)
+		case CancelSubscriptionMsg:
+			return handleCancelSubscriptionMsg(ctx, msg, pm)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized post msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -76,7 +80,7 @@ func handleCreatePostMsg(ctx sdk.Context, msg CreatePostMsg, pm PostManager, am
 	if err := pm.CreatePost(
 		ctx, msg.Author, msg.PostID, msg.SourceAuthor, msg.SourcePostID,
 		msg.ParentAuthor, msg.ParentPostID, msg.Content, msg.Title,
-		splitRate, msg.Links); err != nil {
+		splitRate, msg.Links, msg.Tags); err != nil {
 		return err.Result()
 	}
 
@@ -120,6 +124,9 @@ func handleDonateMsg(
 	if isDeleted, err := pm.IsDeleted(ctx, permlink); isDeleted || err != nil {
 		return ErrDonatePostIsDeleted(permlink).Result()
 	}
+	if isHidden, err := pm.IsHidden(ctx, permlink); isHidden || err != nil {
+		return ErrDonatePostIsCensored(permlink).Result()
+	}
 
 	if msg.Username == msg.Author {
 		return ErrCannotDonateToSelf(msg.Username).Result()
@@ -147,30 +154,125 @@ func handleDonateMsg(
 		return err.Result()
 	}
 
-	totalCoinDayDonated := coinDayBeforeDonate.Minus(coinDayAfterDonate)
+	if err := processDonation(
+		ctx, msg.Username, coin, coinDayBeforeDonate.Minus(coinDayAfterDonate),
+		msg.Author, msg.PostID, msg.FromApp, am, pm, gm, rm); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// processDonation - split donation between post and its source post,
+// coin should already be withdrawn from donator's saving
+func processDonation(
+	ctx sdk.Context, donator types.AccountKey, coin types.Coin, totalCoinDayDonated types.Coin,
+	author types.AccountKey, postID string, fromApp types.AccountKey, am acc.AccountManager,
+	pm PostManager, gm global.GlobalManager, rm rep.ReputationManager) sdk.Error {
+	permlink := types.GetPermlink(author, postID)
 	sourceAuthor, sourcePostID, err := pm.GetSourcePost(ctx, permlink)
 	if err != nil {
-		return err.Result()
+		return err
 	}
 	if sourceAuthor != types.AccountKey("") && sourcePostID != "" {
 		sourcePermlink := types.GetPermlink(sourceAuthor, sourcePostID)
 
 		redistributionSplitRate, err := pm.GetRedistributionSplitRate(ctx, sourcePermlink)
 		if err != nil {
-			return err.Result()
+			return err
 		}
 		sourceIncome := types.RatToCoin(coin.ToRat().Mul(sdk.OneRat().Sub(redistributionSplitRate)))
 		coin = coin.Minus(sourceIncome)
 		sourceCoinDayGained := types.RatToCoin(totalCoinDayDonated.ToRat().Mul(sdk.OneRat().Sub(redistributionSplitRate)))
 		totalCoinDayDonated = totalCoinDayDonated.Minus(sourceCoinDayGained)
 		if err := processDonationFriction(
-			ctx, msg.Username, sourceIncome, sourceCoinDayGained, sourceAuthor, sourcePostID, msg.FromApp, am, pm, gm, rm); err != nil {
-			return ErrProcessSourceDonation(sourcePermlink).Result()
+			ctx, donator, sourceIncome, sourceCoinDayGained, sourceAuthor, sourcePostID, fromApp, am, pm, gm, rm); err != nil {
+			return ErrProcessSourceDonation(sourcePermlink)
 		}
 	}
 	if err := processDonationFriction(
-		ctx, msg.Username, coin, totalCoinDayDonated, msg.Author, msg.PostID, msg.FromApp, am, pm, gm, rm); err != nil {
-		return ErrProcessDonation(permlink).Result()
+		ctx, donator, coin, totalCoinDayDonated, author, postID, fromApp, am, pm, gm, rm); err != nil {
+		return ErrProcessDonation(permlink)
+	}
+	return nil
+}
+
+// Handle SubscribeMsg
+func handleSubscribeMsg(
+	ctx sdk.Context, msg SubscribeMsg, pm PostManager, am acc.AccountManager,
+	gm global.GlobalManager, dm dev.DeveloperManager, rm rep.ReputationManager) sdk.Result {
+	permlink := types.GetPermlink(msg.Creator, msg.PostID)
+	coin, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	if !am.DoesAccountExist(ctx, msg.Subscriber) {
+		return ErrAccountNotFound(msg.Subscriber).Result()
+	}
+	if !pm.DoesPostExist(ctx, permlink) {
+		return ErrPostNotFound(permlink).Result()
+	}
+	if isDeleted, err := pm.IsDeleted(ctx, permlink); isDeleted || err != nil {
+		return ErrSubscribePostIsDeleted(permlink).Result()
+	}
+	if isHidden, err := pm.IsHidden(ctx, permlink); isHidden || err != nil {
+		return ErrSubscribePostIsCensored(permlink).Result()
+	}
+	if msg.Subscriber == msg.Creator {
+		return ErrCannotDonateToSelf(msg.Subscriber).Result()
+	}
+	if msg.FromApp != "" {
+		if !dm.DoesDeveloperExist(ctx, msg.FromApp) {
+			return ErrDeveloperNotFound(msg.FromApp).Result()
+		}
+	}
+	if pm.DoesSubscriptionExist(ctx, msg.Creator, msg.Subscriber) {
+		return ErrSubscriptionAlreadyExist(msg.Subscriber, msg.Creator).Result()
+	}
+
+	// first period is paid when subscribing
+	coinDayBeforeDonate, err := am.GetCoinDay(ctx, msg.Subscriber)
+	if err != nil {
+		return err.Result()
+	}
+	if err := am.MinusSavingCoinWithFullCoinDay(
+		ctx, msg.Subscriber, coin, msg.Creator,
+		fmt.Sprintf("subscribe to post: %v, period: 1/%v", string(permlink), msg.Periods),
+		types.DonationOut); err != nil {
+		return err.Result()
+	}
+	coinDayAfterDonate, err := am.GetCoinDay(ctx, msg.Subscriber)
+	if err != nil {
+		return err.Result()
+	}
+	if err := processDonation(
+		ctx, msg.Subscriber, coin, coinDayBeforeDonate.Minus(coinDayAfterDonate),
+		msg.Creator, msg.PostID, msg.FromApp, am, pm, gm, rm); err != nil {
+		return err.Result()
+	}
+	if msg.Periods == 1 {
+		return sdk.Result{}
+	}
+
+	if err := pm.CreateSubscription(
+		ctx, msg.Subscriber, msg.Creator, msg.PostID, coin, msg.Periods, msg.FromApp); err != nil {
+		return err.Result()
+	}
+	if err := gm.RegisterSubscriptionEvent(ctx, SubscriptionEvent{
+		Subscriber:   msg.Subscriber,
+		Creator:      msg.Creator,
+		SubscribedAt: ctx.BlockHeader().Time.Unix(),
+		Period:       2,
+	}); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// Handle CancelSubscriptionMsg
+func handleCancelSubscriptionMsg(
+	ctx sdk.Context, msg CancelSubscriptionMsg, pm PostManager) sdk.Result {
+	if err := pm.CancelSubscription(ctx, msg.Creator, msg.Subscriber); err != nil {
+		return err.Result()
 	}
 	return sdk.Result{}
 }
@@ -228,7 +330,7 @@ func processDonationFriction(
 	if err := gm.AddConsumption(ctx, coin); err != nil {
 		return err
 	}
-	if err := am.UpdateDonationRelationship(ctx, postAuthor, consumer); err != nil {
+	if err := am.UpdateDonationRelationship(ctx, postAuthor, consumer, directDeposit); err != nil {
 		return err
 	}
 	return nil
@@ -278,6 +380,13 @@ func handleReportOrUpvoteMsg(
 		if _, err := rm.ReportAt(ctx, msg.Username, permlink); err != nil {
 			return err.Result()
 		}
+		coinDay, err := am.GetCoinDay(ctx, msg.Username)
+		if err != nil {
+			return err.Result()
+		}
+		if err := pm.ReportPost(ctx, permlink, msg.Username, coinDay); err != nil {
+			return err.Result()
+		}
 	}
 	if err := pm.UpdateLastActivityAt(ctx, permlink); err != nil {
 		return err.Result()
@@ -301,9 +410,12 @@ func handleUpdatePostMsg(
 	if isDeleted, err := pm.IsDeleted(ctx, permlink); isDeleted || err != nil {
 		return ErrUpdatePostIsDeleted(permlink).Result()
 	}
+	if isHidden, err := pm.IsHidden(ctx, permlink); isHidden || err != nil {
+		return ErrUpdatePostIsCensored(permlink).Result()
+	}
 
 	if err := pm.UpdatePost(
-		ctx, msg.Author, msg.PostID, msg.Title, msg.Content, msg.Links); err != nil {
+		ctx, msg.Author, msg.PostID, msg.Title, msg.Content, msg.Links, msg.Tags); err != nil {
 		return err.Result()
 	}
 	return sdk.Result{}
@@ -56,39 +56,48 @@ func TestHandlerCreatePost(t *testing.T) {
 }
 
 func TestHandlerUpdatePost(t *testing.T) {
-	ctx, am, _, pm, gm, dm, _, rm := setupTest(t, 1)
+	ctx, am, ph, pm, gm, dm, _, rm := setupTest(t, 1)
 	handler := NewHandler(pm, am, gm, dm, rm)
 
 	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0")
 	user1, postID1 := createTestPost(t, ctx, "user1", "postID1", am, pm, "0")
 	user2 := createTestAccount(t, ctx, am, "user2")
+	user3, postID3 := createTestPost(t, ctx, "user3", "postID3", am, pm, "0")
 	err := pm.DeletePost(ctx, types.GetPermlink(user1, postID1))
 	assert.Nil(t, err)
+	postParam, err := ph.GetPostParam(ctx)
+	assert.Nil(t, err)
+	err = pm.ReportPost(ctx, types.GetPermlink(user3, postID3), user2, postParam.HideReportCoinDay)
+	assert.Nil(t, err)
 
 	testCases := map[string]struct {
 		msg        UpdatePostMsg
 		wantResult sdk.Result
 	}{
 		"normal update": {
-			msg:        NewUpdatePostMsg(string(user), postID, "update title", "update content", []types.IDToURLMapping(nil)),
+			msg:        NewUpdatePostMsg(string(user), postID, "update title", "update content", []types.IDToURLMapping(nil), nil),
 			wantResult: sdk.Result{},
 		},
 		"update author doesn't exist": {
-			msg:        NewUpdatePostMsg("invalid", postID, "update title", "update content", []types.IDToURLMapping(nil)),
+			msg:        NewUpdatePostMsg("invalid", postID, "update title", "update content", []types.IDToURLMapping(nil), nil),
 			wantResult: ErrAccountNotFound("invalid").Result(),
 		},
 		"update post doesn't exist - invalid post ID": {
-			msg:        NewUpdatePostMsg(string(user), "invalid", "update title", "update content", []types.IDToURLMapping(nil)),
+			msg:        NewUpdatePostMsg(string(user), "invalid", "update title", "update content", []types.IDToURLMapping(nil), nil),
 			wantResult: ErrPostNotFound(types.GetPermlink(user, "invalid")).Result(),
 		},
 		"update post doesn't exist - invalid author": {
-			msg:        NewUpdatePostMsg(string(user2), postID, "update title", "update content", []types.IDToURLMapping(nil)),
+			msg:        NewUpdatePostMsg(string(user2), postID, "update title", "update content", []types.IDToURLMapping(nil), nil),
 			wantResult: ErrPostNotFound(types.GetPermlink(user2, postID)).Result(),
 		},
 		"update deleted post": {
-			msg:        NewUpdatePostMsg(string(user1), postID1, "update title", "update content", []types.IDToURLMapping(nil)),
+			msg:        NewUpdatePostMsg(string(user1), postID1, "update title", "update content", []types.IDToURLMapping(nil), nil),
 			wantResult: ErrUpdatePostIsDeleted(types.GetPermlink(user1, postID1)).Result(),
 		},
+		"update hidden post": {
+			msg:        NewUpdatePostMsg(string(user3), postID3, "update title", "update content", []types.IDToURLMapping(nil), nil),
+			wantResult: ErrUpdatePostIsCensored(types.GetPermlink(user3, postID3)).Result(),
+		},
 	}
 	for testName, tc := range testCases {
 		result := handler(ctx, tc.msg)
@@ -360,8 +369,16 @@ func TestHandlerPostDonate(t *testing.T) {
 
 	author, postID := createTestPost(t, ctx, "author", "postID", am, pm, "0")
 	author1, deletedPostID := createTestPost(t, ctx, "author1", "delete", am, pm, "0")
+	author2, censoredPostID := createTestPost(t, ctx, "author2", "censored", am, pm, "0")
+	author3, hiddenPostID := createTestPost(t, ctx, "author3", "hidden", am, pm, "0")
 
 	pm.DeletePost(ctx, types.GetPermlink(author1, deletedPostID))
+	err = pm.CensorPost(ctx, types.GetPermlink(author2, censoredPostID))
+	assert.Nil(t, err)
+	postParam, err := ph.GetPostParam(ctx)
+	assert.Nil(t, err)
+	err = pm.ReportPost(ctx, types.GetPermlink(author3, hiddenPostID), "reporter", postParam.HideReportCoinDay)
+	assert.Nil(t, err)
 
 	userWithSufficientSaving := createTestAccount(t, ctx, am, "userWithSufficientSaving")
 	err = am.AddSavingCoin(
@@ -633,6 +650,22 @@ func TestHandlerPostDonate(t *testing.T) {
 			toPostID:   deletedPostID,
 			expectErr:  ErrDonatePostIsDeleted(types.GetPermlink(author1, deletedPostID)).Result(),
 		},
+		{
+			testName:   "donate to censored post",
+			donateUser: userWithSufficientSaving,
+			amount:     types.LNO("1"),
+			toAuthor:   author2,
+			toPostID:   censoredPostID,
+			expectErr:  ErrDonatePostIsCensored(types.GetPermlink(author2, censoredPostID)).Result(),
+		},
+		{
+			testName:   "donate to hidden post",
+			donateUser: userWithSufficientSaving,
+			amount:     types.LNO("1"),
+			toAuthor:   author3,
+			toPostID:   hiddenPostID,
+			expectErr:  ErrDonatePostIsCensored(types.GetPermlink(author3, hiddenPostID)).Result(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -894,12 +927,14 @@ func TestHandlerReportOrUpvote(t *testing.T) {
 		},
 	}
 
+	totalReportCoinDay := types.NewCoinFromInt64(0)
 	for _, tc := range testCases {
 		lastReportOrUpvoteAtCtx := ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(tc.lastReportOrUpvoteAt, 0)})
 		am.UpdateLastReportOrUpvoteAt(lastReportOrUpvoteAtCtx, types.AccountKey(tc.reportOrUpvoteUser))
 
 		newCtx := ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime, 0)})
 		msg := NewReportOrUpvoteMsg(tc.reportOrUpvoteUser, tc.targetPostAuthor, tc.targetPostID, tc.isReport)
+		coinDay, _ := am.GetCoinDay(newCtx, types.AccountKey(tc.reportOrUpvoteUser))
 
 		result := handler(newCtx, msg)
 		if !assert.Equal(t, tc.expectResult, result) {
@@ -908,6 +943,9 @@ func TestHandlerReportOrUpvote(t *testing.T) {
 		if tc.expectResult.Code != sdk.ABCICodeOK {
 			continue
 		}
+		if tc.isReport {
+			totalReportCoinDay = totalReportCoinDay.Plus(coinDay)
+		}
 
 		postMeta := model.PostMeta{
 			CreatedAt:               ctx.BlockHeader().Time.Unix(),
@@ -915,7 +953,7 @@ func TestHandlerReportOrUpvote(t *testing.T) {
 			LastActivityAt:          newCtx.BlockHeader().Time.Unix(),
 			AllowReplies:            true,
 			RedistributionSplitRate: sdk.ZeroRat(),
-			TotalReportCoinDay:      types.NewCoinFromInt64(0),
+			TotalReportCoinDay:      totalReportCoinDay,
 			TotalUpvoteCoinDay:      types.NewCoinFromInt64(0),
 			TotalReward:             types.NewCoinFromInt64(0),
 		}
@@ -1027,3 +1065,119 @@ func TestHandlerView(t *testing.T) {
 		}
 	}
 }
+
+func TestHandlerSubscribe(t *testing.T) {
+	ctx, am, ph, pm, gm, dm, _, rm := setupTest(t, 1)
+	handler := NewHandler(pm, am, gm, dm, rm)
+	postParam, err := ph.GetPostParam(ctx)
+	assert.Nil(t, err)
+
+	creator, postID := createTestPost(t, ctx, "creator", "postID", am, pm, "0")
+	creator1, deletedPostID := createTestPost(t, ctx, "creator1", "delete", am, pm, "0")
+	pm.DeletePost(ctx, types.GetPermlink(creator1, deletedPostID))
+	creator2, censoredPostID := createTestPost(t, ctx, "creator2", "censored", am, pm, "0")
+	err = pm.CensorPost(ctx, types.GetPermlink(creator2, censoredPostID))
+	assert.Nil(t, err)
+
+	subscriber := createTestAccount(t, ctx, am, "subscriber")
+	err = am.AddSavingCoin(
+		ctx, subscriber, types.NewCoinFromInt64(100*types.Decimals), referrer, "", types.TransferIn)
+	assert.Nil(t, err)
+	poorSubscriber := createTestAccount(t, ctx, am, "poorSubscriber")
+
+	testCases := []struct {
+		testName              string
+		msg                   SubscribeMsg
+		expectResult          sdk.Result
+		expectSubscription    *model.Subscription
+		expectRegisteredEvent types.Event
+	}{
+		{
+			testName:     "subscribe to deleted post",
+			msg:          NewSubscribeMsg(string(subscriber), string(creator1), deletedPostID, types.LNO("10"), 3, ""),
+			expectResult: ErrSubscribePostIsDeleted(types.GetPermlink(creator1, deletedPostID)).Result(),
+		},
+		{
+			testName:     "subscribe to censored post",
+			msg:          NewSubscribeMsg(string(subscriber), string(creator2), censoredPostID, types.LNO("10"), 3, ""),
+			expectResult: ErrSubscribePostIsCensored(types.GetPermlink(creator2, censoredPostID)).Result(),
+		},
+		{
+			testName:     "subscribe to self",
+			msg:          NewSubscribeMsg(string(creator), string(creator), postID, types.LNO("10"), 3, ""),
+			expectResult: ErrCannotDonateToSelf(creator).Result(),
+		},
+		{
+			testName:     "subscribe without sufficient saving",
+			msg:          NewSubscribeMsg(string(poorSubscriber), string(creator), postID, types.LNO("10"), 3, ""),
+			expectResult: acc.ErrAccountSavingCoinNotEnough().Result(),
+		},
+		{
+			testName:     "subscribe to non-exist post",
+			msg:          NewSubscribeMsg(string(subscriber), string(creator1), "invalid", types.LNO("10"), 1, ""),
+			expectResult: ErrPostNotFound(types.GetPermlink(creator1, "invalid")).Result(),
+		},
+		{
+			testName:     "normal subscribe",
+			msg:          NewSubscribeMsg(string(subscriber), string(creator), postID, types.LNO("10"), 3, ""),
+			expectResult: sdk.Result{},
+			expectSubscription: &model.Subscription{
+				Subscriber:   subscriber,
+				Creator:      creator,
+				PostID:       postID,
+				Amount:       types.NewCoinFromInt64(10 * types.Decimals),
+				TotalPeriods: 3,
+				PaidPeriods:  1,
+				CreatedAt:    ctx.BlockHeader().Time.Unix(),
+				LastPaidAt:   ctx.BlockHeader().Time.Unix(),
+			},
+			expectRegisteredEvent: SubscriptionEvent{
+				Subscriber:   subscriber,
+				Creator:      creator,
+				SubscribedAt: ctx.BlockHeader().Time.Unix(),
+				Period:       2,
+			},
+		},
+		{
+			testName:     "subscribe twice",
+			msg:          NewSubscribeMsg(string(subscriber), string(creator), postID, types.LNO("10"), 3, ""),
+			expectResult: ErrSubscriptionAlreadyExist(subscriber, creator).Result(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+		if tc.expectSubscription == nil {
+			continue
+		}
+		subscription, err := pm.GetSubscription(ctx, tc.msg.Creator, tc.msg.Subscriber)
+		if err != nil {
+			t.Errorf("%s: failed to get subscription, got err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, *tc.expectSubscription, *subscription) {
+			t.Errorf("%s: diff subscription, got %v, want %v", tc.testName, *subscription, *tc.expectSubscription)
+		}
+		eventList := gm.GetTimeEventListAtTime(ctx, ctx.BlockHeader().Time.Unix()+postParam.SubscriptionIntervalSec)
+		if !assert.Equal(t, tc.expectRegisteredEvent, eventList.Events[len(eventList.Events)-1]) {
+			t.Errorf("%s: diff event, got %v, want %v", tc.testName,
+				eventList.Events[len(eventList.Events)-1], tc.expectRegisteredEvent)
+		}
+		times, err := am.GetDonationRelationship(ctx, tc.msg.Creator, tc.msg.Subscriber)
+		if err != nil {
+			t.Errorf("%s: failed to get donation relationship, got err %v", tc.testName, err)
+		}
+		if times != 1 {
+			t.Errorf("%s: diff donate times, got %v, want %v", tc.testName, times, 1)
+		}
+	}
+
+	// cancel subscription
+	result := handler(ctx, NewCancelSubscriptionMsg(string(subscriber), string(creator)))
+	assert.Equal(t, sdk.Result{}, result)
+	assert.False(t, pm.DoesSubscriptionExist(ctx, creator, subscriber))
+	result = handler(ctx, NewCancelSubscriptionMsg(string(subscriber), string(creator)))
+	assert.Equal(t, ErrSubscriptionNotFound(subscriber, creator).Result(), result)
+}
@@ -39,6 +39,15 @@ func (pm PostManager) GetCreatedTimeAndReward(ctx sdk.Context, permlink types.Pe
 	return postMeta.CreatedAt, postMeta.TotalReward, nil
 }
 
+// GetAuthor - get author of a post
+func (pm PostManager) GetAuthor(ctx sdk.Context, permlink types.Permlink) (types.AccountKey, sdk.Error) {
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	if err != nil {
+		return types.AccountKey(""), err
+	}
+	return postInfo.Author, nil
+}
+
 // DoesPostExist - check if post exist
 func (pm PostManager) DoesPostExist(ctx sdk.Context, permlink types.Permlink) bool {
 	return pm.postStorage.DoesPostExist(ctx, permlink)
@@ -83,7 +92,7 @@ func (pm PostManager) CreatePost(
 	sourceAuthor types.AccountKey, sourcePostID string,
 	parentAuthor types.AccountKey, parentPostID string,
 	content string, title string, redistributionSplitRate sdk.Rat,
-	links []types.IDToURLMapping) sdk.Error {
+	links []types.IDToURLMapping, tags []string) sdk.Error {
 	postInfo := &model.PostInfo{
 		PostID:       postID,
 		Title:        title,
@@ -94,6 +103,7 @@ func (pm PostManager) CreatePost(
 		SourceAuthor: sourceAuthor,
 		SourcePostID: sourcePostID,
 		Links:        links,
+		Tags:         tags,
 	}
 	permlink := types.GetPermlink(postInfo.Author, postInfo.PostID)
 	if pm.DoesPostExist(ctx, permlink) {
@@ -116,13 +126,18 @@ func (pm PostManager) CreatePost(
 	if err := pm.postStorage.SetPostMeta(ctx, permlink, postMeta); err != nil {
 		return err
 	}
+	for _, tag := range tags {
+		pm.postStorage.SetPostTagIndex(ctx, tag, postMeta.CreatedAt, permlink)
+	}
+	pm.postStorage.SetAuthorPostIndex(ctx, author, parentAuthor != "", postMeta.CreatedAt, permlink)
 	return nil
 }
 
-// UpdatePost - update post title, content and links. Can't update a deleted post
+// UpdatePost - update post title, content, links and tags. Can't update a deleted post.
+// Tags are unchanged if no tag is given
 func (pm PostManager) UpdatePost(
 	ctx sdk.Context, author types.AccountKey, postID, title, content string,
-	links []types.IDToURLMapping) sdk.Error {
+	links []types.IDToURLMapping, tags []string) sdk.Error {
 	permlink := types.GetPermlink(author, postID)
 	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
 	if err != nil {
@@ -133,6 +148,16 @@ func (pm PostManager) UpdatePost(
 		return err
 	}
 
+	if len(tags) > 0 {
+		for _, tag := range postInfo.Tags {
+			pm.postStorage.DeletePostTagIndex(ctx, tag, postMeta.CreatedAt, permlink)
+		}
+		for _, tag := range tags {
+			pm.postStorage.SetPostTagIndex(ctx, tag, postMeta.CreatedAt, permlink)
+		}
+		postInfo.Tags = tags
+	}
+
 	postInfo.Title = title
 	postInfo.Content = content
 	postInfo.Links = links
@@ -206,11 +231,15 @@ func (pm PostManager) AddDonation(
 	if donations == nil {
 		donations = &model.Donations{Username: donator, Amount: types.NewCoinFromInt64(0), Times: 0}
 	}
+	pm.postStorage.DeletePostDonationRank(ctx, permlink, donations)
 	donations.Amount = donations.Amount.Plus(amount)
 	donations.Times = donations.Times + 1
 	if err := pm.postStorage.SetPostDonations(ctx, permlink, donations); err != nil {
 		return err
 	}
+	if err := pm.postStorage.SetPostDonationRank(ctx, permlink, donations); err != nil {
+		return err
+	}
 	postMeta.TotalReward = postMeta.TotalReward.Plus(amount)
 	postMeta.TotalDonateCount = postMeta.TotalDonateCount + 1
 	postMeta.LastActivityAt = ctx.BlockHeader().Time.Unix()
@@ -236,13 +265,18 @@ func (pm PostManager) DeletePost(ctx sdk.Context, permlink types.Permlink) sdk.E
 	if err != nil {
 		return err
 	}
+	for _, tag := range postInfo.Tags {
+		pm.postStorage.DeletePostTagIndex(ctx, tag, postMeta.CreatedAt, permlink)
+	}
 	postInfo.Title = ""
 	postInfo.Content = ""
 	postInfo.Links = nil
+	postInfo.Tags = nil
 
 	if err := pm.postStorage.SetPostInfo(ctx, postInfo); err != nil {
 		return err
 	}
+	pm.postStorage.DeleteCensoredPostInfo(ctx, permlink)
 	return nil
 }
 
@@ -268,6 +302,258 @@ func (pm PostManager) UpdateLastActivityAt(ctx sdk.Context, permlink types.Perml
 	return nil
 }
 
+// ReportPost - add reporter's coin day to post total report coin day, each user's
+// report is only counted once till reports are cleared. Visible or restored post
+// will be hidden pending review if total report coin day reaches the threshold
+func (pm PostManager) ReportPost(
+	ctx sdk.Context, permlink types.Permlink, user types.AccountKey, coinDay types.Coin) sdk.Error {
+	if report, _ := pm.postStorage.GetPostReportOrUpvote(ctx, permlink, user); report != nil && report.IsReport {
+		return nil
+	}
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	postParam, err := pm.paramHolder.GetPostParam(ctx)
+	if err != nil {
+		return err
+	}
+	report := &model.ReportOrUpvote{
+		Username:  user,
+		CoinDay:   coinDay,
+		CreatedAt: ctx.BlockHeader().Time.Unix(),
+		IsReport:  true,
+	}
+	if err := pm.postStorage.SetPostReportOrUpvote(ctx, permlink, report); err != nil {
+		return err
+	}
+	postMeta.TotalReportCoinDay = postMeta.TotalReportCoinDay.Plus(coinDay)
+	if (postMeta.ModerationState == types.PostVisible || postMeta.ModerationState == types.PostRestored) &&
+		postMeta.TotalReportCoinDay.IsGTE(postParam.HideReportCoinDay) {
+		postMeta.ModerationState = types.PostHidden
+	}
+	if err := pm.postStorage.SetPostMeta(ctx, permlink, postMeta); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetModerationState - get post moderation state
+func (pm PostManager) GetModerationState(
+	ctx sdk.Context, permlink types.Permlink) (types.ModerationState, sdk.Error) {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return types.PostVisible, err
+	}
+	return postMeta.ModerationState, nil
+}
+
+// IsHidden - check if a post is hidden pending review, censored or under appeal,
+// hidden post can't be updated or donated to
+func (pm PostManager) IsHidden(ctx sdk.Context, permlink types.Permlink) (bool, sdk.Error) {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return false, err
+	}
+	return postMeta.IsHidden(), nil
+}
+
+// IsCensored - check if a post is censored or under appeal
+func (pm PostManager) IsCensored(ctx sdk.Context, permlink types.Permlink) (bool, sdk.Error) {
+	state, err := pm.GetModerationState(ctx, permlink)
+	if err != nil {
+		return false, err
+	}
+	return state == types.PostCensored || state == types.PostAppealed, nil
+}
+
+// CensorPost - censor a visible, hidden or restored post after content censorship
+// passed. Post info is backed up so it can be restored if author's appeal passes
+func (pm PostManager) CensorPost(ctx sdk.Context, permlink types.Permlink) sdk.Error {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	if postMeta.ModerationState == types.PostCensored || postMeta.ModerationState == types.PostAppealed {
+		return ErrInvalidModerationState(permlink, postMeta.ModerationState)
+	}
+	if err := pm.hidePostInfo(ctx, permlink, postMeta); err != nil {
+		return err
+	}
+	postMeta.ModerationState = types.PostCensored
+	postMeta.CensoredAt = ctx.BlockHeader().Time.Unix()
+	postMeta.HasAppealed = false
+	postMeta.LastUpdatedAt = ctx.BlockHeader().Time.Unix()
+	if err := pm.postStorage.SetPostMeta(ctx, permlink, postMeta); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RejectCensorship - un-hide a hidden post after content censorship is rejected
+func (pm PostManager) RejectCensorship(ctx sdk.Context, permlink types.Permlink) sdk.Error {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	if postMeta.ModerationState != types.PostHidden {
+		return nil
+	}
+	if err := pm.clearReports(ctx, permlink, postMeta); err != nil {
+		return err
+	}
+	postMeta.ModerationState = types.PostVisible
+	if err := pm.postStorage.SetPostMeta(ctx, permlink, postMeta); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AppealPost - author appeals a hidden post, or a censored post within appeal period.
+// Each censorship can only be appealed once
+func (pm PostManager) AppealPost(ctx sdk.Context, permlink types.Permlink) sdk.Error {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	postParam, err := pm.paramHolder.GetPostParam(ctx)
+	if err != nil {
+		return err
+	}
+	switch postMeta.ModerationState {
+	case types.PostHidden:
+		postMeta.AppealedWhileHidden = true
+	case types.PostCensored:
+		if postMeta.HasAppealed ||
+			postMeta.CensoredAt+postParam.AppealPeriodSec < ctx.BlockHeader().Time.Unix() {
+			return ErrInvalidModerationState(permlink, postMeta.ModerationState)
+		}
+		postMeta.AppealedWhileHidden = false
+	default:
+		return ErrInvalidModerationState(permlink, postMeta.ModerationState)
+	}
+	postMeta.ModerationState = types.PostAppealed
+	postMeta.HasAppealed = true
+	if err := pm.postStorage.SetPostMeta(ctx, permlink, postMeta); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RestorePost - restore post info of an appealed post after appeal passed
+func (pm PostManager) RestorePost(ctx sdk.Context, permlink types.Permlink) sdk.Error {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	if postMeta.ModerationState != types.PostAppealed {
+		return ErrInvalidModerationState(permlink, postMeta.ModerationState)
+	}
+	if pm.postStorage.DoesCensoredPostInfoExist(ctx, permlink) {
+		postInfo, err := pm.postStorage.GetCensoredPostInfo(ctx, permlink)
+		if err != nil {
+			return err
+		}
+		if err := pm.postStorage.SetPostInfo(ctx, postInfo); err != nil {
+			return err
+		}
+		for _, tag := range postInfo.Tags {
+			pm.postStorage.SetPostTagIndex(ctx, tag, postMeta.CreatedAt, permlink)
+		}
+		pm.postStorage.DeleteCensoredPostInfo(ctx, permlink)
+	}
+	if err := pm.clearReports(ctx, permlink, postMeta); err != nil {
+		return err
+	}
+	postMeta.ModerationState = types.PostRestored
+	postMeta.AppealedWhileHidden = false
+	postMeta.LastUpdatedAt = ctx.BlockHeader().Time.Unix()
+	if err := pm.postStorage.SetPostMeta(ctx, permlink, postMeta); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RejectAppeal - censor an appealed post after appeal is rejected, post can't be
+// appealed again. Appeal of a hidden post has no censorship to uphold, the post
+// stays hidden pending review of content censorship instead
+func (pm PostManager) RejectAppeal(ctx sdk.Context, permlink types.Permlink) sdk.Error {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	if postMeta.ModerationState != types.PostAppealed {
+		return ErrInvalidModerationState(permlink, postMeta.ModerationState)
+	}
+	postMeta.ModerationState = types.PostCensored
+	if postMeta.AppealedWhileHidden {
+		postMeta.ModerationState = types.PostHidden
+		postMeta.AppealedWhileHidden = false
+	}
+	if err := pm.postStorage.SetPostMeta(ctx, permlink, postMeta); err != nil {
+		return err
+	}
+	return nil
+}
+
+// IsRewardWithheld - content reward of a post is withheld while the post is
+// under appeal or can still be appealed, so a restore can pay it out
+func (pm PostManager) IsRewardWithheld(ctx sdk.Context, permlink types.Permlink) (bool, sdk.Error) {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return false, err
+	}
+	postParam, err := pm.paramHolder.GetPostParam(ctx)
+	if err != nil {
+		return false, err
+	}
+	switch postMeta.ModerationState {
+	case types.PostAppealed:
+		return true, nil
+	case types.PostCensored:
+		return !postMeta.HasAppealed &&
+			postMeta.CensoredAt+postParam.AppealPeriodSec >= ctx.BlockHeader().Time.Unix(), nil
+	}
+	return false, nil
+}
+
+// clearReports - clear reports once moderation is settled in favor of the author,
+// so the post can be reported and hidden again
+func (pm PostManager) clearReports(
+	ctx sdk.Context, permlink types.Permlink, postMeta *model.PostMeta) sdk.Error {
+	if err := pm.postStorage.DeletePostReports(ctx, permlink); err != nil {
+		return err
+	}
+	postMeta.TotalReportCoinDay = types.NewCoinFromInt64(0)
+	return nil
+}
+
+// hidePostInfo - back up post info and clear its content, deleted post is left as is
+func (pm PostManager) hidePostInfo(
+	ctx sdk.Context, permlink types.Permlink, postMeta *model.PostMeta) sdk.Error {
+	if postMeta.IsDeleted {
+		return nil
+	}
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	if err := pm.postStorage.SetCensoredPostInfo(ctx, permlink, postInfo); err != nil {
+		return err
+	}
+	for _, tag := range postInfo.Tags {
+		pm.postStorage.DeletePostTagIndex(ctx, tag, postMeta.CreatedAt, permlink)
+	}
+	postInfo.Title = ""
+	postInfo.Content = ""
+	postInfo.Links = nil
+	postInfo.Tags = nil
+	if err := pm.postStorage.SetPostInfo(ctx, postInfo); err != nil {
+		return err
+	}
+	return nil
+}
+
 // GetPenaltyScore - get penalty score from report and upvote
 func (pm PostManager) GetPenaltyScore(ctx sdk.Context, reputation types.Coin) (sdk.Rat, sdk.Error) {
 	if reputation.IsNotNegative() {
@@ -291,3 +577,77 @@ func (pm PostManager) GetPenaltyScore(ctx sdk.Context, reputation types.Coin) (s
 	}
 	return penaltyScore, nil
 }
+
+// RebuildPostIndexes - index posts created before tag, author and donation rank indexes
+func (pm PostManager) RebuildPostIndexes(ctx sdk.Context) sdk.Error {
+	return pm.postStorage.RebuildPostIndexes(ctx)
+}
+
+// GetDonationAmounts - total donation amount from each donator to each author
+func (pm PostManager) GetDonationAmounts(
+	ctx sdk.Context) (map[types.AccountKey]map[types.AccountKey]types.Coin, sdk.Error) {
+	return pm.postStorage.GetDonationAmounts(ctx)
+}
+
+// DoesSubscriptionExist - check if subscriber has an ongoing subscription to creator
+func (pm PostManager) DoesSubscriptionExist(
+	ctx sdk.Context, creator types.AccountKey, subscriber types.AccountKey) bool {
+	return pm.postStorage.DoesSubscriptionExist(ctx, creator, subscriber)
+}
+
+// GetSubscription - get subscription from subscriber to creator
+func (pm PostManager) GetSubscription(
+	ctx sdk.Context, creator types.AccountKey, subscriber types.AccountKey) (*model.Subscription, sdk.Error) {
+	return pm.postStorage.GetSubscription(ctx, creator, subscriber)
+}
+
+// CreateSubscription - create a subscription whose first period is paid in current block
+func (pm PostManager) CreateSubscription(
+	ctx sdk.Context, subscriber types.AccountKey, creator types.AccountKey, postID string,
+	amount types.Coin, totalPeriods int64, fromApp types.AccountKey) sdk.Error {
+	if pm.postStorage.DoesSubscriptionExist(ctx, creator, subscriber) {
+		return ErrSubscriptionAlreadyExist(subscriber, creator)
+	}
+	subscription := &model.Subscription{
+		Subscriber:   subscriber,
+		Creator:      creator,
+		PostID:       postID,
+		Amount:       amount,
+		FromApp:      fromApp,
+		TotalPeriods: totalPeriods,
+		PaidPeriods:  1,
+		CreatedAt:    ctx.BlockHeader().Time.Unix(),
+		LastPaidAt:   ctx.BlockHeader().Time.Unix(),
+	}
+	return pm.postStorage.SetSubscription(ctx, subscription)
+}
+
+// AddSubscriptionPaidPeriod - record one more paid period, the subscription
+// is removed and finished is true if all periods are paid
+func (pm PostManager) AddSubscriptionPaidPeriod(
+	ctx sdk.Context, creator types.AccountKey, subscriber types.AccountKey) (finished bool, err sdk.Error) {
+	subscription, err := pm.postStorage.GetSubscription(ctx, creator, subscriber)
+	if err != nil {
+		return false, err
+	}
+	subscription.PaidPeriods++
+	subscription.LastPaidAt = ctx.BlockHeader().Time.Unix()
+	if subscription.PaidPeriods >= subscription.TotalPeriods {
+		pm.postStorage.DeleteSubscription(ctx, creator, subscriber)
+		return true, nil
+	}
+	if err := pm.postStorage.SetSubscription(ctx, subscription); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// CancelSubscription - remove subscription, remaining periods won't be paid
+func (pm PostManager) CancelSubscription(
+	ctx sdk.Context, creator types.AccountKey, subscriber types.AccountKey) sdk.Error {
+	if !pm.postStorage.DoesSubscriptionExist(ctx, creator, subscriber) {
+		return ErrSubscriptionNotFound(subscriber, creator)
+	}
+	pm.postStorage.DeleteSubscription(ctx, creator, subscriber)
+	return nil
+}
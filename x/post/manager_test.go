@@ -99,7 +99,7 @@ func TestCreatePost(t *testing.T) {
 		err := pm.CreatePost(
 			ctx, msg.Author, msg.PostID, msg.SourceAuthor, msg.SourcePostID,
 			msg.ParentAuthor, msg.ParentPostID, msg.Content,
-			msg.Title, sdk.ZeroRat(), msg.Links)
+			msg.Title, sdk.ZeroRat(), msg.Links, nil)
 		if !assert.Equal(t, err, tc.expectResult) {
 			t.Errorf("%s: diff result, got %v, want %v", tc.testName, err, tc.expectResult)
 		}
@@ -149,7 +149,7 @@ func TestUpdatePost(t *testing.T) {
 			testName: "normal update",
 			msg: NewUpdatePostMsg(
 				string(user), postID, "update to this title", "update to this content",
-				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}),
+				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}, nil),
 			expectErr:  nil,
 			updateTime: baseTime + 10,
 		},
@@ -157,7 +157,7 @@ func TestUpdatePost(t *testing.T) {
 			testName: "update with invalid post id",
 			msg: NewUpdatePostMsg(
 				"invalid", postID, "update to this title", "update to this content",
-				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}),
+				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}, nil),
 			expectErr:  model.ErrPostNotFound(model.GetPostInfoKey(types.GetPermlink("invalid", postID))),
 			updateTime: baseTime + 100,
 		},
@@ -165,7 +165,7 @@ func TestUpdatePost(t *testing.T) {
 			testName: "update with invalid author",
 			msg: NewUpdatePostMsg(
 				string(user), "invalid", "update to this title", "update to this content",
-				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}),
+				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}, nil),
 			expectErr:  model.ErrPostNotFound(model.GetPostInfoKey(types.GetPermlink(user, "invalid"))),
 			updateTime: baseTime + 1000,
 		},
@@ -175,7 +175,7 @@ func TestUpdatePost(t *testing.T) {
 		ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(tc.updateTime, 0)})
 
 		err := pm.UpdatePost(
-			ctx, tc.msg.Author, tc.msg.PostID, tc.msg.Title, tc.msg.Content, tc.msg.Links)
+			ctx, tc.msg.Author, tc.msg.PostID, tc.msg.Title, tc.msg.Content, tc.msg.Links, tc.msg.Tags)
 		if !assert.Equal(t, err, tc.expectErr) {
 			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.expectErr)
 		}
@@ -279,7 +279,7 @@ func TestGetSourcePost(t *testing.T) {
 		err := pm.CreatePost(
 			ctx, msg.Author, msg.PostID, msg.SourceAuthor, msg.SourcePostID,
 			msg.ParentAuthor, msg.ParentPostID, msg.Content,
-			msg.Title, sdk.ZeroRat(), msg.Links)
+			msg.Title, sdk.ZeroRat(), msg.Links, nil)
 		if err != nil {
 			t.Errorf("%s: failed to create post, got err %v", tc.testName, err)
 		}
@@ -567,3 +567,267 @@ func TestDeletePost(t *testing.T) {
 	assert.Nil(t, err)
 	checkIsDelete(t, ctx, pm, types.GetPermlink(user, postID))
 }
+
+func TestPostTags(t *testing.T) {
+	ctx, am, _, pm, _, _, _, _ := setupTest(t, 1)
+	user := createTestAccount(t, ctx, am, "user")
+	permlink1 := types.GetPermlink(user, "post1")
+	permlink2 := types.GetPermlink(user, "post2")
+
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(100, 0)})
+	err := pm.CreatePost(
+		ctx, user, "post1", "", "", "", "", "content", "title",
+		sdk.ZeroRat(), []types.IDToURLMapping{}, []string{"music", "art"})
+	assert.Nil(t, err)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(200, 0)})
+	err = pm.CreatePost(
+		ctx, user, "post2", "", "", "", "", "content", "title",
+		sdk.ZeroRat(), []types.IDToURLMapping{}, []string{"music"})
+	assert.Nil(t, err)
+
+	permlinks := getIndexPermlinks(ctx, model.GetPostTagPrefix("music"))
+	assert.Equal(t, []types.Permlink{permlink2, permlink1}, permlinks)
+	permlinks = getIndexPermlinks(ctx, model.GetPostTagPrefix("art"))
+	assert.Equal(t, []types.Permlink{permlink1}, permlinks)
+
+	// update keeps post at its creation time under new tags
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(300, 0)})
+	err = pm.UpdatePost(
+		ctx, user, "post1", "title", "content", nil, []string{"music", "news"})
+	assert.Nil(t, err)
+	permlinks = getIndexPermlinks(ctx, model.GetPostTagPrefix("music"))
+	assert.Equal(t, []types.Permlink{permlink2, permlink1}, permlinks)
+	permlinks = getIndexPermlinks(ctx, model.GetPostTagPrefix("art"))
+	assert.Equal(t, []types.Permlink{}, permlinks)
+	permlinks = getIndexPermlinks(ctx, model.GetPostTagPrefix("news"))
+	assert.Equal(t, []types.Permlink{permlink1}, permlinks)
+
+	// update without tags keeps existing tags
+	for _, tags := range [][]string{nil, {}} {
+		err = pm.UpdatePost(ctx, user, "post1", "title", "new content", nil, tags)
+		assert.Nil(t, err)
+		postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink1)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"music", "news"}, postInfo.Tags)
+		permlinks = getIndexPermlinks(ctx, model.GetPostTagPrefix("news"))
+		assert.Equal(t, []types.Permlink{permlink1}, permlinks)
+	}
+
+	// deleted post is removed from tag index
+	err = pm.DeletePost(ctx, permlink1)
+	assert.Nil(t, err)
+	permlinks = getIndexPermlinks(ctx, model.GetPostTagPrefix("music"))
+	assert.Equal(t, []types.Permlink{permlink2}, permlinks)
+	permlinks = getIndexPermlinks(ctx, model.GetPostTagPrefix("news"))
+	assert.Equal(t, []types.Permlink{}, permlinks)
+}
+
+func TestAuthorPostIndex(t *testing.T) {
+	ctx, am, _, pm, _, _, _, _ := setupTest(t, 1)
+	user := createTestAccount(t, ctx, am, "user")
+	post1 := types.GetPermlink(user, "post1")
+	post2 := types.GetPermlink(user, "post2")
+	comment := types.GetPermlink(user, "comment")
+
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(100, 0)})
+	err := pm.CreatePost(
+		ctx, user, "post1", "", "", "", "", "content", "title",
+		sdk.ZeroRat(), []types.IDToURLMapping{}, nil)
+	assert.Nil(t, err)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(200, 0)})
+	err = pm.CreatePost(
+		ctx, user, "comment", "", "", user, "post1", "content", "title",
+		sdk.ZeroRat(), []types.IDToURLMapping{}, nil)
+	assert.Nil(t, err)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(300, 0)})
+	err = pm.CreatePost(
+		ctx, user, "post2", "", "", "", "", "content", "title",
+		sdk.ZeroRat(), []types.IDToURLMapping{}, nil)
+	assert.Nil(t, err)
+
+	permlinks := getIndexPermlinks(ctx, model.GetAuthorPostIndexPrefix(user, false))
+	assert.Equal(t, []types.Permlink{post2, post1}, permlinks)
+	permlinks = getIndexPermlinks(ctx, model.GetAuthorPostIndexPrefix(user, true))
+	assert.Equal(t, []types.Permlink{comment}, permlinks)
+
+	// deleted post stays in author index
+	err = pm.DeletePost(ctx, post2)
+	assert.Nil(t, err)
+	permlinks = getIndexPermlinks(ctx, model.GetAuthorPostIndexPrefix(user, false))
+	assert.Equal(t, []types.Permlink{post2, post1}, permlinks)
+}
+
+func TestPostDonationRank(t *testing.T) {
+	ctx, am, _, pm, _, _, _, _ := setupTest(t, 1)
+	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0")
+	permlink := types.GetPermlink(user, postID)
+	donator1 := types.AccountKey("donator1")
+	donator2 := types.AccountKey("donator2")
+
+	err := pm.AddDonation(ctx, permlink, donator1, types.NewCoinFromInt64(300), types.DirectDeposit)
+	assert.Nil(t, err)
+	err = pm.AddDonation(ctx, permlink, donator2, types.NewCoinFromInt64(200), types.DirectDeposit)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Donations{
+		{Username: donator1, Times: 1, Amount: types.NewCoinFromInt64(300)},
+		{Username: donator2, Times: 1, Amount: types.NewCoinFromInt64(200)},
+	}, getDonationRank(t, ctx, permlink))
+
+	// donator2 donates again and moves to the top
+	err = pm.AddDonation(ctx, permlink, donator2, types.NewCoinFromInt64(200), types.DirectDeposit)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Donations{
+		{Username: donator2, Times: 2, Amount: types.NewCoinFromInt64(400)},
+		{Username: donator1, Times: 1, Amount: types.NewCoinFromInt64(300)},
+	}, getDonationRank(t, ctx, permlink))
+}
+
+func TestRebuildPostIndexes(t *testing.T) {
+	ctx, am, _, pm, _, _, _, _ := setupTest(t, 1)
+	user := createTestAccount(t, ctx, am, "user")
+	permlink := types.GetPermlink(user, "post")
+	donator := types.AccountKey("donator")
+
+	err := pm.CreatePost(
+		ctx, user, "post", "", "", "", "", "content", "title",
+		sdk.ZeroRat(), []types.IDToURLMapping{}, []string{"music"})
+	assert.Nil(t, err)
+	err = pm.AddDonation(ctx, permlink, donator, types.NewCoinFromInt64(100), types.DirectDeposit)
+	assert.Nil(t, err)
+
+	// drop index entries as if post was created before indexes were added
+	store := ctx.KVStore(testPostKVStoreKey)
+	for _, prefix := range [][]byte{
+		model.GetAuthorPostIndexPrefix(user, false),
+		model.GetPostTagPrefix("music"),
+		model.GetPostDonationRankPrefix(permlink),
+	} {
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+		assert.Equal(t, [][]byte{}, getIndexValues(ctx, prefix))
+	}
+
+	err = pm.RebuildPostIndexes(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.Permlink{permlink}, getIndexPermlinks(ctx, model.GetAuthorPostIndexPrefix(user, false)))
+	assert.Equal(t, []types.Permlink{permlink}, getIndexPermlinks(ctx, model.GetPostTagPrefix("music")))
+	assert.Equal(t, []model.Donations{
+		{Username: donator, Times: 1, Amount: types.NewCoinFromInt64(100)},
+	}, getDonationRank(t, ctx, permlink))
+}
+
+func TestPostModeration(t *testing.T) {
+	ctx, am, ph, pm, _, _, _, _ := setupTest(t, 1)
+	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0")
+	reporter := createTestAccount(t, ctx, am, "reporter")
+	permlink := types.GetPermlink(user, postID)
+	postParam, _ := ph.GetPostParam(ctx)
+
+	// report below threshold doesn't hide post
+	err := pm.ReportPost(ctx, permlink, reporter, types.NewCoinFromInt64(1))
+	assert.Nil(t, err)
+	state, _ := pm.GetModerationState(ctx, permlink)
+	assert.Equal(t, types.PostVisible, state)
+	// same user can only report once
+	err = pm.ReportPost(ctx, permlink, reporter, postParam.HideReportCoinDay)
+	assert.Nil(t, err)
+	state, _ = pm.GetModerationState(ctx, permlink)
+	assert.Equal(t, types.PostVisible, state)
+
+	err = pm.ReportPost(ctx, permlink, user, postParam.HideReportCoinDay)
+	assert.Nil(t, err)
+	state, _ = pm.GetModerationState(ctx, permlink)
+	assert.Equal(t, types.PostHidden, state)
+
+	// censorship rejected, post is visible again
+	err = pm.RejectCensorship(ctx, permlink)
+	assert.Nil(t, err)
+	state, _ = pm.GetModerationState(ctx, permlink)
+	assert.Equal(t, types.PostVisible, state)
+	err = pm.AppealPost(ctx, permlink)
+	assert.Equal(t, ErrInvalidModerationState(permlink, types.PostVisible), err)
+
+	// reports are cleared, post can be reported and hidden again
+	isHidden, _ := pm.IsHidden(ctx, permlink)
+	assert.False(t, isHidden)
+	err = pm.ReportPost(ctx, permlink, reporter, postParam.HideReportCoinDay)
+	assert.Nil(t, err)
+	isHidden, _ = pm.IsHidden(ctx, permlink)
+	assert.True(t, isHidden)
+
+	// censored post keeps a backup of its content
+	err = pm.CensorPost(ctx, permlink)
+	assert.Nil(t, err)
+	isCensored, _ := pm.IsCensored(ctx, permlink)
+	assert.True(t, isCensored)
+	postInfo, _ := pm.postStorage.GetPostInfo(ctx, permlink)
+	assert.Equal(t, "", postInfo.Content)
+	isWithheld, _ := pm.IsRewardWithheld(ctx, permlink)
+	assert.True(t, isWithheld)
+	err = pm.CensorPost(ctx, permlink)
+	assert.Equal(t, ErrInvalidModerationState(permlink, types.PostCensored), err)
+
+	// appeal rejected, post can't be appealed again
+	err = pm.AppealPost(ctx, permlink)
+	assert.Nil(t, err)
+	err = pm.RejectAppeal(ctx, permlink)
+	assert.Nil(t, err)
+	state, _ = pm.GetModerationState(ctx, permlink)
+	assert.Equal(t, types.PostCensored, state)
+	isWithheld, _ = pm.IsRewardWithheld(ctx, permlink)
+	assert.False(t, isWithheld)
+	err = pm.AppealPost(ctx, permlink)
+	assert.Equal(t, ErrInvalidModerationState(permlink, types.PostCensored), err)
+
+	// hidden post appealed and restored
+	user2, postID2 := createTestPost(t, ctx, "user2", "postID", am, pm, "0")
+	permlink2 := types.GetPermlink(user2, postID2)
+	err = pm.ReportPost(ctx, permlink2, reporter, postParam.HideReportCoinDay)
+	assert.Nil(t, err)
+
+	// rejected appeal of hidden post doesn't censor it without censorship decision
+	err = pm.AppealPost(ctx, permlink2)
+	assert.Nil(t, err)
+	err = pm.RejectAppeal(ctx, permlink2)
+	assert.Nil(t, err)
+	state, _ = pm.GetModerationState(ctx, permlink2)
+	assert.Equal(t, types.PostHidden, state)
+	assert.False(t, pm.postStorage.DoesCensoredPostInfoExist(ctx, permlink2))
+
+	err = pm.AppealPost(ctx, permlink2)
+	assert.Nil(t, err)
+	err = pm.RestorePost(ctx, permlink2)
+	assert.Nil(t, err)
+	state, _ = pm.GetModerationState(ctx, permlink2)
+	assert.Equal(t, types.PostRestored, state)
+	isCensored, _ = pm.IsCensored(ctx, permlink2)
+	assert.False(t, isCensored)
+
+	// restored post can be reported by the same reporter again
+	err = pm.ReportPost(ctx, permlink2, reporter, postParam.HideReportCoinDay)
+	assert.Nil(t, err)
+	state, _ = pm.GetModerationState(ctx, permlink2)
+	assert.Equal(t, types.PostHidden, state)
+
+	// censored post can't be appealed after appeal period
+	err = pm.CensorPost(ctx, permlink2)
+	assert.Nil(t, err)
+	ctx = ctx.WithBlockHeader(abci.Header{
+		ChainID: "Lino", Time: time.Unix(ctx.BlockHeader().Time.Unix()+postParam.AppealPeriodSec+1, 0)})
+	err = pm.AppealPost(ctx, permlink2)
+	assert.Equal(t, ErrInvalidModerationState(permlink2, types.PostCensored), err)
+	isWithheld, _ = pm.IsRewardWithheld(ctx, permlink2)
+	assert.False(t, isWithheld)
+
+	// deleting post also drops its censored backup
+	err = pm.DeletePost(ctx, permlink)
+	assert.Nil(t, err)
+	assert.False(t, pm.postStorage.DoesCensoredPostInfoExist(ctx, permlink))
+}
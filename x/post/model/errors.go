@@ -63,6 +63,16 @@ func ErrFailedToMarshalPostView(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToMarshalPostView, fmt.Sprintf("failed to marshal post view: %s", err.Error()))
 }
 
+// ErrSubscriptionNotFound - error if subscription is not found in KVStore
+func ErrSubscriptionNotFound(key []byte) sdk.Error {
+	return types.NewError(types.CodeSubscriptionNotFound, fmt.Sprintf("subscription is not found for key: %s", key))
+}
+
+// ErrCensoredPostInfoNotFound - error if censored post info is not found in KVStore
+func ErrCensoredPostInfoNotFound(key []byte) sdk.Error {
+	return types.NewError(types.CodeCensoredPostInfoNotFound, fmt.Sprintf("censored post info is not found for key: %s", key))
+}
+
 // ErrFailedToMarshalPostDonations - error if marshal post donation failed
 func ErrFailedToMarshalPostDonations(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToMarshalPostDonations, fmt.Sprintf("failed to marshal post donations: %s", err.Error()))
@@ -97,3 +107,13 @@ func ErrFailedToUnmarshalPostView(err error) sdk.Error {
 func ErrFailedToUnmarshalPostDonations(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalPostDonations, fmt.Sprintf("failed to unmarshal post donations: %s", err.Error()))
 }
+
+// ErrFailedToMarshalSubscription - error if marshal subscription failed
+func ErrFailedToMarshalSubscription(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalSubscription, fmt.Sprintf("failed to marshal subscription: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalSubscription - error if unmarshal subscription failed
+func ErrFailedToUnmarshalSubscription(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalSubscription, fmt.Sprintf("failed to unmarshal subscription: %s", err.Error()))
+}
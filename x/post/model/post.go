@@ -23,21 +23,38 @@ type PostInfo struct {
 	SourceAuthor types.AccountKey       `json:"source_author"`
 	SourcePostID string                 `json:"source_postID"`
 	Links        []types.IDToURLMapping `json:"links"`
+	Tags         []string               `json:"tags"`
 }
 
 // PostMeta - stores tiny and frequently updated fields.
+// ModerationState moves between visible, hidden pending review, censored,
+// appealed and restored. CensoredAt and HasAppealed decide if a censored
+// post can still be appealed. AppealedWhileHidden marks appeal of a hidden
+// post which hasn't been censored yet.
 type PostMeta struct {
-	CreatedAt               int64      `json:"created_at"`
-	LastUpdatedAt           int64      `json:"last_updated_at"`
-	LastActivityAt          int64      `json:"last_activity_at"`
-	AllowReplies            bool       `json:"allow_replies"`
-	IsDeleted               bool       `json:"is_deleted"`
-	TotalDonateCount        int64      `json:"total_donate_count"`
-	TotalReportCoinDay      types.Coin `json:"total_report_coin_day"`
-	TotalUpvoteCoinDay      types.Coin `json:"total_upvote_coin_day"`
-	TotalViewCount          int64      `json:"total_view_count"`
-	TotalReward             types.Coin `json:"total_reward"`
-	RedistributionSplitRate sdk.Rat    `json:"redistribution_split_rate"`
+	CreatedAt               int64                 `json:"created_at"`
+	LastUpdatedAt           int64                 `json:"last_updated_at"`
+	LastActivityAt          int64                 `json:"last_activity_at"`
+	AllowReplies            bool                  `json:"allow_replies"`
+	IsDeleted               bool                  `json:"is_deleted"`
+	TotalDonateCount        int64                 `json:"total_donate_count"`
+	TotalReportCoinDay      types.Coin            `json:"total_report_coin_day"`
+	TotalUpvoteCoinDay      types.Coin            `json:"total_upvote_coin_day"`
+	TotalViewCount          int64                 `json:"total_view_count"`
+	TotalReward             types.Coin            `json:"total_reward"`
+	RedistributionSplitRate sdk.Rat               `json:"redistribution_split_rate"`
+	ModerationState         types.ModerationState `json:"moderation_state"`
+	CensoredAt              int64                 `json:"censored_at"`
+	HasAppealed             bool                  `json:"has_appealed"`
+	AppealedWhileHidden     bool                  `json:"appealed_while_hidden"`
+}
+
+// IsHidden - post is hidden from readers while it is hidden pending review,
+// censored or under appeal
+func (postMeta PostMeta) IsHidden() bool {
+	return postMeta.ModerationState == types.PostHidden ||
+		postMeta.ModerationState == types.PostCensored ||
+		postMeta.ModerationState == types.PostAppealed
 }
 
 // ReportOrUpvote - report or upvote from a user to a post
@@ -68,3 +85,17 @@ type Donations struct {
 	Times    int64            `json:"times"`
 	Amount   types.Coin       `json:"amount"`
 }
+
+// Subscription - recurring donation from a subscriber to a creator's post,
+// one period is paid every subscription interval until all periods are paid
+type Subscription struct {
+	Subscriber   types.AccountKey `json:"subscriber"`
+	Creator      types.AccountKey `json:"creator"`
+	PostID       string           `json:"post_id"`
+	Amount       types.Coin       `json:"amount"`
+	FromApp      types.AccountKey `json:"from_app"`
+	TotalPeriods int64            `json:"total_periods"`
+	PaidPeriods  int64            `json:"paid_periods"`
+	CreatedAt    int64            `json:"created_at"`
+	LastPaidAt   int64            `json:"last_paid_at"`
+}
@@ -1,6 +1,9 @@
 package model
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/lino-network/lino/types"
 
@@ -14,8 +17,17 @@ var (
 	postCommentSubStore        = []byte{0x03} // SubStore for all comments
 	postViewsSubStore          = []byte{0x04} // SubStore for all views
 	postDonationsSubStore      = []byte{0x05} // SubStore for all donations
+	postSubscriptionSubStore   = []byte{0x06} // SubStore for all subscriptions
+	postTagSubStore            = []byte{0x07} // SubStore for tag to permlink index
+	postAuthorPostSubStore     = []byte{0x08} // SubStore for author to top level post index
+	postAuthorCommentSubStore  = []byte{0x09} // SubStore for author to comment index
+	postDonationRankSubStore   = []byte{0x0a} // SubStore for donations ranked by amount
+	postCensoredInfoSubStore   = []byte{0x0b} // SubStore for post info backup of censored posts
 )
 
+// amountIndexWidth - digits of zero padded coin amount in amount ordered index
+const amountIndexWidth = 40
+
 // PostStorage - post storage
 type PostStorage struct {
 	// The (unexposed) key used to access the store from the Context.
@@ -120,6 +132,28 @@ func (ps PostStorage) SetPostReportOrUpvote(
 	return nil
 }
 
+// DeletePostReports - delete all reports to a post, upvotes are kept
+func (ps PostStorage) DeletePostReports(ctx sdk.Context, permlink types.Permlink) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	iterator := store.Iterator(subspace(getPostReportOrUpvotePrefix(permlink)))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var reportOrUpvote ReportOrUpvote
+		if err := ps.cdc.UnmarshalJSON(iterator.Value(), &reportOrUpvote); err != nil {
+			iterator.Close()
+			return ErrFailedToUnmarshalPostReportOrUpvote(err)
+		}
+		if reportOrUpvote.IsReport {
+			keys = append(keys, iterator.Key())
+		}
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return nil
+}
+
 // GetPostComment - get post comment from KVStore
 func (ps PostStorage) GetPostComment(
 	ctx sdk.Context, permlink types.Permlink, commentPermlink types.Permlink) (*Comment, sdk.Error) {
@@ -202,6 +236,212 @@ func (ps PostStorage) SetPostDonations(
 	return nil
 }
 
+// DoesSubscriptionExist - check if a subscription exists in KVStore or not
+func (ps PostStorage) DoesSubscriptionExist(
+	ctx sdk.Context, creator types.AccountKey, subscriber types.AccountKey) bool {
+	store := ctx.KVStore(ps.key)
+	return store.Has(getSubscriptionKey(creator, subscriber))
+}
+
+// GetSubscription - get subscription from KVStore
+func (ps PostStorage) GetSubscription(
+	ctx sdk.Context, creator types.AccountKey, subscriber types.AccountKey) (*Subscription, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	subscriptionBytes := store.Get(getSubscriptionKey(creator, subscriber))
+	if subscriptionBytes == nil {
+		return nil, ErrSubscriptionNotFound(getSubscriptionKey(creator, subscriber))
+	}
+	subscription := new(Subscription)
+	if unmarshalErr := ps.cdc.UnmarshalJSON(subscriptionBytes, subscription); unmarshalErr != nil {
+		return nil, ErrFailedToUnmarshalSubscription(unmarshalErr)
+	}
+	return subscription, nil
+}
+
+// SetSubscription - set subscription to KVStore
+func (ps PostStorage) SetSubscription(ctx sdk.Context, subscription *Subscription) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	subscriptionByte, err := ps.cdc.MarshalJSON(*subscription)
+	if err != nil {
+		return ErrFailedToMarshalSubscription(err)
+	}
+	store.Set(getSubscriptionKey(subscription.Creator, subscription.Subscriber), subscriptionByte)
+	return nil
+}
+
+// DeleteSubscription - delete subscription from KVStore
+func (ps PostStorage) DeleteSubscription(
+	ctx sdk.Context, creator types.AccountKey, subscriber types.AccountKey) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getSubscriptionKey(creator, subscriber))
+}
+
+// GetCensoredPostInfo - get post info backup of a censored post from KVStore
+func (ps PostStorage) GetCensoredPostInfo(ctx sdk.Context, permlink types.Permlink) (*PostInfo, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	infoByte := store.Get(getCensoredPostInfoKey(permlink))
+	if infoByte == nil {
+		return nil, ErrCensoredPostInfoNotFound(getCensoredPostInfoKey(permlink))
+	}
+	postInfo := new(PostInfo)
+	if err := ps.cdc.UnmarshalJSON(infoByte, postInfo); err != nil {
+		return nil, ErrFailedToUnmarshalPostInfo(err)
+	}
+	return postInfo, nil
+}
+
+// SetCensoredPostInfo - back up post info of a censored post to KVStore
+func (ps PostStorage) SetCensoredPostInfo(ctx sdk.Context, permlink types.Permlink, postInfo *PostInfo) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	infoByte, err := ps.cdc.MarshalJSON(*postInfo)
+	if err != nil {
+		return ErrFailedToMarshalPostInfo(err)
+	}
+	store.Set(getCensoredPostInfoKey(permlink), infoByte)
+	return nil
+}
+
+// DoesCensoredPostInfoExist - check if post info backup of a post exists
+func (ps PostStorage) DoesCensoredPostInfoExist(ctx sdk.Context, permlink types.Permlink) bool {
+	store := ctx.KVStore(ps.key)
+	return store.Has(getCensoredPostInfoKey(permlink))
+}
+
+// DeleteCensoredPostInfo - delete post info backup from KVStore
+func (ps PostStorage) DeleteCensoredPostInfo(ctx sdk.Context, permlink types.Permlink) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getCensoredPostInfoKey(permlink))
+}
+
+// SetPostTagIndex - index post under tag by its creation time
+func (ps PostStorage) SetPostTagIndex(
+	ctx sdk.Context, tag string, createdAt int64, permlink types.Permlink) {
+	store := ctx.KVStore(ps.key)
+	store.Set(getPostTagIndexKey(tag, createdAt, permlink), []byte(permlink))
+}
+
+// DeletePostTagIndex - remove post from tag index
+func (ps PostStorage) DeletePostTagIndex(
+	ctx sdk.Context, tag string, createdAt int64, permlink types.Permlink) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getPostTagIndexKey(tag, createdAt, permlink))
+}
+
+// SetPostDonationRank - index donations of a user to a post by donated amount
+func (ps PostStorage) SetPostDonationRank(
+	ctx sdk.Context, permlink types.Permlink, postDonations *Donations) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	postDonationsByte, err := ps.cdc.MarshalJSON(*postDonations)
+	if err != nil {
+		return ErrFailedToMarshalPostDonations(err)
+	}
+	store.Set(getPostDonationRankKey(permlink, postDonations), postDonationsByte)
+	return nil
+}
+
+// DeletePostDonationRank - remove donations of a user from post donation rank
+func (ps PostStorage) DeletePostDonationRank(
+	ctx sdk.Context, permlink types.Permlink, postDonations *Donations) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getPostDonationRankKey(permlink, postDonations))
+}
+
+// SetAuthorPostIndex - index post or comment under author by its creation time
+func (ps PostStorage) SetAuthorPostIndex(
+	ctx sdk.Context, author types.AccountKey, isComment bool, createdAt int64, permlink types.Permlink) {
+	store := ctx.KVStore(ps.key)
+	store.Set(getAuthorPostIndexKey(author, isComment, createdAt, permlink), []byte(permlink))
+}
+
+// RebuildPostIndexes - write tag, author and donation rank index entries of
+// all posts, used to index posts created before these indexes were added
+func (ps PostStorage) RebuildPostIndexes(ctx sdk.Context) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	iterator := store.Iterator(subspace(postInfoSubStore))
+	var postInfos []PostInfo
+	for ; iterator.Valid(); iterator.Next() {
+		var postInfo PostInfo
+		if err := ps.cdc.UnmarshalJSON(iterator.Value(), &postInfo); err != nil {
+			iterator.Close()
+			return ErrFailedToUnmarshalPostInfo(err)
+		}
+		postInfos = append(postInfos, postInfo)
+	}
+	iterator.Close()
+
+	for _, postInfo := range postInfos {
+		permlink := types.GetPermlink(postInfo.Author, postInfo.PostID)
+		postMeta, err := ps.GetPostMeta(ctx, permlink)
+		if err != nil {
+			return err
+		}
+		// tags of deleted or hidden posts are already cleared from post info
+		for _, tag := range postInfo.Tags {
+			ps.SetPostTagIndex(ctx, tag, postMeta.CreatedAt, permlink)
+		}
+		ps.SetAuthorPostIndex(ctx, postInfo.Author, postInfo.ParentAuthor != "", postMeta.CreatedAt, permlink)
+
+		donationsIterator := store.Iterator(subspace(getPostDonationsPrefix(permlink)))
+		var donationsList []Donations
+		for ; donationsIterator.Valid(); donationsIterator.Next() {
+			var postDonations Donations
+			if err := ps.cdc.UnmarshalJSON(donationsIterator.Value(), &postDonations); err != nil {
+				donationsIterator.Close()
+				return ErrFailedToUnmarshalPostDonations(err)
+			}
+			donationsList = append(donationsList, postDonations)
+		}
+		donationsIterator.Close()
+		for i := range donationsList {
+			if err := ps.SetPostDonationRank(ctx, permlink, &donationsList[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// GetDonationAmounts - total donation amount from each donator to each author
+// over all posts of the author, keyed by author and then donator
+func (ps PostStorage) GetDonationAmounts(
+	ctx sdk.Context) (map[types.AccountKey]map[types.AccountKey]types.Coin, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	iterator := store.Iterator(subspace(postInfoSubStore))
+	var postInfos []PostInfo
+	for ; iterator.Valid(); iterator.Next() {
+		var postInfo PostInfo
+		if err := ps.cdc.UnmarshalJSON(iterator.Value(), &postInfo); err != nil {
+			iterator.Close()
+			return nil, ErrFailedToUnmarshalPostInfo(err)
+		}
+		postInfos = append(postInfos, postInfo)
+	}
+	iterator.Close()
+
+	amounts := map[types.AccountKey]map[types.AccountKey]types.Coin{}
+	for _, postInfo := range postInfos {
+		permlink := types.GetPermlink(postInfo.Author, postInfo.PostID)
+		donationsIterator := store.Iterator(subspace(getPostDonationsPrefix(permlink)))
+		for ; donationsIterator.Valid(); donationsIterator.Next() {
+			var postDonations Donations
+			if err := ps.cdc.UnmarshalJSON(donationsIterator.Value(), &postDonations); err != nil {
+				donationsIterator.Close()
+				return nil, ErrFailedToUnmarshalPostDonations(err)
+			}
+			if _, exist := amounts[postInfo.Author]; !exist {
+				amounts[postInfo.Author] = map[types.AccountKey]types.Coin{}
+			}
+			amount, exist := amounts[postInfo.Author][postDonations.Username]
+			if !exist {
+				amount = types.NewCoinFromInt64(0)
+			}
+			amounts[postInfo.Author][postDonations.Username] = amount.Plus(postDonations.Amount)
+		}
+		donationsIterator.Close()
+	}
+	return amounts, nil
+}
+
 // GetPostInfoPrefix - "post info substore" + "author"
 func GetPostInfoPrefix(author types.AccountKey) []byte {
 	return append(postInfoSubStore, author...)
@@ -260,3 +500,79 @@ func getPostDonationsPrefix(permlink types.Permlink) []byte {
 func getPostDonationKey(permlink types.Permlink, donateUser types.AccountKey) []byte {
 	return append(getPostDonationsPrefix(permlink), donateUser...)
 }
+
+// GetSubscriptionPrefix - "subscription substore" + "creator"
+// which can be used to access all subscriptions to this creator
+func GetSubscriptionPrefix(creator types.AccountKey) []byte {
+	return append(append(postSubscriptionSubStore, creator...), types.KeySeparator...)
+}
+
+// getSubscriptionKey - "subscription substore" + "creator" + "subscriber"
+func getSubscriptionKey(creator types.AccountKey, subscriber types.AccountKey) []byte {
+	return append(GetSubscriptionPrefix(creator), subscriber...)
+}
+
+// getCensoredPostInfoKey - "censored post info substore" + "permlink"
+func getCensoredPostInfoKey(permlink types.Permlink) []byte {
+	return append(postCensoredInfoSubStore, permlink...)
+}
+
+// GetPostTagPrefix - "tag substore" + "tag"
+// which can be used to access all posts with this tag
+func GetPostTagPrefix(tag string) []byte {
+	return append(append(postTagSubStore, tag...), types.KeySeparator...)
+}
+
+// getPostTagIndexKey - "tag substore" + "tag" + "created at" + "permlink" + "/"
+func getPostTagIndexKey(tag string, createdAt int64, permlink types.Permlink) []byte {
+	return append(GetPostTagPrefix(tag), GetTimeIndexCursor(createdAt, permlink)...)
+}
+
+// GetAuthorPostIndexPrefix - "author post substore" or "author comment substore" + "author"
+// which can be used to access all posts or comments of the author
+func GetAuthorPostIndexPrefix(author types.AccountKey, isComment bool) []byte {
+	if isComment {
+		return append(append(postAuthorCommentSubStore, author...), types.KeySeparator...)
+	}
+	return append(append(postAuthorPostSubStore, author...), types.KeySeparator...)
+}
+
+// getAuthorPostIndexKey - "author post or comment substore" + "author" + "created at" + "permlink" + "/"
+func getAuthorPostIndexKey(
+	author types.AccountKey, isComment bool, createdAt int64, permlink types.Permlink) []byte {
+	return append(GetAuthorPostIndexPrefix(author, isComment), GetTimeIndexCursor(createdAt, permlink)...)
+}
+
+// GetPostDonationRankPrefix - "donation rank substore" + "permlink"
+// which can be used to access all donators of a post ordered by amount
+func GetPostDonationRankPrefix(permlink types.Permlink) []byte {
+	return append(append(postDonationRankSubStore, permlink...), types.KeySeparator...)
+}
+
+// getPostDonationRankKey - "donation rank substore" + "permlink" + "amount" + "donator" + "/"
+func getPostDonationRankKey(permlink types.Permlink, postDonations *Donations) []byte {
+	return append(
+		GetPostDonationRankPrefix(permlink),
+		GetAmountIndexCursor(postDonations.Amount, postDonations.Username)...)
+}
+
+// GetAmountIndexCursor - "amount" + "username" + "/", zero padded amount keeps
+// index entries sorted by amount. It's also the pagination cursor of a donator.
+func GetAmountIndexCursor(amount types.Coin, username types.AccountKey) string {
+	amountStr := amount.Amount.String()
+	return strings.Repeat("0", amountIndexWidth-len(amountStr)) + amountStr +
+		types.KeySeparator + string(username) + types.KeySeparator
+}
+
+// GetTimeIndexCursor - "created at" + "permlink" + "/", zero padded creation time
+// keeps index entries sorted by time. It's also the pagination cursor of a post.
+func GetTimeIndexCursor(createdAt int64, permlink types.Permlink) string {
+	return fmt.Sprintf("%020d", createdAt) + types.KeySeparator + string(permlink) + types.KeySeparator
+}
+
+func subspace(prefix []byte) (start, end []byte) {
+	end = make([]byte, len(prefix))
+	copy(end, prefix)
+	end[len(end)-1]++
+	return prefix, end
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/store"
@@ -126,6 +127,246 @@ func TestPostDonate(t *testing.T) {
 	})
 }
 
+func TestPostDonationRank(t *testing.T) {
+	permlink := types.GetPermlink("author", "postID")
+	donations1 := Donations{Username: "user1", Times: 1, Amount: types.NewCoinFromInt64(100)}
+	donations2 := Donations{Username: "user2", Times: 2, Amount: types.NewCoinFromInt64(1000)}
+	donations3 := Donations{Username: "user3", Times: 1, Amount: types.NewCoinFromInt64(200)}
+
+	runTest(t, func(env TestEnv) {
+		for _, donations := range []Donations{donations1, donations2, donations3} {
+			err := env.ps.SetPostDonationRank(env.ctx, permlink, &donations)
+			assert.Nil(t, err)
+		}
+		assert.Equal(t, []Donations{donations2, donations3, donations1}, getDonationRank(t, env, permlink))
+
+		env.ps.DeletePostDonationRank(env.ctx, permlink, &donations2)
+		assert.Equal(t, []Donations{donations3, donations1}, getDonationRank(t, env, permlink))
+	})
+}
+
+func TestSubscription(t *testing.T) {
+	creator := types.AccountKey("creator")
+	subscriber := types.AccountKey("subscriber")
+	subscription := Subscription{
+		Subscriber:   subscriber,
+		Creator:      creator,
+		PostID:       "channel",
+		Amount:       types.NewCoinFromInt64(100),
+		TotalPeriods: 12,
+		PaidPeriods:  1,
+		CreatedAt:    100,
+		LastPaidAt:   100,
+	}
+
+	runTest(t, func(env TestEnv) {
+		assert.False(t, env.ps.DoesSubscriptionExist(env.ctx, creator, subscriber))
+		err := env.ps.SetSubscription(env.ctx, &subscription)
+		assert.Nil(t, err)
+		assert.True(t, env.ps.DoesSubscriptionExist(env.ctx, creator, subscriber))
+
+		resultPtr, err := env.ps.GetSubscription(env.ctx, creator, subscriber)
+		assert.Nil(t, err)
+		assert.Equal(t, subscription, *resultPtr, "Subscription should be equal")
+
+		env.ps.DeleteSubscription(env.ctx, creator, subscriber)
+		assert.False(t, env.ps.DoesSubscriptionExist(env.ctx, creator, subscriber))
+		_, err = env.ps.GetSubscription(env.ctx, creator, subscriber)
+		assert.Equal(t, ErrSubscriptionNotFound(getSubscriptionKey(creator, subscriber)), err)
+	})
+}
+
+func TestCensoredPostInfo(t *testing.T) {
+	postInfo := PostInfo{
+		PostID:  "Test Post",
+		Title:   "Test Post",
+		Content: "Test Post",
+		Author:  types.AccountKey("author"),
+		Links:   nil,
+	}
+	permlink := types.GetPermlink(postInfo.Author, postInfo.PostID)
+
+	runTest(t, func(env TestEnv) {
+		assert.False(t, env.ps.DoesCensoredPostInfoExist(env.ctx, permlink))
+		err := env.ps.SetCensoredPostInfo(env.ctx, permlink, &postInfo)
+		assert.Nil(t, err)
+		assert.True(t, env.ps.DoesCensoredPostInfoExist(env.ctx, permlink))
+
+		resultPtr, err := env.ps.GetCensoredPostInfo(env.ctx, permlink)
+		assert.Nil(t, err)
+		assert.Equal(t, postInfo, *resultPtr, "postInfo should be equal")
+
+		env.ps.DeleteCensoredPostInfo(env.ctx, permlink)
+		assert.False(t, env.ps.DoesCensoredPostInfoExist(env.ctx, permlink))
+		_, err = env.ps.GetCensoredPostInfo(env.ctx, permlink)
+		assert.Equal(t, ErrCensoredPostInfoNotFound(getCensoredPostInfoKey(permlink)), err)
+	})
+}
+
+func TestPostTagIndex(t *testing.T) {
+	tag := "music"
+	p1 := types.GetPermlink("author1", "post1")
+	p2 := types.GetPermlink("author2", "post2")
+	p3 := types.GetPermlink("author1", "post3")
+
+	runTest(t, func(env TestEnv) {
+		assert.Equal(t, []types.Permlink{}, getIndexPermlinks(env, GetPostTagPrefix(tag)))
+
+		env.ps.SetPostTagIndex(env.ctx, tag, 100, p1)
+		env.ps.SetPostTagIndex(env.ctx, tag, 200, p2)
+		env.ps.SetPostTagIndex(env.ctx, tag, 300, p3)
+		env.ps.SetPostTagIndex(env.ctx, "musical", 400, p1)
+		assert.Equal(t, []types.Permlink{p3, p2, p1}, getIndexPermlinks(env, GetPostTagPrefix(tag)))
+
+		env.ps.DeletePostTagIndex(env.ctx, tag, 200, p2)
+		assert.Equal(t, []types.Permlink{p3, p1}, getIndexPermlinks(env, GetPostTagPrefix(tag)))
+	})
+}
+
+func TestIndexKeySeparator(t *testing.T) {
+	// index key of a post or donator isn't a prefix of another one's key
+	p1 := types.GetPermlink("author", "post1")
+	p10 := types.GetPermlink("author", "post10")
+	assert.False(t, bytes.HasPrefix(
+		getPostTagIndexKey("music", 100, p10), getPostTagIndexKey("music", 100, p1)))
+	assert.False(t, bytes.HasPrefix(
+		getAuthorPostIndexKey("author", false, 100, p10), getAuthorPostIndexKey("author", false, 100, p1)))
+
+	amount := types.NewCoinFromInt64(100)
+	assert.False(t, bytes.HasPrefix(
+		getPostDonationRankKey(p1, &Donations{Username: "user10", Amount: amount}),
+		getPostDonationRankKey(p1, &Donations{Username: "user1", Amount: amount})))
+}
+
+func TestAuthorPostIndex(t *testing.T) {
+	author := types.AccountKey("author")
+	p1 := types.GetPermlink(author, "post1")
+	p2 := types.GetPermlink(author, "post2")
+	p3 := types.GetPermlink(author, "post3")
+	c1 := types.GetPermlink(author, "comment1")
+
+	runTest(t, func(env TestEnv) {
+		env.ps.SetAuthorPostIndex(env.ctx, author, false, 100, p1)
+		env.ps.SetAuthorPostIndex(env.ctx, author, false, 300, p2)
+		env.ps.SetAuthorPostIndex(env.ctx, author, false, 200, p3)
+		env.ps.SetAuthorPostIndex(env.ctx, author, true, 400, c1)
+		env.ps.SetAuthorPostIndex(env.ctx, types.AccountKey("author2"), false, 500, p1)
+
+		assert.Equal(t,
+			[]types.Permlink{p2, p3, p1}, getIndexPermlinks(env, GetAuthorPostIndexPrefix(author, false)))
+		assert.Equal(t,
+			[]types.Permlink{c1}, getIndexPermlinks(env, GetAuthorPostIndexPrefix(author, true)))
+	})
+}
+
+func TestRebuildPostIndexes(t *testing.T) {
+	author := types.AccountKey("author")
+	post := PostInfo{PostID: "post", Author: author, Tags: []string{"music", "art"}}
+	comment := PostInfo{PostID: "comment", Author: author, ParentAuthor: "parent", ParentPostID: "post"}
+	deleted := PostInfo{PostID: "deleted", Author: author}
+	postPermlink := types.GetPermlink(author, post.PostID)
+	commentPermlink := types.GetPermlink(author, comment.PostID)
+	deletedPermlink := types.GetPermlink(author, deleted.PostID)
+	donations1 := Donations{Username: "user1", Times: 1, Amount: types.NewCoinFromInt64(100)}
+	donations2 := Donations{Username: "user2", Times: 2, Amount: types.NewCoinFromInt64(1000)}
+
+	runTest(t, func(env TestEnv) {
+		for i, postInfo := range []PostInfo{post, comment, deleted} {
+			err := env.ps.SetPostInfo(env.ctx, &postInfo)
+			assert.Nil(t, err)
+			postMeta := PostMeta{
+				CreatedAt:               int64(100 * (i + 1)),
+				IsDeleted:               postInfo.PostID == deleted.PostID,
+				RedistributionSplitRate: sdk.ZeroRat(),
+				TotalUpvoteCoinDay:      types.NewCoinFromInt64(0),
+				TotalReportCoinDay:      types.NewCoinFromInt64(0),
+				TotalReward:             types.NewCoinFromInt64(0),
+			}
+			err = env.ps.SetPostMeta(env.ctx, types.GetPermlink(author, postInfo.PostID), &postMeta)
+			assert.Nil(t, err)
+		}
+		for _, donations := range []Donations{donations1, donations2} {
+			err := env.ps.SetPostDonations(env.ctx, postPermlink, &donations)
+			assert.Nil(t, err)
+		}
+
+		err := env.ps.RebuildPostIndexes(env.ctx)
+		assert.Nil(t, err)
+		assert.Equal(t,
+			[]types.Permlink{deletedPermlink, postPermlink},
+			getIndexPermlinks(env, GetAuthorPostIndexPrefix(author, false)))
+		assert.Equal(t,
+			[]types.Permlink{commentPermlink}, getIndexPermlinks(env, GetAuthorPostIndexPrefix(author, true)))
+		assert.Equal(t, []types.Permlink{postPermlink}, getIndexPermlinks(env, GetPostTagPrefix("music")))
+		assert.Equal(t, []types.Permlink{postPermlink}, getIndexPermlinks(env, GetPostTagPrefix("art")))
+		assert.Equal(t, []Donations{donations2, donations1}, getDonationRank(t, env, postPermlink))
+		assert.Equal(t, []Donations{}, getDonationRank(t, env, commentPermlink))
+	})
+}
+
+func TestGetDonationAmounts(t *testing.T) {
+	author1 := types.AccountKey("author1")
+	author2 := types.AccountKey("author2")
+	posts := []PostInfo{
+		{PostID: "post1", Author: author1},
+		{PostID: "post2", Author: author1},
+		{PostID: "post1", Author: author2},
+	}
+
+	runTest(t, func(env TestEnv) {
+		for _, postInfo := range posts {
+			err := env.ps.SetPostInfo(env.ctx, &postInfo)
+			assert.Nil(t, err)
+		}
+		donations := []struct {
+			permlink  types.Permlink
+			donations Donations
+		}{
+			{types.GetPermlink(author1, "post1"), Donations{Username: "user1", Times: 1, Amount: types.NewCoinFromInt64(100)}},
+			{types.GetPermlink(author1, "post2"), Donations{Username: "user1", Times: 2, Amount: types.NewCoinFromInt64(200)}},
+			{types.GetPermlink(author1, "post2"), Donations{Username: "user2", Times: 1, Amount: types.NewCoinFromInt64(10)}},
+			{types.GetPermlink(author2, "post1"), Donations{Username: "user1", Times: 1, Amount: types.NewCoinFromInt64(1)}},
+		}
+		for _, d := range donations {
+			err := env.ps.SetPostDonations(env.ctx, d.permlink, &d.donations)
+			assert.Nil(t, err)
+		}
+
+		amounts, err := env.ps.GetDonationAmounts(env.ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, map[types.AccountKey]map[types.AccountKey]types.Coin{
+			author1: {"user1": types.NewCoinFromInt64(300), "user2": types.NewCoinFromInt64(10)},
+			author2: {"user1": types.NewCoinFromInt64(1)},
+		}, amounts)
+	})
+}
+
+// getIndexPermlinks - permlinks under an index from the largest key to the smallest
+func getIndexPermlinks(env TestEnv, prefix []byte) []types.Permlink {
+	permlinks := []types.Permlink{}
+	store := env.ctx.KVStore(TestKVStoreKey)
+	iterator := store.ReverseIterator(subspace(prefix))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		permlinks = append(permlinks, types.Permlink(iterator.Value()))
+	}
+	return permlinks
+}
+
+// getDonationRank - donations of a post from the largest amount to the smallest
+func getDonationRank(t *testing.T, env TestEnv, permlink types.Permlink) []Donations {
+	donationsList := []Donations{}
+	store := env.ctx.KVStore(TestKVStoreKey)
+	iterator := store.ReverseIterator(subspace(GetPostDonationRankPrefix(permlink)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var postDonations Donations
+		assert.Nil(t, env.ps.cdc.UnmarshalJSON(iterator.Value(), &postDonations))
+		donationsList = append(donationsList, postDonations)
+	}
+	return donationsList
+}
+
 //
 // Test Environment setup
 //
@@ -2,6 +2,7 @@ package post
 
 import (
 	"fmt"
+	"strings"
 	"unicode/utf8"
 
 	"github.com/lino-network/lino/types"
@@ -15,6 +16,8 @@ var _ types.Msg = DeletePostMsg{}
 var _ types.Msg = DonateMsg{}
 var _ types.Msg = ReportOrUpvoteMsg{}
 var _ types.Msg = ViewMsg{}
+var _ types.Msg = SubscribeMsg{}
+var _ types.Msg = CancelSubscriptionMsg{}
 
 // CreatePostMsg contains information to create a post
 type CreatePostMsg struct {
@@ -28,6 +31,7 @@ type CreatePostMsg struct {
 	SourcePostID            string                 `json:"source_postID"`
 	Links                   []types.IDToURLMapping `json:"links"`
 	RedistributionSplitRate string                 `json:"redistribution_split_rate"`
+	Tags                    []string               `json:"tags"`
 }
 
 // UpdatePostMsg - update post
@@ -37,6 +41,7 @@ type UpdatePostMsg struct {
 	Title   string                 `json:"title"`
 	Content string                 `json:"content"`
 	Links   []types.IDToURLMapping `json:"links"`
+	Tags    []string               `json:"tags"`
 }
 
 // DeletePostMsg - sent from a user to a post
@@ -70,11 +75,28 @@ type ReportOrUpvoteMsg struct {
 	IsReport bool             `json:"is_report"`
 }
 
+// SubscribeMsg - subscriber pays amount to creator's post every period
+// for number of periods, the first period is paid immediately
+type SubscribeMsg struct {
+	Subscriber types.AccountKey `json:"subscriber"`
+	Creator    types.AccountKey `json:"creator"`
+	PostID     string           `json:"post_id"`
+	Amount     types.LNO        `json:"amount"`
+	Periods    int64            `json:"periods"`
+	FromApp    types.AccountKey `json:"from_app"`
+}
+
+// CancelSubscriptionMsg - subscriber cancels subscription to creator
+type CancelSubscriptionMsg struct {
+	Subscriber types.AccountKey `json:"subscriber"`
+	Creator    types.AccountKey `json:"creator"`
+}
+
 // NewCreatePostMsg - constructs a post msg
 func NewCreatePostMsg(
 	author, postID, title, content, parentAuthor, parentPostID,
 	sourceAuthor, sourcePostID, redistributionSplitRate string,
-	links []types.IDToURLMapping) CreatePostMsg {
+	links []types.IDToURLMapping, tags []string) CreatePostMsg {
 	return CreatePostMsg{
 		Author:       types.AccountKey(author),
 		PostID:       postID,
@@ -86,18 +108,20 @@ func NewCreatePostMsg(
 		SourcePostID: sourcePostID,
 		Links:        links,
 		RedistributionSplitRate: redistributionSplitRate,
+		Tags: tags,
 	}
 }
 
 // NewUpdatePostMsg - constructs a UpdatePost msg
 func NewUpdatePostMsg(
-	author, postID, title, content string, links []types.IDToURLMapping) UpdatePostMsg {
+	author, postID, title, content string, links []types.IDToURLMapping, tags []string) UpdatePostMsg {
 	return UpdatePostMsg{
 		Author:  types.AccountKey(author),
 		PostID:  postID,
 		Title:   title,
 		Content: content,
 		Links:   links,
+		Tags:    tags,
 	}
 }
 
@@ -143,6 +167,27 @@ func NewReportOrUpvoteMsg(
 	}
 }
 
+// NewSubscribeMsg - constructs a subscribe msg
+func NewSubscribeMsg(
+	subscriber, creator, postID string, amount types.LNO, periods int64, fromApp string) SubscribeMsg {
+	return SubscribeMsg{
+		Subscriber: types.AccountKey(subscriber),
+		Creator:    types.AccountKey(creator),
+		PostID:     postID,
+		Amount:     amount,
+		Periods:    periods,
+		FromApp:    types.AccountKey(fromApp),
+	}
+}
+
+// NewCancelSubscriptionMsg - constructs a cancel subscription msg
+func NewCancelSubscriptionMsg(subscriber, creator string) CancelSubscriptionMsg {
+	return CancelSubscriptionMsg{
+		Subscriber: types.AccountKey(subscriber),
+		Creator:    types.AccountKey(creator),
+	}
+}
+
 // Type - implements sdk.Msg
 func (msg CreatePostMsg) Type() string { return types.PostRouterName }
 
@@ -161,6 +206,12 @@ func (msg ReportOrUpvoteMsg) Type() string { return types.PostRouterName }
 // Type - implements sdk.Msg
 func (msg ViewMsg) Type() string { return types.PostRouterName }
 
+// Type - implements sdk.Msg
+func (msg SubscribeMsg) Type() string { return types.PostRouterName }
+
+// Type - implements sdk.Msg
+func (msg CancelSubscriptionMsg) Type() string { return types.PostRouterName }
+
 // ValidateBasic - implements sdk.Msg
 func (msg CreatePostMsg) ValidateBasic() sdk.Error {
 	// Ensure permlink exists
@@ -200,6 +251,10 @@ func (msg CreatePostMsg) ValidateBasic() sdk.Error {
 		}
 	}
 
+	if err := validateTags(msg.Tags); err != nil {
+		return err
+	}
+
 	splitRate, err := sdk.NewRatFromDecimal(msg.RedistributionSplitRate, types.NewRatFromDecimalPrecision)
 	if err != nil {
 		return err
@@ -234,6 +289,22 @@ func (msg UpdatePostMsg) ValidateBasic() sdk.Error {
 			return ErrURLLengthTooLong()
 		}
 	}
+	return validateTags(msg.Tags)
+}
+
+// validateTags - tags can't be empty, duplicate, too long or contain key separator
+func validateTags(tags []string) sdk.Error {
+	if len(tags) > types.MaximumNumOfTags {
+		return ErrTooManyTags()
+	}
+	seen := map[string]bool{}
+	for _, tag := range tags {
+		if len(tag) == 0 || len(tag) > types.MaximumTagLength ||
+			strings.Contains(tag, types.KeySeparator) || seen[tag] {
+			return ErrInvalidTag(tag)
+		}
+		seen[tag] = true
+	}
 	return nil
 }
 
@@ -292,6 +363,36 @@ func (msg ViewMsg) ValidateBasic() sdk.Error {
 	return nil
 }
 
+// ValidateBasic - implements sdk.Msg
+func (msg SubscribeMsg) ValidateBasic() sdk.Error {
+	if len(msg.Subscriber) == 0 {
+		return ErrNoUsername()
+	}
+	if len(msg.Creator) == 0 || len(msg.PostID) == 0 {
+		return ErrInvalidTarget()
+	}
+	if msg.Periods <= 0 || msg.Periods > types.MaximumSubscriptionPeriods {
+		return ErrInvalidSubscriptionPeriods()
+	}
+
+	_, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// ValidateBasic - implements sdk.Msg
+func (msg CancelSubscriptionMsg) ValidateBasic() sdk.Error {
+	if len(msg.Subscriber) == 0 {
+		return ErrNoUsername()
+	}
+	if len(msg.Creator) == 0 {
+		return ErrInvalidTarget()
+	}
+	return nil
+}
+
 // GetPermission - implements types.Msg
 func (msg CreatePostMsg) GetPermission() types.Permission {
 	return types.AppPermission
@@ -322,6 +423,16 @@ func (msg ViewMsg) GetPermission() types.Permission {
 	return types.AppPermission
 }
 
+// GetPermission - implements types.Msg
+func (msg SubscribeMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetPermission - implements types.Msg
+func (msg CancelSubscriptionMsg) GetPermission() types.Permission {
+	return types.AppPermission
+}
+
 // GetSignBytes - implements sdk.Msg
 func (msg CreatePostMsg) GetSignBytes() []byte {
 	return getSignBytes(msg)
@@ -352,6 +463,16 @@ func (msg ViewMsg) GetSignBytes() []byte {
 	return getSignBytes(msg)
 }
 
+// GetSignBytes - implements sdk.Msg
+func (msg SubscribeMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg CancelSubscriptionMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
 func getSignBytes(msg sdk.Msg) []byte {
 	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
 	if err != nil {
@@ -390,17 +511,27 @@ func (msg ViewMsg) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
 }
 
+// GetSigners - implements sdk.Msg
+func (msg SubscribeMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Subscriber)}
+}
+
+// GetSigners - implements sdk.Msg
+func (msg CancelSubscriptionMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Subscriber)}
+}
+
 // String implements Stringer
 func (msg CreatePostMsg) String() string {
 	return fmt.Sprintf("Post.CreatePostMsg{author:%v, postID:%v, title:%v, content:%v, parentAuthor:%v,"+
-		"parentPostID:%v, sourceAuthor:%v, sourcePostID:%v,links:%v, redistribution split rate:%v}",
+		"parentPostID:%v, sourceAuthor:%v, sourcePostID:%v,links:%v, redistribution split rate:%v, tags:%v}",
 		msg.Author, msg.PostID, msg.Title, msg.Content, msg.ParentAuthor, msg.ParentPostID, msg.SourceAuthor, msg.SourcePostID,
-		msg.Links, msg.RedistributionSplitRate)
+		msg.Links, msg.RedistributionSplitRate, msg.Tags)
 }
 
 func (msg UpdatePostMsg) String() string {
-	return fmt.Sprintf("Post.UpdatePostMsg{author:%v, postID:%v, title:%v, content:%v, links:%v}",
-		msg.Author, msg.PostID, msg.Title, msg.Content, msg.Links)
+	return fmt.Sprintf("Post.UpdatePostMsg{author:%v, postID:%v, title:%v, content:%v, links:%v, tags:%v}",
+		msg.Author, msg.PostID, msg.Title, msg.Content, msg.Links, msg.Tags)
 }
 
 func (msg DeletePostMsg) String() string {
@@ -425,6 +556,17 @@ func (msg ViewMsg) String() string {
 		msg.Username, msg.Author, msg.PostID)
 }
 
+func (msg SubscribeMsg) String() string {
+	return fmt.Sprintf(
+		"Post.SubscribeMsg{subscriber: %v, creator:%v, post id: %v, amount: %v, periods: %v}",
+		msg.Subscriber, msg.Creator, msg.PostID, msg.Amount, msg.Periods)
+}
+
+func (msg CancelSubscriptionMsg) String() string {
+	return fmt.Sprintf(
+		"Post.CancelSubscriptionMsg{subscriber: %v, creator:%v}", msg.Subscriber, msg.Creator)
+}
+
 // GetConsumeAmount - implements types.Msg
 func (msg CreatePostMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
@@ -455,3 +597,14 @@ func (msg ReportOrUpvoteMsg) GetConsumeAmount() types.Coin {
 func (msg ViewMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+// GetConsumeAmount - implements types.Msg
+func (msg SubscribeMsg) GetConsumeAmount() types.Coin {
+	coin, _ := types.LinoToCoin(msg.Amount)
+	return coin
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg CancelSubscriptionMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
@@ -56,7 +56,7 @@ func getCommentAndRepost(
 	t *testing.T, parentAuthor, parentPostID, sourceAuthor, sourcePostID string) CreatePostMsg {
 	return NewCreatePostMsg(
 		"author", "TestPostID", string(make([]byte, 100)), string(make([]byte, 1000)),
-		parentAuthor, parentPostID, sourceAuthor, sourcePostID, "0", nil)
+		parentAuthor, parentPostID, sourceAuthor, sourcePostID, "0", nil, nil)
 }
 
 func TestCreatePostMsg(t *testing.T) {
@@ -267,6 +267,58 @@ func TestCreatePostMsg(t *testing.T) {
 			},
 			expectedResult: ErrURLLengthTooLong(),
 		},
+		{
+			testName: "post with tags",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				Content:                 string(make([]byte, 1000)),
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				RedistributionSplitRate: "0",
+				Tags:                    []string{"music", string(make([]byte, 30))},
+			},
+			expectedResult: nil,
+		},
+		{
+			testName: "too many tags",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				Content:                 string(make([]byte, 1000)),
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				RedistributionSplitRate: "0",
+				Tags:                    []string{"a", "b", "c", "d", "e", "f"},
+			},
+			expectedResult: ErrTooManyTags(),
+		},
+		{
+			testName: "empty tag",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				Content:                 string(make([]byte, 1000)),
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				RedistributionSplitRate: "0",
+				Tags:                    []string{""},
+			},
+			expectedResult: ErrInvalidTag(""),
+		},
+		{
+			testName: "tag is too long",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				Content:                 string(make([]byte, 1000)),
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				RedistributionSplitRate: "0",
+				Tags:                    []string{string(make([]byte, 31))},
+			},
+			expectedResult: ErrInvalidTag(string(make([]byte, 31))),
+		},
 	}
 	for _, tc := range testCases {
 		result := tc.msg.ValidateBasic()
@@ -285,65 +337,83 @@ func TestUpdatePostMsg(t *testing.T) {
 		{
 			testName: "normal case 1",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectedResult: nil,
 		},
 		{
 			testName: "normal case 2",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectedResult: nil,
 		},
 		{
 			testName: "utf8 title",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", maxLenOfUTF8Title, "content", []types.IDToURLMapping{}),
+				"author", "postID", maxLenOfUTF8Title, "content", []types.IDToURLMapping{}, nil),
 			expectedResult: nil,
 		},
 		{
 			testName: "utf8 content",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", "title", maxLenOfUTF8Content, []types.IDToURLMapping{}),
+				"author", "postID", "title", maxLenOfUTF8Content, []types.IDToURLMapping{}, nil),
 			expectedResult: nil,
 		},
 		{
 			testName: "no author",
 			updatePostMsg: NewUpdatePostMsg(
-				"", "postID", "title", "content", []types.IDToURLMapping{}),
+				"", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectedResult: ErrNoAuthor(),
 		},
 		{
 			testName: "no post id",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "", "title", "content", []types.IDToURLMapping{}),
+				"author", "", "title", "content", []types.IDToURLMapping{}, nil),
 			expectedResult: ErrNoPostID(),
 		},
 		{
 			testName: "post tile is too long",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", string(make([]byte, 101)), "content", []types.IDToURLMapping{}),
+				"author", "postID", string(make([]byte, 101)), "content", []types.IDToURLMapping{}, nil),
 			expectedResult: ErrPostTitleExceedMaxLength(),
 		},
 		{
 			testName: "post utf8 tile is too long",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", tooLongOfUTF8Title, "content", []types.IDToURLMapping{}),
+				"author", "postID", tooLongOfUTF8Title, "content", []types.IDToURLMapping{}, nil),
 			expectedResult: ErrPostTitleExceedMaxLength(),
 		},
 		{
 			testName: "post content is too long",
 			updatePostMsg: NewUpdatePostMsg(
 				"author", "postID", string(make([]byte, 100)), string(make([]byte, 1001)),
-				[]types.IDToURLMapping{}),
+				[]types.IDToURLMapping{}, nil),
 			expectedResult: ErrPostContentExceedMaxLength(),
 		},
 		{
 			testName: "post utf8 content is too long",
 			updatePostMsg: NewUpdatePostMsg(
 				"author", "postID", string(make([]byte, 100)), tooLongOfUTF8Content,
-				[]types.IDToURLMapping{}),
+				[]types.IDToURLMapping{}, nil),
 			expectedResult: ErrPostContentExceedMaxLength(),
 		},
+		{
+			testName: "update tags",
+			updatePostMsg: NewUpdatePostMsg(
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, []string{"music", "art"}),
+			expectedResult: nil,
+		},
+		{
+			testName: "duplicate tags",
+			updatePostMsg: NewUpdatePostMsg(
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, []string{"music", "music"}),
+			expectedResult: ErrInvalidTag("music"),
+		},
+		{
+			testName: "tag contains key separator",
+			updatePostMsg: NewUpdatePostMsg(
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, []string{"music/art"}),
+			expectedResult: ErrInvalidTag("music/art"),
+		},
 	}
 	for _, tc := range testCases {
 		result := tc.updatePostMsg.ValidateBasic()
@@ -523,6 +593,83 @@ func TestDonationMsg(t *testing.T) {
 	}
 }
 
+func TestSubscribeMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		subscribeMsg  SubscribeMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			subscribeMsg:  NewSubscribeMsg("test", "author", "postID", types.LNO("1"), 12, ""),
+			expectedError: nil,
+		},
+		{
+			testName:      "no subscriber",
+			subscribeMsg:  NewSubscribeMsg("", "author", "postID", types.LNO("1"), 12, ""),
+			expectedError: ErrNoUsername(),
+		},
+		{
+			testName:      "invalid target - no post id",
+			subscribeMsg:  NewSubscribeMsg("test", "author", "", types.LNO("1"), 12, ""),
+			expectedError: ErrInvalidTarget(),
+		},
+		{
+			testName:      "zero periods",
+			subscribeMsg:  NewSubscribeMsg("test", "author", "postID", types.LNO("1"), 0, ""),
+			expectedError: ErrInvalidSubscriptionPeriods(),
+		},
+		{
+			testName:      "too many periods",
+			subscribeMsg:  NewSubscribeMsg("test", "author", "postID", types.LNO("1"), types.MaximumSubscriptionPeriods+1, ""),
+			expectedError: ErrInvalidSubscriptionPeriods(),
+		},
+		{
+			testName:      "zero coin is less than lower bound",
+			subscribeMsg:  NewSubscribeMsg("test", "author", "postID", types.LNO("0"), 12, ""),
+			expectedError: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.subscribeMsg.ValidateBasic()
+		if !assert.Equal(t, tc.expectedError, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestCancelSubscriptionMsg(t *testing.T) {
+	testCases := []struct {
+		testName              string
+		cancelSubscriptionMsg CancelSubscriptionMsg
+		expectedError         sdk.Error
+	}{
+		{
+			testName:              "normal case",
+			cancelSubscriptionMsg: NewCancelSubscriptionMsg("test", "author"),
+			expectedError:         nil,
+		},
+		{
+			testName:              "no subscriber",
+			cancelSubscriptionMsg: NewCancelSubscriptionMsg("", "author"),
+			expectedError:         ErrNoUsername(),
+		},
+		{
+			testName:              "no creator",
+			cancelSubscriptionMsg: NewCancelSubscriptionMsg("test", ""),
+			expectedError:         ErrInvalidTarget(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.cancelSubscriptionMsg.ValidateBasic()
+		if !assert.Equal(t, tc.expectedError, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestReportOrUpvoteMsg(t *testing.T) {
 	testCases := []struct {
 		testName          string
@@ -660,7 +807,7 @@ func TestMsgPermission(t *testing.T) {
 		{
 			testName: "update post",
 			msg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectedPermission: types.AppPermission,
 		},
 	}
@@ -722,7 +869,7 @@ func TestGetSignBytes(t *testing.T) {
 		{
 			testName: "update post",
 			msg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 		},
 	}
 
@@ -786,7 +933,7 @@ func TestGetSigners(t *testing.T) {
 		{
 			testName: "update post",
 			msg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectSigners: []types.AccountKey{"author"},
 		},
 	}
@@ -860,7 +1007,7 @@ func TestGetConsumeAmount(t *testing.T) {
 		{
 			testName: "update post",
 			msg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectAmount: types.NewCoinFromInt64(0),
 		},
 	}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	acc "github.com/lino-network/lino/x/account"
@@ -60,6 +61,7 @@ func setupTest(
 	cdc := globalManager.WireCodec()
 	cdc.RegisterInterface((*types.Event)(nil), nil)
 	cdc.RegisterConcrete(RewardEvent{}, "event/reward", nil)
+	cdc.RegisterConcrete(SubscriptionEvent{}, "event/subscription", nil)
 
 	err := InitGlobalManager(ctx, globalManager)
 	assert.Nil(t, err)
@@ -119,7 +121,7 @@ func createTestPost(
 	err = pm.CreatePost(
 		ctx, types.AccountKey(user), postID, "", "", "", "",
 		string(make([]byte, 1000)), string(make([]byte, 50)),
-		splitRate, []types.IDToURLMapping{})
+		splitRate, []types.IDToURLMapping{}, nil)
 	assert.Nil(t, err)
 	return user, postID
 }
@@ -133,7 +135,37 @@ func createTestRepost(
 	err := pm.CreatePost(
 		ctx, types.AccountKey(user), postID, sourceUser, sourcePostID, "", "",
 		string(make([]byte, 1000)), string(make([]byte, 50)),
-		sdk.ZeroRat(), []types.IDToURLMapping{})
+		sdk.ZeroRat(), []types.IDToURLMapping{}, nil)
 	assert.Nil(t, err)
 	return user, postID
 }
+
+// getIndexValues - values under an index from the largest key to the smallest
+func getIndexValues(ctx sdk.Context, prefix []byte) [][]byte {
+	values := [][]byte{}
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(testPostKVStoreKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		values = append([][]byte{iterator.Value()}, values...)
+	}
+	return values
+}
+
+func getIndexPermlinks(ctx sdk.Context, prefix []byte) []types.Permlink {
+	permlinks := []types.Permlink{}
+	for _, value := range getIndexValues(ctx, prefix) {
+		permlinks = append(permlinks, types.Permlink(value))
+	}
+	return permlinks
+}
+
+func getDonationRank(t *testing.T, ctx sdk.Context, permlink types.Permlink) []model.Donations {
+	donationsList := []model.Donations{}
+	cdc := wire.NewCodec()
+	for _, value := range getIndexValues(ctx, model.GetPostDonationRankPrefix(permlink)) {
+		var postDonations model.Donations
+		assert.Nil(t, cdc.UnmarshalJSON(value, &postDonations))
+		donationsList = append(donationsList, postDonations)
+	}
+	return donationsList
+}
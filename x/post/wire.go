@@ -12,6 +12,8 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(DonateMsg{}, "lino/donate", nil)
 	cdc.RegisterConcrete(ViewMsg{}, "lino/view", nil)
 	cdc.RegisterConcrete(ReportOrUpvoteMsg{}, "lino/reportOrUpvote", nil)
+	cdc.RegisterConcrete(SubscribeMsg{}, "lino/subscribe", nil)
+	cdc.RegisterConcrete(CancelSubscriptionMsg{}, "lino/cancelSubscription", nil)
 }
 
 var msgCdc = wire.NewCodec()
@@ -39,6 +39,45 @@ func GetExpiredProposalCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetUpgradePlanCmd returns the scheduled protocol upgrade
+func GetUpgradePlanCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "query-upgrade-plan",
+		Short: "Query scheduled protocol upgrade",
+		RunE:  cmdr.getUpgradePlanCmd,
+	}
+}
+
+// GetPendingProposalCmd returns a specific proposal which is collecting deposit
+func GetPendingProposalCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "query-pending-proposal",
+		Short: "Query a specific proposal which is collecting deposit",
+		RunE:  cmdr.getPendingProposalCmd,
+	}
+}
+
+// GetProposalDepositCmd returns deposits on a proposal
+func GetProposalDepositCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "query-proposal-deposit",
+		Short: "Query deposits on a proposal",
+		RunE:  cmdr.getProposalDepositCmd,
+	}
+}
+
 type commander struct {
 	storeName string
 	cdc       *wire.Codec
@@ -95,3 +134,84 @@ func (c commander) getExpiredProposalCmd(cmd *cobra.Command, args []string) erro
 	fmt.Println(string(output))
 	return nil
 }
+
+func (c commander) getUpgradePlanCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+
+	res, err := ctx.Query(model.GetUpgradePlanKey(), c.storeName)
+	if err != nil {
+		return err
+	}
+	if len(res) == 0 {
+		fmt.Println("no upgrade scheduled")
+		return nil
+	}
+	plan := new(model.UpgradePlan)
+	if err := c.cdc.UnmarshalJSON(res, plan); err != nil {
+		return err
+	}
+
+	// print out upgrade plan
+	output, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+func (c commander) getPendingProposalCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 {
+		return errors.New("You must provide proposal ID")
+	}
+
+	proposalID := types.ProposalKey(args[0])
+
+	res, err := ctx.Query(model.GetPendingProposalKey(proposalID), c.storeName)
+	if err != nil {
+		return err
+	}
+	proposal := new(model.Proposal)
+	if err := c.cdc.UnmarshalJSON(res, proposal); err != nil {
+		return err
+	}
+
+	// print out proposal
+	output, err := json.MarshalIndent(proposal, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+func (c commander) getProposalDepositCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 {
+		return errors.New("You must provide proposal ID")
+	}
+
+	proposalID := types.ProposalKey(args[0])
+
+	res, err := ctx.Query(model.GetDepositKey(proposalID), c.storeName)
+	if err != nil {
+		return err
+	}
+	if len(res) == 0 {
+		fmt.Println("no deposit on the proposal")
+		return nil
+	}
+	deposit := new(model.ProposalDeposit)
+	if err := c.cdc.UnmarshalJSON(res, deposit); err != nil {
+		return err
+	}
+
+	// print out deposit
+	output, err := json.MarshalIndent(deposit, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
@@ -0,0 +1,427 @@
+package vote
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/param"
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/proposal"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// changeParamProposal - describes how to build a change param proposal from a param file
+type changeParamProposal struct {
+	use      string
+	short    string
+	key      []byte
+	newParam func() interface{}
+	newMsg   func(creator string, parameter interface{}, reason string) sdk.Msg
+}
+
+var changeParamProposals = []changeParamProposal{
+	{
+		use:      "global-allocation",
+		short:    "propose to change global allocation param",
+		key:      param.GetAllocationParamKey(),
+		newParam: func() interface{} { return new(param.GlobalAllocationParam) },
+		newMsg: func(creator string, parameter interface{}, reason string) sdk.Msg {
+			return proposal.NewChangeGlobalAllocationParamMsg(
+				creator, *parameter.(*param.GlobalAllocationParam), reason)
+		},
+	},
+	{
+		use:      "evaluate-of-content-value",
+		short:    "propose to change evaluate of content value param",
+		key:      param.GetEvaluateOfContentValueParamKey(),
+		newParam: func() interface{} { return new(param.EvaluateOfContentValueParam) },
+		newMsg: func(creator string, parameter interface{}, reason string) sdk.Msg {
+			return proposal.NewChangeEvaluateOfContentValueParamMsg(
+				creator, *parameter.(*param.EvaluateOfContentValueParam), reason)
+		},
+	},
+	{
+		use:      "infra-internal-allocation",
+		short:    "propose to change infra internal allocation param",
+		key:      param.GetInfraInternalAllocationParamKey(),
+		newParam: func() interface{} { return new(param.InfraInternalAllocationParam) },
+		newMsg: func(creator string, parameter interface{}, reason string) sdk.Msg {
+			return proposal.NewChangeInfraInternalAllocationParamMsg(
+				creator, *parameter.(*param.InfraInternalAllocationParam), reason)
+		},
+	},
+	{
+		use:      "vote",
+		short:    "propose to change vote param",
+		key:      param.GetVoteParamKey(),
+		newParam: func() interface{} { return new(param.VoteParam) },
+		newMsg: func(creator string, parameter interface{}, reason string) sdk.Msg {
+			return proposal.NewChangeVoteParamMsg(creator, *parameter.(*param.VoteParam), reason)
+		},
+	},
+	{
+		use:      "proposal",
+		short:    "propose to change proposal param",
+		key:      param.GetProposalParamKey(),
+		newParam: func() interface{} { return new(param.ProposalParam) },
+		newMsg: func(creator string, parameter interface{}, reason string) sdk.Msg {
+			return proposal.NewChangeProposalParamMsg(creator, *parameter.(*param.ProposalParam), reason)
+		},
+	},
+	{
+		use:      "developer",
+		short:    "propose to change developer param",
+		key:      param.GetDeveloperParamKey(),
+		newParam: func() interface{} { return new(param.DeveloperParam) },
+		newMsg: func(creator string, parameter interface{}, reason string) sdk.Msg {
+			return proposal.NewChangeDeveloperParamMsg(creator, *parameter.(*param.DeveloperParam), reason)
+		},
+	},
+	{
+		use:      "validator",
+		short:    "propose to change validator param",
+		key:      param.GetValidatorParamKey(),
+		newParam: func() interface{} { return new(param.ValidatorParam) },
+		newMsg: func(creator string, parameter interface{}, reason string) sdk.Msg {
+			return proposal.NewChangeValidatorParamMsg(creator, *parameter.(*param.ValidatorParam), reason)
+		},
+	},
+	{
+		use:      "bandwidth",
+		short:    "propose to change bandwidth param",
+		key:      param.GetBandwidthParamKey(),
+		newParam: func() interface{} { return new(param.BandwidthParam) },
+		newMsg: func(creator string, parameter interface{}, reason string) sdk.Msg {
+			return proposal.NewChangeBandwidthParamMsg(creator, *parameter.(*param.BandwidthParam), reason)
+		},
+	},
+	{
+		use:      "account",
+		short:    "propose to change account param",
+		key:      param.GetAccountParamKey(),
+		newParam: func() interface{} { return new(param.AccountParam) },
+		newMsg: func(creator string, parameter interface{}, reason string) sdk.Msg {
+			return proposal.NewChangeAccountParamMsg(creator, *parameter.(*param.AccountParam), reason)
+		},
+	},
+	{
+		use:      "post",
+		short:    "propose to change post param",
+		key:      param.GetPostParamKey(),
+		newParam: func() interface{} { return new(param.PostParam) },
+		newMsg: func(creator string, parameter interface{}, reason string) sdk.Msg {
+			return proposal.NewChangePostParamMsg(creator, *parameter.(*param.PostParam), reason)
+		},
+	},
+	{
+		use:      "coin-day",
+		short:    "propose to change coin day param",
+		key:      param.GetCoinDayParamKey(),
+		newParam: func() interface{} { return new(param.CoinDayParam) },
+		newMsg: func(creator string, parameter interface{}, reason string) sdk.Msg {
+			return proposal.NewChangeCoinDayParamMsg(creator, *parameter.(*param.CoinDayParam), reason)
+		},
+	},
+	{
+		use:      "reputation",
+		short:    "propose to change reputation param",
+		key:      param.GetReputationParamKey(),
+		newParam: func() interface{} { return new(param.ReputationParam) },
+		newMsg: func(creator string, parameter interface{}, reason string) sdk.Msg {
+			return proposal.NewChangeReputationParamMsg(creator, *parameter.(*param.ReputationParam), reason)
+		},
+	},
+}
+
+// SubmitProposalCmd returns the submit command with one subcommand for each proposal type
+func SubmitProposalCmd(paramStoreName string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit",
+		Short: "submit a proposal",
+	}
+	for _, p := range changeParamProposals {
+		cmd.AddCommand(client.PostCommands(changeParamProposalTxCmd(paramStoreName, cdc, p))...)
+	}
+	cmd.AddCommand(client.PostCommands(
+		contentCensorshipProposalTxCmd(cdc),
+		contentAppealProposalTxCmd(cdc),
+		protocolUpgradeProposalTxCmd(cdc),
+		textProposalTxCmd(cdc),
+		communityPoolSpendProposalTxCmd(cdc))...)
+	return cmd
+}
+
+func changeParamProposalTxCmd(paramStoreName string, cdc *wire.Codec, p changeParamProposal) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   p.use,
+		Short: p.short,
+		RunE:  sendChangeParamProposalTx(paramStoreName, cdc, p),
+	}
+	cmd.Flags().String(client.FlagCreator, "", "creator of the proposal")
+	cmd.Flags().String(client.FlagParamFile, "", "JSON file of the new parameter")
+	cmd.Flags().String(client.FlagReason, "", "reason of the proposal")
+	cmd.Flags().Bool(client.FlagTrustNode, true, "Don't verify proofs for responses")
+	return cmd
+}
+
+func sendChangeParamProposalTx(
+	paramStoreName string, cdc *wire.Codec, p changeParamProposal) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		creator := viper.GetString(client.FlagCreator)
+
+		// load the new parameter from file
+		paramBytes, err := ioutil.ReadFile(viper.GetString(client.FlagParamFile))
+		if err != nil {
+			return err
+		}
+		newParam := p.newParam()
+		if err := cdc.UnmarshalJSON(paramBytes, newParam); err != nil {
+			return err
+		}
+
+		// create the message
+		msg := p.newMsg(creator, newParam, viper.GetString(client.FlagReason))
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		// show the difference with the current parameter
+		res, err := ctx.Query(p.key, paramStoreName)
+		if err != nil {
+			return err
+		}
+		curParam := p.newParam()
+		if err := cdc.UnmarshalJSON(res, curParam); err != nil {
+			return err
+		}
+		if err := printParamDiff(cdc, curParam, newParam); err != nil {
+			return err
+		}
+
+		// build and sign the transaction, then broadcast to Tendermint
+		txRes, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", txRes.Height, txRes.Hash.String())
+		return nil
+	}
+}
+
+// printParamDiff - print each field that differs between current and new parameter
+func printParamDiff(cdc *wire.Codec, curParam, newParam interface{}) error {
+	curFields, err := paramFields(cdc, curParam)
+	if err != nil {
+		return err
+	}
+	newFields, err := paramFields(cdc, newParam)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for name := range newFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	changed := false
+	for _, name := range names {
+		if bytes.Equal(curFields[name], newFields[name]) {
+			continue
+		}
+		changed = true
+		fmt.Printf("%s: %s -> %s\n", name, curFields[name], newFields[name])
+	}
+	if !changed {
+		return errors.New("New parameter is the same as the current one")
+	}
+	return nil
+}
+
+func paramFields(cdc *wire.Codec, parameter interface{}) (map[string]json.RawMessage, error) {
+	paramBytes, err := cdc.MarshalJSON(parameter)
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(paramBytes, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func contentCensorshipProposalTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "content-censorship",
+		Short: "propose to censor a post",
+		RunE: sendPostProposalTx(cdc, func(creator string, permlink types.Permlink, reason string) sdk.Msg {
+			return proposal.NewDeletePostContentMsg(creator, permlink, reason)
+		}),
+	}
+	cmd.Flags().String(client.FlagCreator, "", "creator of the proposal")
+	cmd.Flags().String(client.FlagAuthor, "", "author of the target post")
+	cmd.Flags().String(client.FlagPostID, "", "post id of the target post")
+	cmd.Flags().String(client.FlagReason, "", "reason of the proposal")
+	return cmd
+}
+
+func contentAppealProposalTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "content-appeal",
+		Short: "propose to restore a censored post",
+		RunE: sendPostProposalTx(cdc, func(creator string, permlink types.Permlink, reason string) sdk.Msg {
+			return proposal.NewAppealPostContentMsg(creator, permlink, reason)
+		}),
+	}
+	cmd.Flags().String(client.FlagCreator, "", "creator of the proposal")
+	cmd.Flags().String(client.FlagAuthor, "", "author of the target post")
+	cmd.Flags().String(client.FlagPostID, "", "post id of the target post")
+	cmd.Flags().String(client.FlagReason, "", "reason of the proposal")
+	return cmd
+}
+
+func sendPostProposalTx(
+	cdc *wire.Codec,
+	newMsg func(creator string, permlink types.Permlink, reason string) sdk.Msg) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		permlink := types.GetPermlink(
+			types.AccountKey(viper.GetString(client.FlagAuthor)), viper.GetString(client.FlagPostID))
+
+		// create the message
+		msg := newMsg(viper.GetString(client.FlagCreator), permlink, viper.GetString(client.FlagReason))
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
+
+func protocolUpgradeProposalTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "protocol-upgrade",
+		Short: "propose to upgrade the protocol",
+		RunE:  sendProtocolUpgradeProposalTx(cdc),
+	}
+	cmd.Flags().String(client.FlagCreator, "", "creator of the proposal")
+	cmd.Flags().String(client.FlagUpgradeName, "", "name of the upgrade handler in new binary")
+	cmd.Flags().Int64(client.FlagUpgradeHeight, 0, "block height the upgrade takes effect, must not be reached before the proposal is decided")
+	cmd.Flags().String(client.FlagLink, "", "link to the upgrade")
+	cmd.Flags().String(client.FlagReason, "", "reason of the proposal")
+	return cmd
+}
+
+func sendProtocolUpgradeProposalTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+
+		// create the message
+		msg := proposal.NewUpgradeProtocolMsg(
+			viper.GetString(client.FlagCreator), viper.GetString(client.FlagUpgradeName),
+			viper.GetInt64(client.FlagUpgradeHeight), viper.GetString(client.FlagLink),
+			viper.GetString(client.FlagReason))
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
+
+func textProposalTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "text",
+		Short: "propose a signaling text without on-chain effect",
+		RunE:  sendTextProposalTx(cdc),
+	}
+	cmd.Flags().String(client.FlagCreator, "", "creator of the proposal")
+	cmd.Flags().String(client.FlagReason, "", "text of the proposal")
+	return cmd
+}
+
+func sendTextProposalTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+
+		// create the message
+		msg := proposal.NewSubmitTextMsg(
+			viper.GetString(client.FlagCreator), viper.GetString(client.FlagReason))
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
+
+func communityPoolSpendProposalTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-spend",
+		Short: "propose to transfer coin from community pool to an account",
+		RunE:  sendCommunityPoolSpendProposalTx(cdc),
+	}
+	cmd.Flags().String(client.FlagCreator, "", "creator of the proposal")
+	cmd.Flags().String(client.FlagReceiver, "", "recipient of the spend")
+	cmd.Flags().String(client.FlagAmount, "", "amount of LNO to spend")
+	cmd.Flags().String(client.FlagReason, "", "reason of the proposal")
+	return cmd
+}
+
+func sendCommunityPoolSpendProposalTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+
+		// create the message
+		msg := proposal.NewSpendCommunityPoolMsg(
+			viper.GetString(client.FlagCreator), viper.GetString(client.FlagReceiver),
+			viper.GetString(client.FlagAmount), viper.GetString(client.FlagReason))
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -24,6 +24,8 @@ func VoteProposalTxCmd(cdc *wire.Codec) *cobra.Command {
 	cmd.Flags().String(client.FlagVoter, "", "voter for the proposal")
 	cmd.Flags().Int64(client.FlagProposalID, -1, "proposal id")
 	cmd.Flags().Bool(client.FlagResult, true, "vote result")
+	cmd.Flags().Bool(client.FlagAbstain, false, "abstain from the proposal, vote result is ignored")
+	cmd.Flags().Bool(client.FlagVeto, false, "vote no with veto, vote result is ignored")
 	return cmd
 }
 
@@ -36,6 +38,12 @@ func sendVoteProposalTx(cdc *wire.Codec) client.CommandTxCallback {
 
 		// create the message
 		msg := proposal.NewVoteProposalMsg(voter, id, result)
+		if viper.GetBool(client.FlagAbstain) {
+			msg = proposal.NewAbstainVoteProposalMsg(voter, id)
+		}
+		if viper.GetBool(client.FlagVeto) {
+			msg = proposal.NewVetoVoteProposalMsg(voter, id)
+		}
 
 		// build and sign the transaction, then broadcast to Tendermint
 		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
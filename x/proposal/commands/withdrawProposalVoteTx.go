@@ -0,0 +1,48 @@
+package vote
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/x/proposal"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WithdrawProposalVoteTxCmd will create a withdrawProposalVote tx and sign it with the given key
+func WithdrawProposalVoteTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-proposal-vote",
+		Short: "withdraw vote from an ongoing proposal",
+		RunE:  sendWithdrawProposalVoteTx(cdc),
+	}
+	cmd.Flags().String(client.FlagVoter, "", "voter of the proposal")
+	cmd.Flags().Int64(client.FlagProposalID, -1, "proposal id")
+	return cmd
+}
+
+func sendWithdrawProposalVoteTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		voter := viper.GetString(client.FlagVoter)
+		id := viper.GetInt64(client.FlagProposalID)
+
+		// create the message
+		msg := proposal.NewWithdrawProposalVoteMsg(voter, id)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -22,6 +22,16 @@ func ErrCensorshipPostIsDeleted(permlink types.Permlink) sdk.Error {
 	return types.NewError(types.CodeCensorshipPostIsDeleted, fmt.Sprintf("censorship post %v is deleted", permlink))
 }
 
+// ErrCensorshipPostIsCensored - error when censorship post is already censored
+func ErrCensorshipPostIsCensored(permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodeCensorshipPostIsCensored, fmt.Sprintf("censorship post %v is already censored", permlink))
+}
+
+// ErrNotPostAuthor - error when appeal is not submitted by post author
+func ErrNotPostAuthor(permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodeNotPostAuthor, fmt.Sprintf("only author can appeal post %v", permlink))
+}
+
 // ErrVoterNotFound - error when voter is not found
 func ErrVoterNotFound() sdk.Error {
 	return types.NewError(types.CodeVoterNotFound, fmt.Sprintf("voter is not found"))
@@ -71,3 +81,34 @@ func ErrCensorshipPostNotFound() sdk.Error {
 func ErrIllegalParameter() sdk.Error {
 	return types.NewError(types.CodeIllegalParameter, fmt.Sprintf("invalid parameter"))
 }
+
+// ErrInvalidVoteOption - error if vote both agrees and abstains
+func ErrInvalidVoteOption() sdk.Error {
+	return types.NewError(types.CodeInvalidVoteOption, fmt.Sprintf("can't agree and abstain at the same time"))
+}
+
+// ErrNotPendingProposal - error if deposit to a proposal which isn't collecting deposit
+func ErrNotPendingProposal() sdk.Error {
+	return types.NewError(types.CodeNotPendingProposal, fmt.Sprintf("not pending proposal"))
+}
+
+// ErrRecipientNotFound - error if community pool spend recipient doesn't exist
+func ErrRecipientNotFound(recipient types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeRecipientNotFound, fmt.Sprintf("recipient %v not found", recipient))
+}
+
+// ErrInvalidUpgradeName - error if protocol upgrade name is empty or too long
+func ErrInvalidUpgradeName() sdk.Error {
+	return types.NewError(types.CodeInvalidUpgradeName, fmt.Sprintf("invalid upgrade name"))
+}
+
+// ErrInvalidUpgradeHeight - error if protocol upgrade height is not positive
+func ErrInvalidUpgradeHeight(height int64) sdk.Error {
+	return types.NewError(types.CodeInvalidUpgradeHeight, fmt.Sprintf("invalid upgrade height %v", height))
+}
+
+// ErrUpgradeHeightTooLow - error if protocol upgrade height may be passed before proposal is decided
+func ErrUpgradeHeightTooLow(height, minHeight int64) sdk.Error {
+	return types.NewError(types.CodeUpgradeHeightTooLow,
+		fmt.Sprintf("upgrade height %v may be passed before proposal is decided, must be above %v", height, minHeight))
+}
@@ -3,6 +3,7 @@ package proposal
 import (
 	"github.com/lino-network/lino/x/global"
 	"github.com/lino-network/lino/x/post"
+	"github.com/lino-network/lino/x/proposal/model"
 	"github.com/lino-network/lino/x/vote"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -27,12 +28,25 @@ func (dpe DecideProposalEvent) Execute(
 		return ErrOngoingProposalNotFound()
 	}
 
+	// tally votes with voting power at decision time
+	if err := updateProposalTally(ctx, proposalManager, voteManager, dpe.ProposalID); err != nil {
+		return err
+	}
+
 	// get all oncall validators (make sure they voted on certain type of proposal)
 	lst, err := valManager.GetValidatorList(ctx)
 	if err != nil {
 		return err
 	}
 
+	// record if oncall validators voted on this proposal
+	for _, validator := range lst.OncallValidators {
+		if err := valManager.RecordProposalParticipation(
+			ctx, validator, voteManager.DoesVoteExist(ctx, dpe.ProposalID, validator)); err != nil {
+			return err
+		}
+	}
+
 	// get penalty list
 	penaltyList, err := voteManager.GetPenaltyList(
 		ctx, dpe.ProposalID, dpe.ProposalType, lst.OncallValidators)
@@ -46,19 +60,38 @@ func (dpe DecideProposalEvent) Execute(
 		return err
 	}
 
-	// add coins back to inflation pool
-	if err := gm.AddToValidatorInflationPool(ctx, actualPenalty); err != nil {
+	// penalties fund the community pool
+	if err := gm.AddToCommunityPool(ctx, actualPenalty); err != nil {
+		return err
+	}
+
+	// quorum is calculated from total lino stake at decision time
+	totalLinoStake, err := gm.GetTotalLinoStake(ctx)
+	if err != nil {
 		return err
 	}
 
 	// update the ongoing and past proposal list
 	proposalRes, err := proposalManager.UpdateProposalPassStatus(
-		ctx, dpe.ProposalType, dpe.ProposalID)
+		ctx, dpe.ProposalType, dpe.ProposalID, totalLinoStake)
 	if err != nil {
 		return err
 	}
+
+	// return or forfeit deposits based on participation and veto share
+	if err := dpe.SettleDeposit(
+		ctx, dpe.ProposalID, totalLinoStake, am, proposalManager, gm); err != nil {
+		return err
+	}
+
 	// majority disagree this proposal
 	if proposalRes == types.ProposalNotPass {
+		switch dpe.ProposalType {
+		case types.ContentCensorship:
+			return dpe.RejectContentCensorship(ctx, dpe.ProposalID, proposalManager, postManager)
+		case types.ContentAppeal:
+			return dpe.RejectContentAppeal(ctx, dpe.ProposalID, proposalManager, postManager)
+		}
 		return nil
 	}
 
@@ -76,10 +109,56 @@ func (dpe DecideProposalEvent) Execute(
 		if err := dpe.ExecuteProtocolUpgrade(ctx, dpe.ProposalID, proposalManager); err != nil {
 			return err
 		}
+	case types.ContentAppeal:
+		if err := dpe.ExecuteContentAppeal(ctx, dpe.ProposalID, proposalManager, postManager); err != nil {
+			return err
+		}
+	case types.CommunityPoolSpend:
+		if err := dpe.ExecuteCommunityPoolSpend(ctx, dpe.ProposalID, am, proposalManager, gm); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+// SettleDeposit - forfeit deposits if too few voted or veto share is too large,
+// otherwise return deposits to depositors. Forfeited deposit is burned or goes to
+// validator inflation pool. Proposals created before co-deposit have no deposit record
+// and their deposit is returned by coin return events
+func (dpe DecideProposalEvent) SettleDeposit(
+	ctx sdk.Context, curID types.ProposalKey, totalLinoStake types.Coin, am acc.AccountManager,
+	proposalManager ProposalManager, gm global.GlobalManager) sdk.Error {
+	deposit, err := proposalManager.GetDeposit(ctx, curID)
+	if err != nil || deposit == nil {
+		return err
+	}
+	forfeit, err := proposalManager.ShouldForfeitDeposit(ctx, dpe.ProposalType, curID, totalLinoStake)
+	if err != nil {
+		return err
+	}
+	if !forfeit {
+		if err := returnDeposit(ctx, deposit, am, gm); err != nil {
+			return err
+		}
+		return proposalManager.DeleteDeposit(ctx, curID)
+	}
+
+	param, err := proposalManager.paramHolder.GetProposalParam(ctx)
+	if err != nil {
+		return err
+	}
+	if param.DepositForfeitToValidator {
+		if err := gm.ReturnValidatorInflation(ctx, deposit.Total); err != nil {
+			return err
+		}
+	} else {
+		if err := gm.BurnCoin(ctx, deposit.Total); err != nil {
+			return err
+		}
+	}
+	return proposalManager.DeleteDeposit(ctx, curID)
+}
+
 // ExecuteChangeParam - reigster parameter change event
 func (dpe DecideProposalEvent) ExecuteChangeParam(
 	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager,
@@ -94,7 +173,7 @@ func (dpe DecideProposalEvent) ExecuteChangeParam(
 	return nil
 }
 
-// ExecuteContentCensorship - delete target post
+// ExecuteContentCensorship - censor target post, post info is kept for author's appeal
 func (dpe DecideProposalEvent) ExecuteContentCensorship(
 	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager,
 	postManager post.PostManager) sdk.Error {
@@ -103,18 +182,169 @@ func (dpe DecideProposalEvent) ExecuteContentCensorship(
 		return err
 	}
 
-	// TODO add content censorship logic
 	if exist := postManager.DoesPostExist(ctx, permlink); !exist {
 		return ErrCensorshipPostNotFound()
 	}
-	if err := postManager.DeletePost(ctx, permlink); err != nil {
+	// post has been censored or appealed by another proposal
+	if isCensored, err := postManager.IsCensored(ctx, permlink); isCensored || err != nil {
+		return err
+	}
+	if err := postManager.CensorPost(ctx, permlink); err != nil {
 		return err
 	}
 	return nil
 }
 
-// ExecuteProtocolUpgrade - since execute protocol upgrade engage code change, the process need to be done manually
+// RejectContentCensorship - un-hide target post if it's hidden pending review
+func (dpe DecideProposalEvent) RejectContentCensorship(
+	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager,
+	postManager post.PostManager) sdk.Error {
+	permlink, err := proposalManager.GetPermlink(ctx, curID)
+	if err != nil {
+		return err
+	}
+	if exist := postManager.DoesPostExist(ctx, permlink); !exist {
+		return nil
+	}
+	return postManager.RejectCensorship(ctx, permlink)
+}
+
+// ExecuteContentAppeal - restore target post after appeal passed
+func (dpe DecideProposalEvent) ExecuteContentAppeal(
+	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager,
+	postManager post.PostManager) sdk.Error {
+	permlink, err := proposalManager.GetPermlink(ctx, curID)
+	if err != nil {
+		return err
+	}
+	if state, err := postManager.GetModerationState(ctx, permlink); state != types.PostAppealed || err != nil {
+		return err
+	}
+	return postManager.RestorePost(ctx, permlink)
+}
+
+// RejectContentAppeal - censor target post after appeal is rejected, appealed
+// hidden post stays hidden pending content censorship
+func (dpe DecideProposalEvent) RejectContentAppeal(
+	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager,
+	postManager post.PostManager) sdk.Error {
+	permlink, err := proposalManager.GetPermlink(ctx, curID)
+	if err != nil {
+		return err
+	}
+	if state, err := postManager.GetModerationState(ctx, permlink); state != types.PostAppealed || err != nil {
+		return err
+	}
+	return postManager.RejectAppeal(ctx, permlink)
+}
+
+// ExecuteProtocolUpgrade - schedule upgrade plan, chain halts at upgrade height
+// unless the running binary has a handler registered for the upgrade
 func (dpe DecideProposalEvent) ExecuteProtocolUpgrade(
 	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager) sdk.Error {
+	return proposalManager.ScheduleUpgrade(ctx, curID)
+}
+
+// ExecuteCommunityPoolSpend - transfer coin from community pool to recipient,
+// proposal is left without effect if recipient is gone or pool is insufficient
+func (dpe DecideProposalEvent) ExecuteCommunityPoolSpend(
+	ctx sdk.Context, curID types.ProposalKey, am acc.AccountManager,
+	proposalManager ProposalManager, gm global.GlobalManager) sdk.Error {
+	recipient, amount, err := proposalManager.GetCommunityPoolSpend(ctx, curID)
+	if err != nil {
+		return err
+	}
+	if !am.DoesAccountExist(ctx, recipient) {
+		return nil
+	}
+	pool, err := gm.GetCommunityPool(ctx)
+	if err != nil {
+		return err
+	}
+	if !pool.IsGTE(amount) {
+		return nil
+	}
+	if err := gm.SpendFromCommunityPool(ctx, amount); err != nil {
+		return err
+	}
+	return am.AddSavingCoin(ctx, recipient, amount, "", string(curID), types.CommunityPoolGrant)
+}
+
+// ExpireProposalDepositEvent - revoke proposal which doesn't collect min deposit in deposit period
+type ExpireProposalDepositEvent struct {
+	ProposalType types.ProposalType `json:"proposal_type"`
+	ProposalID   types.ProposalKey  `json:"proposal_id"`
+}
+
+// Execute - return deposits and revoke proposal if it's still pending,
+// content under censorship or appeal is treated as if the proposal didn't pass
+func (epde ExpireProposalDepositEvent) Execute(
+	ctx sdk.Context, am acc.AccountManager, proposalManager ProposalManager,
+	postManager post.PostManager, gm global.GlobalManager) sdk.Error {
+	// voting period has started
+	if !proposalManager.IsPendingProposal(ctx, epde.ProposalID) {
+		return nil
+	}
+
+	deposit, err := proposalManager.GetDeposit(ctx, epde.ProposalID)
+	if err != nil {
+		return err
+	}
+	if deposit != nil {
+		if err := returnDeposit(ctx, deposit, am, gm); err != nil {
+			return err
+		}
+		if err := proposalManager.DeleteDeposit(ctx, epde.ProposalID); err != nil {
+			return err
+		}
+	}
+
+	if err := proposalManager.RevokePendingProposal(ctx, epde.ProposalID); err != nil {
+		return err
+	}
+
+	dpe := DecideProposalEvent{
+		ProposalType: epde.ProposalType,
+		ProposalID:   epde.ProposalID,
+	}
+	switch epde.ProposalType {
+	case types.ContentCensorship:
+		return dpe.RejectContentCensorship(ctx, epde.ProposalID, proposalManager, postManager)
+	case types.ContentAppeal:
+		return dpe.RejectContentAppeal(ctx, epde.ProposalID, proposalManager, postManager)
+	}
+	return nil
+}
+
+// returnDeposit - deposits are frozen and returned to depositors by coin return events
+// in one installment at next block
+func returnDeposit(
+	ctx sdk.Context, deposit *model.ProposalDeposit, am acc.AccountManager, gm global.GlobalManager) sdk.Error {
+	for _, d := range deposit.Deposits {
+		if err := returnCoinTo(ctx, d.Depositor, gm, am, int64(1), int64(0), d.Amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func returnCoinTo(
+	ctx sdk.Context, name types.AccountKey, gm global.GlobalManager, am acc.AccountManager,
+	times int64, interval int64, coin types.Coin) sdk.Error {
+	// frozen money is only a record, coin is still returned if depositor's frozen money list is full
+	if err := am.AddFrozenMoney(
+		ctx, name, coin, ctx.BlockHeader().Time.Unix(), interval, times); err != nil &&
+		err.Code() != types.CodeFrozenMoneyListTooLong {
+		return err
+	}
+
+	events, err := am.CreatePendingCoinReturnEvents(ctx, name, times, interval, coin, types.ProposalReturnCoin)
+	if err != nil {
+		return err
+	}
+
+	if err := gm.RegisterCoinReturnEvent(ctx, events, times, interval); err != nil {
+		return err
+	}
 	return nil
 }
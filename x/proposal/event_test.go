@@ -62,7 +62,6 @@ func TestDecideProposal(t *testing.T) {
 		voter                 types.AccountKey
 		proposalID            types.ProposalKey
 		voterRes              bool
-		votingPower           types.Coin
 		expectOngoingProposal []types.ProposalKey
 		expectDecidedProposal []types.ProposalKey
 		expectProposalRes     types.ProposalResult
@@ -76,7 +75,6 @@ func TestDecideProposal(t *testing.T) {
 			voter:                 user1,
 			proposalID:            id1,
 			voterRes:              true,
-			votingPower:           c1,
 			expectOngoingProposal: []types.ProposalKey{id1, id2},
 			expectDecidedProposal: nil,
 			expectProposalRes:     types.ProposalNotPass,
@@ -90,7 +88,6 @@ func TestDecideProposal(t *testing.T) {
 			voter:                 user2,
 			proposalID:            id1,
 			voterRes:              false,
-			votingPower:           c2,
 			expectOngoingProposal: []types.ProposalKey{id1, id2},
 			expectDecidedProposal: nil,
 			expectProposalRes:     types.ProposalNotPass,
@@ -117,7 +114,6 @@ func TestDecideProposal(t *testing.T) {
 			voter:                 user1,
 			proposalID:            id2,
 			voterRes:              true,
-			votingPower:           c1,
 			expectOngoingProposal: []types.ProposalKey{id2},
 			expectDecidedProposal: []types.ProposalKey{id1},
 			expectProposalRes:     types.ProposalNotPass,
@@ -131,7 +127,6 @@ func TestDecideProposal(t *testing.T) {
 			voter:                 user2,
 			proposalID:            id2,
 			voterRes:              true,
-			votingPower:           c2,
 			expectOngoingProposal: []types.ProposalKey{id2},
 			expectDecidedProposal: []types.ProposalKey{id1},
 			expectProposalRes:     types.ProposalNotPass,
@@ -145,7 +140,6 @@ func TestDecideProposal(t *testing.T) {
 			voter:                 user4,
 			proposalID:            id2,
 			voterRes:              true,
-			votingPower:           c4,
 			expectOngoingProposal: []types.ProposalKey{id2},
 			expectDecidedProposal: []types.ProposalKey{id1},
 			expectProposalRes:     types.ProposalNotPass,
@@ -159,7 +153,6 @@ func TestDecideProposal(t *testing.T) {
 			voter:                 user3,
 			proposalID:            id2,
 			voterRes:              false,
-			votingPower:           c3,
 			expectOngoingProposal: []types.ProposalKey{id2},
 			expectDecidedProposal: []types.ProposalKey{id1},
 			expectProposalRes:     types.ProposalNotPass,
@@ -194,9 +187,6 @@ func TestDecideProposal(t *testing.T) {
 
 		} else {
 			voteManager.AddVote(ctx, cs.proposalID, cs.voter, cs.voterRes)
-
-			err := pm.UpdateProposalVotingStatus(ctx, cs.proposalID, cs.voter, cs.voterRes, cs.votingPower)
-			assert.Nil(t, err)
 		}
 
 		ongoingList, _ := pm.storage.GetOngoingProposalList(ctx)
@@ -218,3 +208,244 @@ func TestDecideProposal(t *testing.T) {
 		assert.Equal(t, expectExpiredProposalList, expiredList)
 	}
 }
+
+func TestDecideCommunityPoolSpend(t *testing.T) {
+	ctx, am, pm, postManager, voteManager, valManager, gm := setupTest(t, 0)
+	voteManager.InitGenesis(ctx)
+	valManager.InitGenesis(ctx)
+	pm.InitGenesis(ctx)
+	proposalParam, _ := pm.paramHolder.GetProposalParam(ctx)
+
+	c1 := proposalParam.ChangeParamPassVotes.Plus(types.NewCoinFromInt64(20))
+	user1 := createTestAccount(ctx, am, "user1", c1)
+	recipient := createTestAccount(ctx, am, "recipient", types.NewCoinFromInt64(0))
+	voteManager.AddVoter(ctx, user1, c1)
+
+	spend := types.NewCoinFromInt64(100 * types.Decimals)
+	err := gm.AddToCommunityPool(ctx, spend.Plus(spend))
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName          string
+		amount            types.Coin
+		voteResult        bool
+		expectProposalRes types.ProposalResult
+		expectRecipient   types.Coin
+		expectPool        types.Coin
+	}{
+		{
+			testName:          "rejected spend doesn't transfer",
+			amount:            spend,
+			voteResult:        false,
+			expectProposalRes: types.ProposalNotPass,
+			expectRecipient:   types.NewCoinFromInt64(0),
+			expectPool:        spend.Plus(spend),
+		},
+		{
+			testName:          "passed spend transfers from pool",
+			amount:            spend,
+			voteResult:        true,
+			expectProposalRes: types.ProposalPass,
+			expectRecipient:   spend,
+			expectPool:        spend,
+		},
+		{
+			testName:          "passed spend exceeding pool has no effect",
+			amount:            spend.Plus(spend),
+			voteResult:        true,
+			expectProposalRes: types.ProposalPass,
+			expectRecipient:   spend,
+			expectPool:        spend,
+		},
+	}
+
+	for _, tc := range testCases {
+		p := pm.CreateCommunityPoolSpendProposal(ctx, recipient, tc.amount, "")
+		id, err := pm.AddProposal(ctx, user1, p, 10)
+		assert.Nil(t, err)
+		voteManager.AddVote(ctx, id, user1, tc.voteResult)
+
+		event := DecideProposalEvent{
+			ProposalType: types.CommunityPoolSpend,
+			ProposalID:   id,
+		}
+		err = event.Execute(ctx, voteManager, valManager, am, pm, postManager, gm)
+		if err != nil {
+			t.Errorf("%s: failed to execute event, got err %v", tc.testName, err)
+		}
+
+		proposal, _ := pm.storage.GetExpiredProposal(ctx, id)
+		if proposal.GetProposalInfo().Result != tc.expectProposalRes {
+			t.Errorf("%s: diff proposal result, got %v, want %v",
+				tc.testName, proposal.GetProposalInfo().Result, tc.expectProposalRes)
+		}
+		saving, _ := am.GetSavingFromBank(ctx, recipient)
+		if !saving.IsEqual(tc.expectRecipient) {
+			t.Errorf("%s: diff recipient saving, got %v, want %v", tc.testName, saving, tc.expectRecipient)
+		}
+		pool, _ := gm.GetCommunityPool(ctx)
+		if !pool.IsEqual(tc.expectPool) {
+			t.Errorf("%s: diff community pool, got %v, want %v", tc.testName, pool, tc.expectPool)
+		}
+	}
+}
+
+func TestSettleDeposit(t *testing.T) {
+	ctx, am, pm, _, _, _, gm := setupTest(t, 0)
+	pm.InitGenesis(ctx)
+	proposalParam, _ := pm.paramHolder.GetProposalParam(ctx)
+
+	user1 := createTestAccount(ctx, am, "user1", types.NewCoinFromInt64(0))
+	deposit := types.NewCoinFromInt64(100 * types.Decimals)
+	totalLinoStake := types.NewCoinFromInt64(1000000 * types.Decimals)
+	quorum, _ := pm.GetProposalQuorum(ctx, types.TextProposal, totalLinoStake)
+	zero := types.NewCoinFromInt64(0)
+
+	testCases := []struct {
+		testName            string
+		agreeVotes          types.Coin
+		forfeitToValidator  bool
+		expectReturned      bool
+		expectValidatorPool types.Coin
+	}{
+		{
+			testName:            "deposit is returned if enough voted",
+			agreeVotes:          quorum,
+			forfeitToValidator:  false,
+			expectReturned:      true,
+			expectValidatorPool: zero,
+		},
+		{
+			testName:            "deposit is burned if too few voted",
+			agreeVotes:          zero,
+			forfeitToValidator:  false,
+			expectReturned:      false,
+			expectValidatorPool: zero,
+		},
+		{
+			testName:            "deposit goes to validator inflation pool if too few voted",
+			agreeVotes:          zero,
+			forfeitToValidator:  true,
+			expectReturned:      false,
+			expectValidatorPool: deposit,
+		},
+	}
+
+	for _, tc := range testCases {
+		proposalParam.DepositForfeitToValidator = tc.forfeitToValidator
+		err := param.ChangeParamEvent{Param: *proposalParam}.Execute(ctx, pm.paramHolder)
+		assert.Nil(t, err)
+
+		p := pm.CreateTextProposal(ctx, "")
+		id, err := pm.AddProposal(ctx, user1, p, 10)
+		assert.Nil(t, err)
+		_, err = pm.AddDeposit(ctx, id, user1, deposit)
+		assert.Nil(t, err)
+		err = pm.SetProposalTally(ctx, id, tc.agreeVotes, zero, zero, zero)
+		assert.Nil(t, err)
+		_, err = pm.UpdateProposalStatus(ctx, types.TextProposal, id, totalLinoStake)
+		assert.Nil(t, err)
+
+		event := DecideProposalEvent{
+			ProposalType: types.TextProposal,
+			ProposalID:   id,
+		}
+		pendingReturns, _ := am.GetPendingReturns(ctx, user1)
+		returnedBefore := len(pendingReturns)
+		err = event.SettleDeposit(ctx, id, totalLinoStake, am, pm, gm)
+		if err != nil {
+			t.Errorf("%s: failed to settle deposit, got err %v", tc.testName, err)
+		}
+
+		// returned deposit is paid by coin return event
+		saving, _ := am.GetSavingFromBank(ctx, user1)
+		if !saving.IsZero() {
+			t.Errorf("%s: diff saving, got %v, want 0", tc.testName, saving)
+		}
+		pendingReturns, _ = am.GetPendingReturns(ctx, user1)
+		if (len(pendingReturns) > returnedBefore) != tc.expectReturned {
+			t.Errorf("%s: diff deposit return, got %v pending returns, want returned %v",
+				tc.testName, len(pendingReturns), tc.expectReturned)
+		}
+		pool, _ := gm.GetValidatorHourlyInflation(ctx)
+		if !pool.IsEqual(tc.expectValidatorPool) {
+			t.Errorf("%s: diff validator inflation pool, got %v, want %v", tc.testName, pool, tc.expectValidatorPool)
+		}
+		if record, _ := pm.GetDeposit(ctx, id); record != nil {
+			t.Errorf("%s: deposit record is not deleted", tc.testName)
+		}
+	}
+}
+
+func TestDecideModerationProposalWithoutVotes(t *testing.T) {
+	ctx, am, pm, postManager, voteManager, valManager, gm := setupTest(t, 0)
+	voteManager.InitGenesis(ctx)
+	valManager.InitGenesis(ctx)
+	pm.InitGenesis(ctx)
+	postParam, _ := pm.paramHolder.GetPostParam(ctx)
+
+	testCases := []struct {
+		testName        string
+		author          string
+		proposalType    types.ProposalType
+		appeal          bool
+		expectPostState types.ModerationState
+	}{
+		{
+			testName:        "censorship without enough votes un-hides post",
+			author:          "user1",
+			proposalType:    types.ContentCensorship,
+			appeal:          false,
+			expectPostState: types.PostVisible,
+		},
+		{
+			testName:        "appeal of hidden post without enough votes is rejected",
+			author:          "user2",
+			proposalType:    types.ContentAppeal,
+			appeal:          true,
+			expectPostState: types.PostHidden,
+		},
+	}
+	for _, tc := range testCases {
+		user, postID := createTestPost(
+			t, ctx, tc.author, "post", types.NewCoinFromInt64(0), am, postManager, "0")
+		permlink := types.GetPermlink(user, postID)
+		err := postManager.ReportPost(ctx, permlink, "reporter", postParam.HideReportCoinDay)
+		assert.Nil(t, err)
+
+		var p model.Proposal
+		if tc.appeal {
+			err = postManager.AppealPost(ctx, permlink)
+			assert.Nil(t, err)
+			p = pm.CreateContentAppealProposal(ctx, permlink, "")
+		} else {
+			p = pm.CreateContentCensorshipProposal(ctx, permlink, "")
+		}
+		id, err := pm.AddProposal(ctx, user, p, 10)
+		assert.Nil(t, err)
+
+		event := DecideProposalEvent{
+			ProposalType: tc.proposalType,
+			ProposalID:   id,
+		}
+		err = event.Execute(ctx, voteManager, valManager, am, pm, postManager, gm)
+		if err != nil {
+			t.Errorf("%s: failed to execute event, got err %v", tc.testName, err)
+		}
+
+		assert.False(t, pm.IsOngoingProposal(ctx, id))
+		proposal, err := pm.storage.GetExpiredProposal(ctx, id)
+		if err != nil {
+			t.Errorf("%s: failed to get expired proposal, got err %v", tc.testName, err)
+			continue
+		}
+		if proposal.GetProposalInfo().Result != types.ProposalNotPass {
+			t.Errorf("%s: diff proposal result, got %v, want %v",
+				tc.testName, proposal.GetProposalInfo().Result, types.ProposalNotPass)
+		}
+		state, _ := postManager.GetModerationState(ctx, permlink)
+		if state != tc.expectPostState {
+			t.Errorf("%s: diff post state, got %v, want %v", tc.testName, state, tc.expectPostState)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/global"
 	"github.com/lino-network/lino/x/post"
+	"github.com/lino-network/lino/x/proposal/model"
 	"github.com/lino-network/lino/x/vote"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,10 +24,20 @@ func NewHandler(
 			return handleChangeParamMsg(ctx, am, proposalManager, gm, msg)
 		case ContentCensorshipMsg:
 			return handleContentCensorshipMsg(ctx, am, proposalManager, postManager, gm, msg)
+		case ContentAppealMsg:
+			return handleContentAppealMsg(ctx, am, proposalManager, postManager, gm, msg)
 		case ProtocolUpgradeMsg:
 			return handleProtocolUpgradeMsg(ctx, am, proposalManager, gm, msg)
+		case TextProposalMsg:
+			return handleTextProposalMsg(ctx, am, proposalManager, gm, msg)
+		case CommunityPoolSpendMsg:
+			return handleCommunityPoolSpendMsg(ctx, am, proposalManager, gm, msg)
 		case VoteProposalMsg:
 			return handleVoteProposalMsg(ctx, proposalManager, vm, msg)
+		case WithdrawProposalVoteMsg:
+			return handleWithdrawProposalVoteMsg(ctx, proposalManager, vm, msg)
+		case DepositProposalMsg:
+			return handleDepositProposalMsg(ctx, am, proposalManager, gm, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized proposal Msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -41,33 +52,8 @@ func handleChangeParamMsg(
 		return ErrAccountNotFound().Result()
 	}
 
-	param, err := pm.paramHolder.GetProposalParam(ctx)
-	if err != nil {
-		return err.Result()
-	}
-
 	proposal := pm.CreateChangeParamProposal(ctx, msg.GetParameter(), msg.GetReason())
-	proposalID, err := pm.AddProposal(ctx, msg.GetCreator(), proposal, param.ChangeParamDecideSec)
-	if err != nil {
-		return err.Result()
-	}
-	//  set a time event to decide the proposal
-	event := pm.CreateDecideProposalEvent(ctx, types.ChangeParam, proposalID)
-
-	if err := gm.RegisterProposalDecideEvent(ctx, param.ChangeParamDecideSec, event); err != nil {
-		return err.Result()
-	}
-
-	// minus coin from account and return when deciding the proposal
-	if err = am.MinusSavingCoin(
-		ctx, msg.GetCreator(), param.ChangeParamMinDeposit, "",
-		string(proposalID), types.ProposalDeposit); err != nil {
-		return err.Result()
-	}
-
-	if err := returnCoinTo(
-		ctx, msg.GetCreator(), gm, am, int64(1),
-		param.ChangeParamDecideSec, param.ChangeParamMinDeposit); err != nil {
+	if err := submitProposal(ctx, am, pm, gm, msg.GetCreator(), types.ChangeParam, proposal); err != nil {
 		return err.Result()
 	}
 	return sdk.Result{}
@@ -80,33 +66,62 @@ func handleProtocolUpgradeMsg(
 		return ErrAccountNotFound().Result()
 	}
 
+	// upgrade height can't be passed before the proposal is decided, otherwise
+	// the passed proposal can't be scheduled. Proposal without full initial
+	// deposit may wait for deposit period before voting starts
 	param, err := pm.paramHolder.GetProposalParam(ctx)
 	if err != nil {
 		return err.Result()
 	}
+	decideSec := param.ProtocolUpgradeDecideSec
+	if param.InitialDepositRatio.Rat == nil || param.InitialDepositRatio.LT(sdk.OneRat()) {
+		decideSec += param.DepositPeriodSec
+	}
+	minHeight := ctx.BlockHeader().Height + decideSec/types.MinBlockIntervalSec
+	if msg.GetHeight() <= minHeight {
+		return ErrUpgradeHeightTooLow(msg.GetHeight(), minHeight).Result()
+	}
 
-	proposal := pm.CreateProtocolUpgradeProposal(ctx, msg.GetLink(), msg.GetReason())
-	proposalID, err := pm.AddProposal(ctx, msg.GetCreator(), proposal, param.ProtocolUpgradeDecideSec)
-	if err != nil {
+	proposal := pm.CreateProtocolUpgradeProposal(
+		ctx, msg.GetName(), msg.GetHeight(), msg.GetLink(), msg.GetReason())
+	if err := submitProposal(ctx, am, pm, gm, msg.GetCreator(), types.ProtocolUpgrade, proposal); err != nil {
 		return err.Result()
 	}
-	//  set a time event to decide the proposal
-	event := pm.CreateDecideProposalEvent(ctx, types.ProtocolUpgrade, proposalID)
+	return sdk.Result{}
+}
 
-	if err := gm.RegisterProposalDecideEvent(ctx, param.ProtocolUpgradeDecideSec, event); err != nil {
+// text proposal shares decide period, deposit and pass condition with protocol upgrade
+func handleTextProposalMsg(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, gm global.GlobalManager,
+	msg TextProposalMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.GetCreator()) {
+		return ErrAccountNotFound().Result()
+	}
+
+	proposal := pm.CreateTextProposal(ctx, msg.GetReason())
+	if err := submitProposal(ctx, am, pm, gm, msg.GetCreator(), types.TextProposal, proposal); err != nil {
 		return err.Result()
 	}
+	return sdk.Result{}
+}
 
-	// minus coin from account and return when deciding the proposal
-	if err = am.MinusSavingCoin(
-		ctx, msg.GetCreator(), param.ProtocolUpgradeMinDeposit,
-		"", string(proposalID), types.ProposalDeposit); err != nil {
+// community pool spend shares decide period, deposit and pass condition with change param
+func handleCommunityPoolSpendMsg(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, gm global.GlobalManager,
+	msg CommunityPoolSpendMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.GetCreator()) {
+		return ErrAccountNotFound().Result()
+	}
+	if !am.DoesAccountExist(ctx, msg.GetRecipient()) {
+		return ErrRecipientNotFound(msg.GetRecipient()).Result()
+	}
+	amount, err := types.LinoToCoin(msg.GetAmount())
+	if err != nil {
 		return err.Result()
 	}
 
-	if err := returnCoinTo(
-		ctx, msg.GetCreator(), gm, am, int64(1),
-		param.ProtocolUpgradeDecideSec, param.ProtocolUpgradeMinDeposit); err != nil {
+	proposal := pm.CreateCommunityPoolSpendProposal(ctx, msg.GetRecipient(), amount, msg.GetReason())
+	if err := submitProposal(ctx, am, pm, gm, msg.GetCreator(), types.CommunityPoolSpend, proposal); err != nil {
 		return err.Result()
 	}
 	return sdk.Result{}
@@ -127,36 +142,49 @@ func handleContentCensorshipMsg(
 		return ErrCensorshipPostIsDeleted(msg.GetPermlink()).Result()
 	}
 
-	param, err := proposalManager.paramHolder.GetProposalParam(ctx)
-	if err != nil {
-		return err.Result()
+	if isCensored, err := postManager.IsCensored(ctx, msg.GetPermlink()); isCensored || err != nil {
+		return ErrCensorshipPostIsCensored(msg.GetPermlink()).Result()
 	}
 
 	proposal :=
 		proposalManager.CreateContentCensorshipProposal(
 			ctx, msg.GetPermlink(), msg.GetReason())
-	proposalID, err :=
-		proposalManager.AddProposal(
-			ctx, msg.GetCreator(), proposal, param.ContentCensorshipDecideSec)
-	if err != nil {
+	if err := submitProposal(
+		ctx, am, proposalManager, gm, msg.GetCreator(), types.ContentCensorship, proposal); err != nil {
 		return err.Result()
 	}
-	//  set a time event to decide the proposal
-	event := proposalManager.CreateDecideProposalEvent(ctx, types.ContentCensorship, proposalID)
-	// minus coin from account and return when deciding the proposal
-	if err = am.MinusSavingCoin(
-		ctx, msg.GetCreator(), param.ContentCensorshipMinDeposit,
-		"", string(proposalID), types.ProposalDeposit); err != nil {
+	return sdk.Result{}
+}
+
+func handleContentAppealMsg(
+	ctx sdk.Context, am acc.AccountManager, proposalManager ProposalManager,
+	postManager post.PostManager, gm global.GlobalManager, msg ContentAppealMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.GetCreator()) {
+		return ErrAccountNotFound().Result()
+	}
+
+	if !postManager.DoesPostExist(ctx, msg.GetPermlink()) {
+		return ErrPostNotFound().Result()
+	}
+
+	author, err := postManager.GetAuthor(ctx, msg.GetPermlink())
+	if err != nil {
 		return err.Result()
 	}
+	if author != msg.GetCreator() {
+		return ErrNotPostAuthor(msg.GetPermlink()).Result()
+	}
 
-	if err := gm.RegisterProposalDecideEvent(ctx, param.ContentCensorshipDecideSec, event); err != nil {
+	// post is under appeal until the proposal is decided
+	if err := postManager.AppealPost(ctx, msg.GetPermlink()); err != nil {
 		return err.Result()
 	}
 
-	if err := returnCoinTo(
-		ctx, msg.GetCreator(), gm, am, int64(1),
-		param.ContentCensorshipDecideSec, param.ContentCensorshipMinDeposit); err != nil {
+	proposal :=
+		proposalManager.CreateContentAppealProposal(
+			ctx, msg.GetPermlink(), msg.GetReason())
+	if err := submitProposal(
+		ctx, am, proposalManager, gm, msg.GetCreator(), types.ContentAppeal, proposal); err != nil {
 		return err.Result()
 	}
 	return sdk.Result{}
@@ -171,38 +199,137 @@ func handleVoteProposalMsg(ctx sdk.Context, proposalManager ProposalManager, vm
 		return ErrNotOngoingProposal().Result()
 	}
 
-	if err := vm.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Result); err != nil {
+	// voting again before the proposal is decided replaces the previous vote
+	var err sdk.Error
+	switch {
+	case msg.Abstain:
+		err = vm.AddAbstainVote(ctx, msg.ProposalID, msg.Voter)
+	case msg.Veto:
+		err = vm.AddVetoVote(ctx, msg.ProposalID, msg.Voter)
+	default:
+		err = vm.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Result)
+	}
+	if err != nil {
+		return err.Result()
+	}
+	if err := updateProposalTally(ctx, proposalManager, vm, msg.ProposalID); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleWithdrawProposalVoteMsg(
+	ctx sdk.Context, proposalManager ProposalManager, vm vote.VoteManager, msg WithdrawProposalVoteMsg) sdk.Result {
+	if !proposalManager.IsOngoingProposal(ctx, msg.ProposalID) {
+		return ErrNotOngoingProposal().Result()
+	}
+
+	if err := vm.DeleteVote(ctx, msg.ProposalID, msg.Voter); err != nil {
 		return err.Result()
 	}
+	if err := updateProposalTally(ctx, proposalManager, vm, msg.ProposalID); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
 
-	v, err := vm.GetVote(ctx, msg.ProposalID, msg.Voter)
+// updateProposalTally - tally votes with current voting power and store it in the proposal,
+// tally is refreshed on every vote change and recalculated when the proposal is decided
+func updateProposalTally(
+	ctx sdk.Context, proposalManager ProposalManager, vm vote.VoteManager,
+	proposalID types.ProposalKey) sdk.Error {
+	agreeVotes, disagreeVotes, abstainVotes, vetoVotes, err := vm.GetProposalTally(ctx, proposalID)
 	if err != nil {
-		return err.Result()
+		return err
+	}
+	return proposalManager.SetProposalTally(
+		ctx, proposalID, agreeVotes, disagreeVotes, abstainVotes, vetoVotes)
+}
+
+func handleDepositProposalMsg(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, gm global.GlobalManager,
+	msg DepositProposalMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Depositor) {
+		return ErrAccountNotFound().Result()
 	}
 
-	err = proposalManager.UpdateProposalVotingStatus(ctx, msg.ProposalID, msg.Voter, v.Result, v.VotingPower)
+	if !pm.IsPendingProposal(ctx, msg.ProposalID) {
+		return ErrNotPendingProposal().Result()
+	}
+
+	amount, err := types.LinoToCoin(msg.Amount)
 	if err != nil {
 		return err.Result()
 	}
-
+	if err := depositProposal(ctx, am, pm, gm, msg.Depositor, msg.ProposalID, amount); err != nil {
+		return err.Result()
+	}
 	return sdk.Result{}
 }
 
-func returnCoinTo(
-	ctx sdk.Context, name types.AccountKey, gm global.GlobalManager, am acc.AccountManager,
-	times int64, interval int64, coin types.Coin) sdk.Error {
-	if err := am.AddFrozenMoney(
-		ctx, name, coin, ctx.BlockHeader().Time.Unix(), interval, times); err != nil {
+// add proposal to pending list with creator's initial deposit, other accounts
+// can co-deposit till min deposit is collected or the proposal is revoked
+func submitProposal(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, gm global.GlobalManager,
+	creator types.AccountKey, proposalType types.ProposalType, proposal model.Proposal) sdk.Error {
+	param, err := pm.paramHolder.GetProposalParam(ctx)
+	if err != nil {
 		return err
 	}
-
-	events, err := acc.CreateCoinReturnEvents(ctx, name, times, interval, coin, types.ProposalReturnCoin)
+	minDeposit, _, err := pm.GetProposalDepositParam(ctx, proposalType)
 	if err != nil {
 		return err
 	}
+	initialDeposit := types.RatToCoin(minDeposit.ToRat().Mul(param.InitialDepositRatio))
 
-	if err := gm.RegisterCoinReturnEvent(ctx, events, times, interval); err != nil {
+	proposalID, err := pm.AddPendingProposal(ctx, creator, proposal, param.DepositPeriodSec)
+	if err != nil {
 		return err
 	}
+	if err := depositProposal(ctx, am, pm, gm, creator, proposalID, initialDeposit); err != nil {
+		return err
+	}
+
+	//  set a time event to revoke the proposal if min deposit isn't collected
+	if pm.IsPendingProposal(ctx, proposalID) {
+		event := pm.CreateExpireProposalDepositEvent(ctx, proposalType, proposalID)
+		if err := gm.RegisterProposalDecideEvent(ctx, param.DepositPeriodSec, event); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+// minus deposit from depositor's saving, start voting period once total deposit
+// reaches min deposit. Deposit is returned or forfeited when the proposal is decided
+func depositProposal(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, gm global.GlobalManager,
+	depositor types.AccountKey, proposalID types.ProposalKey, amount types.Coin) sdk.Error {
+	if err := am.MinusSavingCoin(
+		ctx, depositor, amount, "", string(proposalID), types.ProposalDeposit); err != nil {
+		return err
+	}
+	totalDeposit, err := pm.AddDeposit(ctx, proposalID, depositor, amount)
+	if err != nil {
+		return err
+	}
+
+	proposalType, err := pm.GetProposalType(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	minDeposit, decideSec, err := pm.GetProposalDepositParam(ctx, proposalType)
+	if err != nil {
+		return err
+	}
+	if !totalDeposit.IsGTE(minDeposit) {
+		return nil
+	}
+
+	if err := pm.StartVotingPeriod(ctx, proposalID, decideSec); err != nil {
+		return err
+	}
+	//  set a time event to decide the proposal
+	event := pm.CreateDecideProposalEvent(ctx, proposalType, proposalID)
+	return gm.RegisterProposalDecideEvent(ctx, decideSec, event)
+}
@@ -8,8 +8,9 @@ import (
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	acc "github.com/lino-network/lino/x/account"
+	"github.com/lino-network/lino/x/post"
 	"github.com/lino-network/lino/x/proposal/model"
-	"github.com/lino-network/lino/x/vote"
+	voteModel "github.com/lino-network/lino/x/vote/model"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -28,6 +29,7 @@ func TestChangeParamProposal(t *testing.T) {
 		GlobalGrowthRate:         sdk.NewRat(98, 1000),
 		DeveloperAllocation:      sdk.ZeroRat(),
 		ValidatorAllocation:      sdk.ZeroRat(),
+		CommunityPoolAllocation:  sdk.ZeroRat(),
 		InfraAllocation:          sdk.ZeroRat(),
 		ContentCreatorAllocation: sdk.NewRat(5, 10),
 	}
@@ -46,6 +48,8 @@ func TestChangeParamProposal(t *testing.T) {
 			ProposalID:    proposalID1,
 			AgreeVotes:    types.NewCoinFromInt64(0),
 			DisagreeVotes: types.NewCoinFromInt64(0),
+			AbstainVotes:  types.NewCoinFromInt64(0),
+			VetoVotes:     types.NewCoinFromInt64(0),
 			Result:        types.ProposalNotPass,
 			CreatedAt:     curTime,
 			ExpiredAt:     curTime + proposalParam.ChangeParamDecideSec,
@@ -146,6 +150,8 @@ func TestContentCensorshipProposal(t *testing.T) {
 			ProposalID:    proposalID1,
 			AgreeVotes:    types.NewCoinFromInt64(0),
 			DisagreeVotes: types.NewCoinFromInt64(0),
+			AbstainVotes:  types.NewCoinFromInt64(0),
+			VetoVotes:     types.NewCoinFromInt64(0),
 			Result:        types.ProposalNotPass,
 			CreatedAt:     curTime,
 			ExpiredAt:     curTime + proposalParam.ChangeParamDecideSec,
@@ -260,69 +266,214 @@ func TestContentCensorshipProposal(t *testing.T) {
 	}
 }
 
-func TestAddFrozenMoney(t *testing.T) {
-	ctx, am, proposalManager, _, _, _, gm := setupTest(t, 0)
+func TestContentAppealProposal(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, gm, vm)
+	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
+
 	proposalManager.InitGenesis(ctx)
 
-	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
-	user := createTestAccount(ctx, am, "user", minBalance)
+	user1, postID1 := createTestPost(t, ctx, "user1", "postID", c4600, am, postManager, "0")
+	user2 := createTestAccount(ctx, am, "user2", c4600)
+	permlink := types.GetPermlink(user1, postID1)
+	appealReason := "reason"
 
 	testCases := []struct {
-		testName               string
-		times                  int64
-		interval               int64
-		returnedCoin           types.Coin
-		expectedFrozenListLen  int
-		expectedFrozenMoney    types.Coin
-		expectedFrozenTimes    int64
-		expectedFrozenInterval int64
+		testName           string
+		creator            types.AccountKey
+		permlink           types.Permlink
+		censorBeforeAppeal bool
+		wantRes            sdk.Result
+		wantCreatorBalance types.Coin
+		wantState          types.ModerationState
 	}{
 		{
-			testName:               "return coin to user",
-			times:                  10,
-			interval:               2,
-			returnedCoin:           types.NewCoinFromInt64(100),
-			expectedFrozenListLen:  1,
-			expectedFrozenMoney:    types.NewCoinFromInt64(100),
-			expectedFrozenTimes:    10,
-			expectedFrozenInterval: 2,
+			testName:           "target post is not exist",
+			creator:            user1,
+			permlink:           types.GetPermlink(user1, "invalid"),
+			wantRes:            ErrPostNotFound().Result(),
+			wantCreatorBalance: c4600,
+			wantState:          types.PostVisible,
+		},
+		{
+			testName:           "user2 can't appeal user1's post",
+			creator:            user2,
+			permlink:           permlink,
+			censorBeforeAppeal: true,
+			wantRes:            ErrNotPostAuthor(permlink).Result(),
+			wantCreatorBalance: c4600,
+			wantState:          types.PostCensored,
+		},
+		{
+			testName:           "user1 appeals censored post successfully",
+			creator:            user1,
+			permlink:           permlink,
+			wantRes:            sdk.Result{},
+			wantCreatorBalance: c4600.Minus(proposalParam.ContentCensorshipMinDeposit),
+			wantState:          types.PostAppealed,
 		},
 		{
-			testName:               "return coin to user multiple times",
-			times:                  100000,
-			interval:               20000,
-			returnedCoin:           types.NewCoinFromInt64(100000),
-			expectedFrozenListLen:  2,
-			expectedFrozenMoney:    types.NewCoinFromInt64(100000),
-			expectedFrozenTimes:    100000,
-			expectedFrozenInterval: 20000,
+			testName:           "post can't be appealed twice",
+			creator:            user1,
+			permlink:           permlink,
+			wantRes:            post.ErrInvalidModerationState(permlink, types.PostAppealed).Result(),
+			wantCreatorBalance: c4600.Minus(proposalParam.ContentCensorshipMinDeposit),
+			wantState:          types.PostAppealed,
 		},
 	}
-
 	for _, tc := range testCases {
-		err := returnCoinTo(
-			ctx, "user", gm, am, tc.times, tc.interval, tc.returnedCoin)
-		if err != nil {
-			t.Errorf("%s: failed to return coin, got err %v", tc.testName, err)
+		if tc.censorBeforeAppeal {
+			err := postManager.CensorPost(ctx, tc.permlink)
+			assert.Nil(t, err)
+		}
+		msg := NewAppealPostContentMsg(string(tc.creator), tc.permlink, appealReason)
+		result := handler(ctx, msg)
+		if !assert.Equal(t, tc.wantRes, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.wantRes)
 		}
 
-		lst, err := am.GetFrozenMoneyList(ctx, user)
-		if err != nil {
-			t.Errorf("%s: failed to get frozen money list, got err %v", tc.testName, err)
+		creatorBalance, _ := am.GetSavingFromBank(ctx, tc.creator)
+		if !creatorBalance.IsEqual(tc.wantCreatorBalance) {
+			t.Errorf("%s: diff bank balance: got %v, want %v",
+				tc.testName, creatorBalance, tc.wantCreatorBalance)
 		}
-		if len(lst) != tc.expectedFrozenListLen {
-			t.Errorf("%s: diff list len, got %v, want %v", tc.testName, len(lst), tc.expectedFrozenListLen)
+
+		state, _ := postManager.GetModerationState(ctx, permlink)
+		if state != tc.wantState {
+			t.Errorf("%s: diff moderation state, got %v, want %v", tc.testName, state, tc.wantState)
 		}
-		if !lst[len(lst)-1].Amount.IsEqual(tc.expectedFrozenMoney) {
-			t.Errorf("%s: diff amount, got %v, want %v", tc.testName, lst[len(lst)-1].Amount, tc.expectedFrozenMoney)
+	}
+
+	// appeal passed, post should be restored
+	proposalID := types.ProposalKey(strconv.FormatInt(int64(1), 10))
+	event := DecideProposalEvent{ProposalType: types.ContentAppeal, ProposalID: proposalID}
+	err := event.ExecuteContentAppeal(ctx, proposalID, proposalManager, postManager)
+	assert.Nil(t, err)
+	state, _ := postManager.GetModerationState(ctx, permlink)
+	assert.Equal(t, types.PostRestored, state)
+}
+
+func TestDepositProposal(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, gm, vm)
+	proposalManager.InitGenesis(ctx)
+	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
+
+	// creator only pays half of min deposit
+	proposalParam.InitialDepositRatio = sdk.NewRat(1, 2)
+	proposalParam.DepositPeriodSec = int64(3 * 24 * 3600)
+	err := param.ChangeParamEvent{Param: *proposalParam}.Execute(ctx, proposalManager.paramHolder)
+	assert.Nil(t, err)
+	halfDeposit := types.RatToCoin(proposalParam.ProtocolUpgradeMinDeposit.ToRat().Mul(sdk.NewRat(1, 2)))
+
+	user1 := createTestAccount(ctx, am, "user1", c460000.Plus(c460000).Plus(c460000))
+	user2 := createTestAccount(ctx, am, "user2", c460000.Plus(c460000))
+	proposalID1 := types.ProposalKey("1")
+	proposalID2 := types.ProposalKey("2")
+	curTime := ctx.BlockHeader().Time.Unix()
+
+	result := handler(ctx, NewSubmitTextMsg(string(user1), "reason"))
+	assert.Equal(t, sdk.Result{}, result)
+	assert.True(t, proposalManager.IsPendingProposal(ctx, proposalID1))
+	assert.False(t, proposalManager.IsOngoingProposal(ctx, proposalID1))
+	saving, _ := am.GetSavingFromBank(ctx, user1)
+	assert.Equal(t, c460000.Plus(c460000).Plus(c460000).Minus(halfDeposit), saving)
+	expireEvent := ExpireProposalDepositEvent{ProposalType: types.TextProposal, ProposalID: proposalID1}
+	assert.Equal(t, &types.TimeEventList{Events: []types.Event{expireEvent}},
+		gm.GetTimeEventListAtTime(ctx, curTime+proposalParam.DepositPeriodSec))
+
+	testCases := []struct {
+		testName            string
+		msg                 DepositProposalMsg
+		wantRes             sdk.Result
+		wantPending         bool
+		wantDepositorSaving types.Coin
+	}{
+		{
+			testName:            "deposit to proposal which doesn't exist",
+			msg:                 NewDepositProposalMsg(string(user2), 100, "1"),
+			wantRes:             ErrNotPendingProposal().Result(),
+			wantPending:         true,
+			wantDepositorSaving: c460000.Plus(c460000),
+		},
+		{
+			testName:            "user2 co-deposits rest of min deposit",
+			msg:                 NewDepositProposalMsg(string(user2), 1, types.LNO("500000")),
+			wantRes:             sdk.Result{},
+			wantPending:         false,
+			wantDepositorSaving: c460000.Plus(c460000).Minus(halfDeposit),
+		},
+		{
+			testName:            "proposal in voting period doesn't accept deposit",
+			msg:                 NewDepositProposalMsg(string(user2), 1, "1"),
+			wantRes:             ErrNotPendingProposal().Result(),
+			wantPending:         false,
+			wantDepositorSaving: c460000.Plus(c460000).Minus(halfDeposit),
+		},
+	}
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.wantRes, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.wantRes)
 		}
-		if lst[len(lst)-1].Times != tc.expectedFrozenTimes {
-			t.Errorf("%s: diff times, got %v, want %v", tc.testName, lst[len(lst)-1].Times, tc.expectedFrozenTimes)
+		if proposalManager.IsPendingProposal(ctx, proposalID1) != tc.wantPending {
+			t.Errorf("%s: diff pending status, want %v", tc.testName, tc.wantPending)
 		}
-		if lst[len(lst)-1].Interval != tc.expectedFrozenInterval {
-			t.Errorf("%s: diff interval, got %v, want %v", tc.testName, lst[len(lst)-1].Interval, tc.expectedFrozenInterval)
+		saving, _ := am.GetSavingFromBank(ctx, tc.msg.Depositor)
+		if !saving.IsEqual(tc.wantDepositorSaving) {
+			t.Errorf("%s: diff depositor saving, got %v, want %v", tc.testName, saving, tc.wantDepositorSaving)
 		}
 	}
+
+	// voting period starts after min deposit is collected
+	assert.True(t, proposalManager.IsOngoingProposal(ctx, proposalID1))
+	decideEvent := DecideProposalEvent{ProposalType: types.TextProposal, ProposalID: proposalID1}
+	assert.Equal(t, &types.TimeEventList{Events: []types.Event{decideEvent}},
+		gm.GetTimeEventListAtTime(ctx, curTime+proposalParam.ProtocolUpgradeDecideSec))
+	deposit, err := proposalManager.GetDeposit(ctx, proposalID1)
+	assert.Nil(t, err)
+	assert.Equal(t, &model.ProposalDeposit{
+		Deposits: []model.Deposit{
+			{Depositor: user1, Amount: halfDeposit},
+			{Depositor: user2, Amount: halfDeposit},
+		},
+		Total: halfDeposit.Plus(halfDeposit),
+	}, deposit)
+
+	// expire event has no effect on proposal in voting period
+	err = expireEvent.Execute(ctx, am, proposalManager, postManager, gm)
+	assert.Nil(t, err)
+	assert.True(t, proposalManager.IsOngoingProposal(ctx, proposalID1))
+
+	// proposal which doesn't collect min deposit is revoked and deposit is returned
+	result = handler(ctx, NewSubmitTextMsg(string(user1), "reason"))
+	assert.Equal(t, sdk.Result{}, result)
+	assert.True(t, proposalManager.IsPendingProposal(ctx, proposalID2))
+	expireEvent = ExpireProposalDepositEvent{ProposalType: types.TextProposal, ProposalID: proposalID2}
+	err = expireEvent.Execute(ctx, am, proposalManager, postManager, gm)
+	assert.Nil(t, err)
+	assert.False(t, proposalManager.IsPendingProposal(ctx, proposalID2))
+	proposal, err := proposalManager.storage.GetExpiredProposal(ctx, proposalID2)
+	assert.Nil(t, err)
+	assert.Equal(t, types.ProposalRevoked, proposal.GetProposalInfo().Result)
+
+	// deposit is returned by coin return event at next block
+	saving, _ = am.GetSavingFromBank(ctx, user1)
+	assert.Equal(t, c460000.Plus(c460000).Plus(c460000).Minus(halfDeposit).Minus(halfDeposit), saving)
+	pendingReturns, err := am.GetPendingReturns(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(pendingReturns))
+	assert.Equal(t, types.ProposalReturnCoin, pendingReturns[0].ReturnType)
+	assert.True(t, halfDeposit.IsEqual(pendingReturns[0].Remaining))
+	returnEvents := gm.GetTimeEventListAtTime(ctx, curTime)
+	assert.Equal(t, 1, len(returnEvents.Events))
+	err = returnEvents.Events[0].(acc.ReturnCoinEvent).Execute(ctx, am)
+	assert.Nil(t, err)
+	saving, _ = am.GetSavingFromBank(ctx, user1)
+	assert.Equal(t, c460000.Plus(c460000).Plus(c460000).Minus(halfDeposit), saving)
+	deposit, err = proposalManager.GetDeposit(ctx, proposalID2)
+	assert.Nil(t, err)
+	assert.Nil(t, deposit)
 }
 
 func TestVoteProposalBasic(t *testing.T) {
@@ -370,6 +521,8 @@ func TestVoteProposalBasic(t *testing.T) {
 					ProposalID:    proposalID1,
 					AgreeVotes:    types.NewCoinFromInt64(0),
 					DisagreeVotes: types.NewCoinFromInt64(0),
+					AbstainVotes:  types.NewCoinFromInt64(0),
+					VetoVotes:     types.NewCoinFromInt64(0),
 					Result:        types.ProposalNotPass,
 					CreatedAt:     curTime,
 					ExpiredAt:     curTime + decideSec,
@@ -390,6 +543,8 @@ func TestVoteProposalBasic(t *testing.T) {
 					ProposalID:    proposalID1,
 					AgreeVotes:    types.NewCoinFromInt64(0),
 					DisagreeVotes: types.NewCoinFromInt64(0),
+					AbstainVotes:  types.NewCoinFromInt64(0),
+					VetoVotes:     types.NewCoinFromInt64(0),
 					Result:        types.ProposalNotPass,
 					CreatedAt:     curTime,
 					ExpiredAt:     curTime + decideSec,
@@ -412,6 +567,8 @@ func TestVoteProposalBasic(t *testing.T) {
 					ProposalID:    proposalID1,
 					AgreeVotes:    c4600,
 					DisagreeVotes: types.NewCoinFromInt64(0),
+					AbstainVotes:  types.NewCoinFromInt64(0),
+					VetoVotes:     types.NewCoinFromInt64(0),
 					Result:        types.ProposalNotPass,
 					CreatedAt:     curTime,
 					ExpiredAt:     curTime + decideSec,
@@ -420,20 +577,22 @@ func TestVoteProposalBasic(t *testing.T) {
 				Reason:   censorshipReason},
 		},
 		{
-			testName: "user can't double-vote",
+			testName: "user can change vote before deadline",
 			msg: VoteProposalMsg{
 				Voter:      user1,
 				ProposalID: proposalID1,
 				Result:     false,
 			},
-			wantRes: vote.ErrVoteAlreadyExist().Result(),
+			wantRes: sdk.Result{},
 			wantOK:  true,
 			wantProposal: &model.ContentCensorshipProposal{
 				ProposalInfo: model.ProposalInfo{
 					Creator:       user1,
 					ProposalID:    proposalID1,
-					AgreeVotes:    c4600,
-					DisagreeVotes: types.NewCoinFromInt64(0),
+					AgreeVotes:    types.NewCoinFromInt64(0),
+					DisagreeVotes: c4600,
+					AbstainVotes:  types.NewCoinFromInt64(0),
+					VetoVotes:     types.NewCoinFromInt64(0),
 					Result:        types.ProposalNotPass,
 					CreatedAt:     curTime,
 					ExpiredAt:     curTime + decideSec,
@@ -460,3 +619,238 @@ func TestVoteProposalBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestWithdrawProposalVote(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, gm, vm)
+	proposalManager.InitGenesis(ctx)
+
+	user1 := types.AccountKey("user1")
+	createTestAccount(ctx, am, "user1", c4600)
+	_ = vm.AddVoter(ctx, user1, c4600)
+
+	proposal1 := &model.ContentCensorshipProposal{
+		Permlink: types.Permlink("postlink"),
+		Reason:   "reason",
+	}
+	proposalID1, _ := proposalManager.AddProposal(ctx, user1, proposal1, 100)
+
+	testCases := []struct {
+		testName      string
+		msg           sdk.Msg
+		wantRes       sdk.Result
+		wantVoteExist bool
+		wantAbstain   bool
+		wantAbstained types.Coin
+	}{
+		{
+			testName:      "abstain from proposal",
+			msg:           NewAbstainVoteProposalMsg("user1", 1),
+			wantRes:       sdk.Result{},
+			wantVoteExist: true,
+			wantAbstain:   true,
+			wantAbstained: c4600,
+		},
+		{
+			testName:      "withdraw vote",
+			msg:           NewWithdrawProposalVoteMsg("user1", 1),
+			wantRes:       sdk.Result{},
+			wantVoteExist: false,
+			wantAbstained: types.NewCoinFromInt64(0),
+		},
+		{
+			testName:      "withdraw vote twice",
+			msg:           NewWithdrawProposalVoteMsg("user1", 1),
+			wantRes:       voteModel.ErrVoteNotFound().Result(),
+			wantVoteExist: false,
+			wantAbstained: types.NewCoinFromInt64(0),
+		},
+		{
+			testName:      "withdraw vote from non-exist proposal",
+			msg:           NewWithdrawProposalVoteMsg("user1", 100),
+			wantRes:       ErrNotOngoingProposal().Result(),
+			wantVoteExist: false,
+			wantAbstained: types.NewCoinFromInt64(0),
+		},
+	}
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.wantRes, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.wantRes)
+		}
+		proposal, err := proposalManager.storage.GetOngoingProposal(ctx, proposalID1)
+		if err != nil {
+			t.Errorf("%s: failed to get proposal, got err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, tc.wantAbstained, proposal.GetProposalInfo().AbstainVotes) {
+			t.Errorf("%s: diff abstain votes, got %v, want %v",
+				tc.testName, proposal.GetProposalInfo().AbstainVotes, tc.wantAbstained)
+		}
+		if vm.DoesVoteExist(ctx, proposalID1, user1) != tc.wantVoteExist {
+			t.Errorf("%s: diff vote exist, want %v", tc.testName, tc.wantVoteExist)
+		}
+		if !tc.wantVoteExist {
+			continue
+		}
+		v, err := vm.GetVote(ctx, proposalID1, user1)
+		if err != nil {
+			t.Errorf("%s: failed to get vote, got err %v", tc.testName, err)
+		}
+		if v.Abstain != tc.wantAbstain {
+			t.Errorf("%s: diff abstain, got %v, want %v", tc.testName, v.Abstain, tc.wantAbstain)
+		}
+	}
+}
+
+func TestCommunityPoolSpendProposal(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, gm, vm)
+	proposalManager.InitGenesis(ctx)
+
+	user1 := createTestAccount(ctx, am, "user1", c460000)
+	user2 := createTestAccount(ctx, am, "user2", c4600)
+
+	curTime := ctx.BlockHeader().Time.Unix()
+	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
+	proposalID1 := types.ProposalKey(strconv.FormatInt(int64(1), 10))
+	proposalID2 := types.ProposalKey(strconv.FormatInt(int64(2), 10))
+
+	proposal1 := &model.CommunityPoolSpendProposal{
+		ProposalInfo: model.ProposalInfo{
+			Creator:       user1,
+			ProposalID:    proposalID1,
+			AgreeVotes:    types.NewCoinFromInt64(0),
+			DisagreeVotes: types.NewCoinFromInt64(0),
+			AbstainVotes:  types.NewCoinFromInt64(0),
+			VetoVotes:     types.NewCoinFromInt64(0),
+			Result:        types.ProposalNotPass,
+			CreatedAt:     curTime,
+			ExpiredAt:     curTime + proposalParam.ChangeParamDecideSec,
+		},
+		Recipient: user2,
+		Amount:    c46,
+		Reason:    "reason",
+	}
+	proposal2 := &model.TextProposal{
+		ProposalInfo: model.ProposalInfo{
+			Creator:       user1,
+			ProposalID:    proposalID2,
+			AgreeVotes:    types.NewCoinFromInt64(0),
+			DisagreeVotes: types.NewCoinFromInt64(0),
+			AbstainVotes:  types.NewCoinFromInt64(0),
+			VetoVotes:     types.NewCoinFromInt64(0),
+			Result:        types.ProposalNotPass,
+			CreatedAt:     curTime,
+			ExpiredAt:     curTime + proposalParam.ProtocolUpgradeDecideSec,
+		},
+		Reason: "signal",
+	}
+
+	testCases := []struct {
+		testName           string
+		msg                sdk.Msg
+		proposalID         types.ProposalKey
+		wantRes            sdk.Result
+		wantCreatorBalance types.Coin
+		wantProposal       model.Proposal
+	}{
+		{
+			testName:           "recipient doesn't exist",
+			msg:                NewSpendCommunityPoolMsg("user1", "user3", "46", "reason"),
+			proposalID:         proposalID1,
+			wantRes:            ErrRecipientNotFound("user3").Result(),
+			wantCreatorBalance: c460000,
+			wantProposal:       nil,
+		},
+		{
+			testName:           "user1 creates community pool spend proposal",
+			msg:                NewSpendCommunityPoolMsg("user1", "user2", "46", "reason"),
+			proposalID:         proposalID1,
+			wantRes:            sdk.Result{},
+			wantCreatorBalance: c460000.Minus(proposalParam.ChangeParamMinDeposit),
+			wantProposal:       proposal1,
+		},
+		{
+			testName:   "user1 creates text proposal",
+			msg:        NewSubmitTextMsg("user1", "signal"),
+			proposalID: proposalID2,
+			wantRes:    sdk.Result{},
+			wantCreatorBalance: c460000.Minus(proposalParam.ChangeParamMinDeposit).
+				Minus(proposalParam.ProtocolUpgradeMinDeposit),
+			wantProposal: proposal2,
+		},
+	}
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.wantRes, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.wantRes)
+		}
+
+		creatorBalance, _ := am.GetSavingFromBank(ctx, user1)
+		if !creatorBalance.IsEqual(tc.wantCreatorBalance) {
+			t.Errorf("%s: diff bank balance: got %v, want %v", tc.testName, creatorBalance, tc.wantCreatorBalance)
+		}
+
+		if tc.wantProposal == nil {
+			continue
+		}
+		proposal, err := proposalManager.storage.GetOngoingProposal(ctx, tc.proposalID)
+		if err != nil {
+			t.Errorf("%s: failed to get proposal, get err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, tc.wantProposal, proposal) {
+			t.Errorf("%s: diff proposal, got %v, want %v", tc.testName, proposal, tc.wantProposal)
+		}
+	}
+}
+
+func TestProtocolUpgradeProposal(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm := setupTest(t, 10)
+	handler := NewHandler(am, proposalManager, postManager, gm, vm)
+	proposalManager.InitGenesis(ctx)
+
+	user1 := createTestAccount(ctx, am, "user1", types.NewCoinFromInt64(2000000*types.Decimals))
+	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
+	// proposal is decided after decide period since creator pays full min deposit
+	minHeight := 10 + proposalParam.ProtocolUpgradeDecideSec/types.MinBlockIntervalSec
+
+	testCases := []struct {
+		testName     string
+		msg          sdk.Msg
+		wantRes      sdk.Result
+		wantProposal bool
+	}{
+		{
+			testName:     "upgrade height is passed before proposal is decided",
+			msg:          NewUpgradeProtocolMsg("user1", "v2", minHeight, "link", ""),
+			wantRes:      ErrUpgradeHeightTooLow(minHeight, minHeight).Result(),
+			wantProposal: false,
+		},
+		{
+			testName:     "upgrade height is reachable after proposal is decided",
+			msg:          NewUpgradeProtocolMsg("user1", "v2", minHeight+1, "link", ""),
+			wantRes:      sdk.Result{},
+			wantProposal: true,
+		},
+	}
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.wantRes, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.wantRes)
+		}
+		exist := proposalManager.storage.DoesProposalExist(ctx, types.ProposalKey("1"))
+		if exist != tc.wantProposal {
+			t.Errorf("%s: diff proposal exist, got %v, want %v", tc.testName, exist, tc.wantProposal)
+		}
+	}
+
+	// proposal may wait for deposit period before voting starts without full initial deposit
+	proposalParam.InitialDepositRatio = sdk.NewRat(1, 2)
+	err := param.ChangeParamEvent{Param: *proposalParam}.Execute(ctx, proposalManager.paramHolder)
+	assert.Nil(t, err)
+	minHeight += proposalParam.DepositPeriodSec / types.MinBlockIntervalSec
+	result := handler(ctx, NewUpgradeProtocolMsg("user1", "v3", minHeight, "link", ""))
+	assert.Equal(t, ErrUpgradeHeightTooLow(minHeight, minHeight).Result(), result)
+	result = handler(ctx, NewUpgradeProtocolMsg("user1", "v3", minHeight+1, "link", ""))
+	assert.Equal(t, sdk.Result{}, result)
+}
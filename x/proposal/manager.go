@@ -1,6 +1,7 @@
 package proposal
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/lino-network/lino/param"
@@ -43,6 +44,12 @@ func (pm ProposalManager) IsOngoingProposal(ctx sdk.Context, proposalID types.Pr
 	return err == nil
 }
 
+// IsPendingProposal - check given proposal ID is in pending proposal list
+func (pm ProposalManager) IsPendingProposal(ctx sdk.Context, proposalID types.ProposalKey) bool {
+	_, err := pm.storage.GetPendingProposal(ctx, proposalID)
+	return err == nil
+}
+
 // CreateContentCensorshipProposal - create a content censorship proposal
 func (pm ProposalManager) CreateContentCensorshipProposal(
 	ctx sdk.Context, permlink types.Permlink, reason string) model.Proposal {
@@ -52,14 +59,43 @@ func (pm ProposalManager) CreateContentCensorshipProposal(
 	}
 }
 
+// CreateContentAppealProposal - create a content appeal proposal
+func (pm ProposalManager) CreateContentAppealProposal(
+	ctx sdk.Context, permlink types.Permlink, reason string) model.Proposal {
+	return &model.ContentAppealProposal{
+		Permlink: permlink,
+		Reason:   reason,
+	}
+}
+
 // CreateProtocolUpgradeProposal - create a protocol upgrade proposal
-func (pm ProposalManager) CreateProtocolUpgradeProposal(ctx sdk.Context, link string, reason string) model.Proposal {
+func (pm ProposalManager) CreateProtocolUpgradeProposal(
+	ctx sdk.Context, name string, height int64, link string, reason string) model.Proposal {
 	return &model.ProtocolUpgradeProposal{
+		Name:   name,
+		Height: height,
 		Link:   link,
 		Reason: reason,
 	}
 }
 
+// CreateTextProposal - create a text proposal
+func (pm ProposalManager) CreateTextProposal(ctx sdk.Context, reason string) model.Proposal {
+	return &model.TextProposal{
+		Reason: reason,
+	}
+}
+
+// CreateCommunityPoolSpendProposal - create a community pool spend proposal
+func (pm ProposalManager) CreateCommunityPoolSpendProposal(
+	ctx sdk.Context, recipient types.AccountKey, amount types.Coin, reason string) model.Proposal {
+	return &model.CommunityPoolSpendProposal{
+		Recipient: recipient,
+		Amount:    amount,
+		Reason:    reason,
+	}
+}
+
 // CreateChangeParamProposal - create a change parameters proposal
 func (pm ProposalManager) CreateChangeParamProposal(
 	ctx sdk.Context, parameter param.Parameter, reason string) model.Proposal {
@@ -102,26 +138,214 @@ func (pm ProposalManager) AddProposal(
 		return newID, err
 	}
 
-	info := model.ProposalInfo{
+	proposal.SetProposalInfo(pm.newProposalInfo(ctx, creator, newID, decideSec))
+	if err := pm.storage.SetOngoingProposal(ctx, newID, proposal); err != nil {
+		return newID, err
+	}
+
+	if err := pm.IncreaseNextProposalID(ctx); err != nil {
+		return newID, err
+	}
+
+	return newID, nil
+}
+
+// AddPendingProposal - add a new proposal to pending proposal list,
+// proposal stays pending till min deposit is collected or deposit period ends
+func (pm ProposalManager) AddPendingProposal(
+	ctx sdk.Context, creator types.AccountKey, proposal model.Proposal,
+	depositPeriodSec int64) (types.ProposalKey, sdk.Error) {
+	newID, err := pm.GetNextProposalID(ctx)
+	if err != nil {
+		return newID, err
+	}
+
+	proposal.SetProposalInfo(pm.newProposalInfo(ctx, creator, newID, depositPeriodSec))
+	if err := pm.storage.SetPendingProposal(ctx, newID, proposal); err != nil {
+		return newID, err
+	}
+
+	if err := pm.IncreaseNextProposalID(ctx); err != nil {
+		return newID, err
+	}
+
+	return newID, nil
+}
+
+// StartVotingPeriod - move pending proposal to ongoing proposal list after min deposit is collected
+func (pm ProposalManager) StartVotingPeriod(
+	ctx sdk.Context, proposalID types.ProposalKey, decideSec int64) sdk.Error {
+	proposal, err := pm.storage.GetPendingProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	proposalInfo := proposal.GetProposalInfo()
+	proposalInfo.ExpiredAt = ctx.BlockHeader().Time.Unix() + decideSec
+	proposal.SetProposalInfo(proposalInfo)
+
+	if err := pm.storage.SetOngoingProposal(ctx, proposalID, proposal); err != nil {
+		return err
+	}
+	return pm.storage.DeletePendingProposal(ctx, proposalID)
+}
+
+// RevokePendingProposal - move pending proposal to expired proposal list
+// as revoked if min deposit isn't collected in deposit period
+func (pm ProposalManager) RevokePendingProposal(ctx sdk.Context, proposalID types.ProposalKey) sdk.Error {
+	proposal, err := pm.storage.GetPendingProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	proposalInfo := proposal.GetProposalInfo()
+	proposalInfo.Result = types.ProposalRevoked
+	proposal.SetProposalInfo(proposalInfo)
+
+	if err := pm.storage.SetExpiredProposal(ctx, proposalID, proposal); err != nil {
+		return err
+	}
+	return pm.storage.DeletePendingProposal(ctx, proposalID)
+}
+
+func (pm ProposalManager) newProposalInfo(
+	ctx sdk.Context, creator types.AccountKey, proposalID types.ProposalKey, durationSec int64) model.ProposalInfo {
+	return model.ProposalInfo{
 		Creator:       creator,
-		ProposalID:    newID,
+		ProposalID:    proposalID,
 		AgreeVotes:    types.NewCoinFromInt64(0),
 		DisagreeVotes: types.NewCoinFromInt64(0),
+		AbstainVotes:  types.NewCoinFromInt64(0),
+		VetoVotes:     types.NewCoinFromInt64(0),
 		Result:        types.ProposalNotPass,
 		CreatedAt:     ctx.BlockHeader().Time.Unix(),
-		ExpiredAt:     ctx.BlockHeader().Time.Unix() + decideSec,
+		ExpiredAt:     ctx.BlockHeader().Time.Unix() + durationSec,
 	}
-	proposal.SetProposalInfo(info)
+}
 
-	if err := pm.storage.SetOngoingProposal(ctx, newID, proposal); err != nil {
-		return newID, err
+// GetProposalType - get type of a pending or ongoing proposal
+func (pm ProposalManager) GetProposalType(
+	ctx sdk.Context, proposalID types.ProposalKey) (types.ProposalType, sdk.Error) {
+	proposal, err := pm.storage.GetPendingProposal(ctx, proposalID)
+	if err != nil {
+		if proposal, err = pm.storage.GetOngoingProposal(ctx, proposalID); err != nil {
+			return types.ProposalType(0), err
+		}
+	}
+
+	switch proposal.(type) {
+	case *model.ChangeParamProposal:
+		return types.ChangeParam, nil
+	case *model.ContentCensorshipProposal:
+		return types.ContentCensorship, nil
+	case *model.ProtocolUpgradeProposal:
+		return types.ProtocolUpgrade, nil
+	case *model.ContentAppealProposal:
+		return types.ContentAppeal, nil
+	case *model.TextProposal:
+		return types.TextProposal, nil
+	case *model.CommunityPoolSpendProposal:
+		return types.CommunityPoolSpend, nil
+	}
+	return types.ProposalType(0), ErrIncorrectProposalType()
+}
+
+// GetProposalDepositParam - based on proposal type, get min deposit and decide seconds
+func (pm ProposalManager) GetProposalDepositParam(
+	ctx sdk.Context, proposalType types.ProposalType) (types.Coin, int64, sdk.Error) {
+	param, err := pm.paramHolder.GetProposalParam(ctx)
+	if err != nil {
+		return types.NewCoinFromInt64(0), 0, err
+	}
+	switch proposalType {
+	case types.ChangeParam, types.CommunityPoolSpend:
+		return param.ChangeParamMinDeposit, param.ChangeParamDecideSec, nil
+	case types.ContentCensorship, types.ContentAppeal:
+		return param.ContentCensorshipMinDeposit, param.ContentCensorshipDecideSec, nil
+	case types.ProtocolUpgrade, types.TextProposal:
+		return param.ProtocolUpgradeMinDeposit, param.ProtocolUpgradeDecideSec, nil
+	default:
+		return types.NewCoinFromInt64(0), 0, ErrIncorrectProposalType()
 	}
+}
 
-	if err := pm.IncreaseNextProposalID(ctx); err != nil {
-		return newID, err
+// AddDeposit - record deposit on a proposal, return total deposit of the proposal
+func (pm ProposalManager) AddDeposit(
+	ctx sdk.Context, proposalID types.ProposalKey, depositor types.AccountKey,
+	amount types.Coin) (types.Coin, sdk.Error) {
+	deposit := &model.ProposalDeposit{
+		Deposits: []model.Deposit{},
+		Total:    types.NewCoinFromInt64(0),
+	}
+	if pm.storage.DoesDepositExist(ctx, proposalID) {
+		var err sdk.Error
+		if deposit, err = pm.storage.GetDeposit(ctx, proposalID); err != nil {
+			return types.NewCoinFromInt64(0), err
+		}
+	}
+
+	found := false
+	for i := range deposit.Deposits {
+		if deposit.Deposits[i].Depositor == depositor {
+			deposit.Deposits[i].Amount = deposit.Deposits[i].Amount.Plus(amount)
+			found = true
+			break
+		}
+	}
+	if !found {
+		deposit.Deposits = append(deposit.Deposits, model.Deposit{
+			Depositor: depositor,
+			Amount:    amount,
+		})
+	}
+	deposit.Total = deposit.Total.Plus(amount)
+
+	if err := pm.storage.SetDeposit(ctx, proposalID, deposit); err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return deposit.Total, nil
+}
+
+// GetDeposit - get deposits on a proposal, nil if proposal has no deposit record
+func (pm ProposalManager) GetDeposit(
+	ctx sdk.Context, proposalID types.ProposalKey) (*model.ProposalDeposit, sdk.Error) {
+	if !pm.storage.DoesDepositExist(ctx, proposalID) {
+		return nil, nil
 	}
+	return pm.storage.GetDeposit(ctx, proposalID)
+}
 
-	return newID, nil
+// DeleteDeposit - remove deposit record after deposits are returned or forfeited
+func (pm ProposalManager) DeleteDeposit(ctx sdk.Context, proposalID types.ProposalKey) sdk.Error {
+	return pm.storage.DeleteDeposit(ctx, proposalID)
+}
+
+// ShouldForfeitDeposit - check if deposit of a decided proposal is forfeited,
+// which happens when total votes are too few or veto votes take too large a share,
+// unset forfeit ratio doesn't forfeit deposit
+func (pm ProposalManager) ShouldForfeitDeposit(
+	ctx sdk.Context, proposalType types.ProposalType, proposalID types.ProposalKey,
+	totalLinoStake types.Coin) (bool, sdk.Error) {
+	proposal, err := pm.storage.GetExpiredProposal(ctx, proposalID)
+	if err != nil {
+		return false, err
+	}
+	param, err := pm.paramHolder.GetProposalParam(ctx)
+	if err != nil {
+		return false, err
+	}
+	quorum, err := pm.GetProposalQuorum(ctx, proposalType, totalLinoStake)
+	if err != nil {
+		return false, err
+	}
+
+	proposalInfo := proposal.GetProposalInfo()
+	if !isRatioUnset(param.DepositForfeitQuorumRatio) &&
+		getTotalVotes(proposalInfo).ToRat().LT(quorum.ToRat().Mul(param.DepositForfeitQuorumRatio)) {
+		return true, nil
+	}
+	if isRatioUnset(param.DepositForfeitVetoRatio) {
+		return false, nil
+	}
+	return isVetoed(proposalInfo, param.DepositForfeitVetoRatio), nil
 }
 
 // GetProposalPassParam - based on proposal type, get pass ratio and pass vote requirement
@@ -134,44 +358,90 @@ func (pm ProposalManager) GetProposalPassParam(
 	switch proposalType {
 	case types.ChangeParam:
 		return param.ChangeParamPassRatio, param.ChangeParamPassVotes, nil
-	case types.ContentCensorship:
+	case types.ContentCensorship, types.ContentAppeal:
 		return param.ContentCensorshipPassRatio, param.ContentCensorshipPassVotes, nil
-	case types.ProtocolUpgrade:
+	case types.ProtocolUpgrade, types.TextProposal:
 		return param.ProtocolUpgradePassRatio, param.ProtocolUpgradePassVotes, nil
+	case types.CommunityPoolSpend:
+		return param.ChangeParamPassRatio, param.ChangeParamPassVotes, nil
 	default:
 		return sdk.NewRat(1, 1), types.NewCoinFromInt64(0), ErrIncorrectProposalType()
 	}
 }
 
-// UpdateProposalVotingStatus - update proposal status after voting
-func (pm ProposalManager) UpdateProposalVotingStatus(ctx sdk.Context, proposalID types.ProposalKey,
-	voter types.AccountKey, voteResult bool, votingPower types.Coin) sdk.Error {
+// GetProposalQuorum - based on proposal type, get minimum total votes as a ratio of total lino stake,
+// quorum is zero if quorum ratio is unset
+func (pm ProposalManager) GetProposalQuorum(
+	ctx sdk.Context, proposalType types.ProposalType, totalLinoStake types.Coin) (types.Coin, sdk.Error) {
+	param, err := pm.paramHolder.GetProposalParam(ctx)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	ratio, err := getQuorumRatio(param, proposalType)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	if isRatioUnset(ratio) {
+		return types.NewCoinFromInt64(0), nil
+	}
+	return types.RatToCoin(totalLinoStake.ToRat().Mul(ratio)), nil
+}
+
+func getQuorumRatio(proposalParam *param.ProposalParam, proposalType types.ProposalType) (sdk.Rat, sdk.Error) {
+	switch proposalType {
+	case types.ChangeParam, types.CommunityPoolSpend:
+		return proposalParam.ChangeParamQuorumRatio, nil
+	case types.ContentCensorship, types.ContentAppeal:
+		return proposalParam.ContentCensorshipQuorumRatio, nil
+	case types.ProtocolUpgrade, types.TextProposal:
+		return proposalParam.ProtocolUpgradeQuorumRatio, nil
+	default:
+		return sdk.ZeroRat(), ErrIncorrectProposalType()
+	}
+}
+
+// ratio parameters stored before they were introduced are unset
+func isRatioUnset(ratio sdk.Rat) bool {
+	return ratio.Rat == nil || ratio.IsZero()
+}
+
+// SetProposalTally - overwrite proposal votes with the tally calculated with current voting power
+func (pm ProposalManager) SetProposalTally(ctx sdk.Context, proposalID types.ProposalKey,
+	agreeVotes types.Coin, disagreeVotes types.Coin, abstainVotes types.Coin, vetoVotes types.Coin) sdk.Error {
 	proposal, err := pm.storage.GetOngoingProposal(ctx, proposalID)
 	if err != nil {
 		return err
 	}
 	proposalInfo := proposal.GetProposalInfo()
-
-	if voteResult == true {
-		proposalInfo.AgreeVotes = proposalInfo.AgreeVotes.Plus(votingPower)
-	} else {
-		proposalInfo.DisagreeVotes = proposalInfo.DisagreeVotes.Plus(votingPower)
-	}
+	proposalInfo.AgreeVotes = agreeVotes
+	proposalInfo.DisagreeVotes = disagreeVotes
+	proposalInfo.AbstainVotes = abstainVotes
+	proposalInfo.VetoVotes = vetoVotes
 
 	proposal.SetProposalInfo(proposalInfo)
 	if err := pm.storage.SetOngoingProposal(ctx, proposalID, proposal); err != nil {
 		return err
 	}
-
 	return nil
 }
 
-// UpdateProposalPassStatus - update proposal pass status when proposal change from ongoing to expired
+// UpdateProposalPassStatus - update proposal pass status when proposal change from ongoing to expired,
+// pass votes are used if quorum ratio or veto ratio is unset
 func (pm ProposalManager) UpdateProposalPassStatus(
 	ctx sdk.Context, proposalType types.ProposalType,
-	proposalID types.ProposalKey) (types.ProposalResult, sdk.Error) {
+	proposalID types.ProposalKey, totalLinoStake types.Coin) (types.ProposalResult, sdk.Error) {
 	if ctx.BlockHeader().Height > types.LinoBlockchainFirstUpdateHeight {
-		return pm.UpdateProposalStatus(ctx, proposalType, proposalID)
+		param, err := pm.paramHolder.GetProposalParam(ctx)
+		if err != nil {
+			return types.ProposalNotPass, err
+		}
+		quorumRatio, err := getQuorumRatio(param, proposalType)
+		if err != nil {
+			return types.ProposalNotPass, err
+		}
+		if !isRatioUnset(quorumRatio) && !isRatioUnset(param.VetoRatio) {
+			return pm.UpdateProposalStatus(ctx, proposalType, proposalID, totalLinoStake)
+		}
 	}
 	proposal, err := pm.storage.GetOngoingProposal(ctx, proposalID)
 	if err != nil {
@@ -185,15 +455,14 @@ func (pm ProposalManager) UpdateProposalPassStatus(
 	if err != nil {
 		return types.ProposalNotPass, err
 	}
+	// proposal without enough votes doesn't pass and is expired as well
+	proposalInfo.Result = types.ProposalNotPass
 	totalVotes := proposalInfo.AgreeVotes.Plus(proposalInfo.DisagreeVotes)
-	if !totalVotes.IsGT(minVotes) {
-		return types.ProposalNotPass, nil
-	}
-	actualRatio := proposalInfo.AgreeVotes.ToRat().Quo(totalVotes.ToRat()).Round(types.PrecisionFactor)
-	if ratio.LT(actualRatio) {
-		proposalInfo.Result = types.ProposalPass
-	} else {
-		proposalInfo.Result = types.ProposalNotPass
+	if totalVotes.IsGT(minVotes) {
+		actualRatio := proposalInfo.AgreeVotes.ToRat().Quo(totalVotes.ToRat()).Round(types.PrecisionFactor)
+		if ratio.LT(actualRatio) {
+			proposalInfo.Result = types.ProposalPass
+		}
 	}
 
 	proposal.SetProposalInfo(proposalInfo)
@@ -207,10 +476,11 @@ func (pm ProposalManager) UpdateProposalPassStatus(
 	return proposalInfo.Result, nil
 }
 
-// UpdateProposalStatus - update proposal pass status when proposal change from ongoing to expired
+// UpdateProposalStatus - update proposal pass status when proposal change from ongoing to expired,
+// quorum is a ratio of total lino stake and a large enough veto share fails the proposal outright
 func (pm ProposalManager) UpdateProposalStatus(
 	ctx sdk.Context, proposalType types.ProposalType,
-	proposalID types.ProposalKey) (types.ProposalResult, sdk.Error) {
+	proposalID types.ProposalKey, totalLinoStake types.Coin) (types.ProposalResult, sdk.Error) {
 	proposal, err := pm.storage.GetOngoingProposal(ctx, proposalID)
 	if err != nil {
 		return types.ProposalNotPass, err
@@ -219,17 +489,29 @@ func (pm ProposalManager) UpdateProposalStatus(
 	proposalInfo := proposal.GetProposalInfo()
 
 	// calculate if agree votes meet minimum pass requirement
-	ratio, minVotes, err := pm.GetProposalPassParam(ctx, proposalType)
+	ratio, _, err := pm.GetProposalPassParam(ctx, proposalType)
 	if err != nil {
 		return types.ProposalNotPass, err
 	}
-	totalVotes := proposalInfo.AgreeVotes.Plus(proposalInfo.DisagreeVotes)
-	actualRatio := proposalInfo.AgreeVotes.ToRat().Quo(totalVotes.ToRat()).Round(types.PrecisionFactor)
+	quorum, err := pm.GetProposalQuorum(ctx, proposalType, totalLinoStake)
+	if err != nil {
+		return types.ProposalNotPass, err
+	}
+	param, err := pm.paramHolder.GetProposalParam(ctx)
+	if err != nil {
+		return types.ProposalNotPass, err
+	}
+	// abstain votes count toward quorum but not toward pass ratio,
+	// veto votes count as disagree
+	decisiveVotes := proposalInfo.AgreeVotes.Plus(proposalInfo.DisagreeVotes).Plus(proposalInfo.VetoVotes)
+	totalVotes := getTotalVotes(proposalInfo)
 
-	if !totalVotes.IsGT(minVotes) || !ratio.LT(actualRatio) {
-		proposalInfo.Result = types.ProposalNotPass
-	} else {
-		proposalInfo.Result = types.ProposalPass
+	proposalInfo.Result = types.ProposalNotPass
+	if totalVotes.IsGT(quorum) && decisiveVotes.IsPositive() && !isVetoed(proposalInfo, param.VetoRatio) {
+		actualRatio := proposalInfo.AgreeVotes.ToRat().Quo(decisiveVotes.ToRat()).Round(types.PrecisionFactor)
+		if ratio.LT(actualRatio) {
+			proposalInfo.Result = types.ProposalPass
+		}
 	}
 
 	proposal.SetProposalInfo(proposalInfo)
@@ -243,6 +525,22 @@ func (pm ProposalManager) UpdateProposalStatus(
 	return proposalInfo.Result, nil
 }
 
+// get all votes including abstain and veto
+func getTotalVotes(proposalInfo model.ProposalInfo) types.Coin {
+	return proposalInfo.AgreeVotes.Plus(proposalInfo.DisagreeVotes).
+		Plus(proposalInfo.AbstainVotes).Plus(proposalInfo.VetoVotes)
+}
+
+// check if veto votes share of total votes exceeds given ratio
+func isVetoed(proposalInfo model.ProposalInfo, vetoRatio sdk.Rat) bool {
+	totalVotes := getTotalVotes(proposalInfo)
+	if !totalVotes.IsPositive() {
+		return false
+	}
+	actualRatio := proposalInfo.VetoVotes.ToRat().Quo(totalVotes.ToRat()).Round(types.PrecisionFactor)
+	return actualRatio.GT(vetoRatio)
+}
+
 // CreateDecideProposalEvent - create a decide proposal event
 func (pm ProposalManager) CreateDecideProposalEvent(
 	ctx sdk.Context, proposalType types.ProposalType, proposalID types.ProposalKey) types.Event {
@@ -253,6 +551,17 @@ func (pm ProposalManager) CreateDecideProposalEvent(
 	return event
 }
 
+// CreateExpireProposalDepositEvent - create an event to revoke proposal
+// if min deposit isn't collected in deposit period
+func (pm ProposalManager) CreateExpireProposalDepositEvent(
+	ctx sdk.Context, proposalType types.ProposalType, proposalID types.ProposalKey) types.Event {
+	event := ExpireProposalDepositEvent{
+		ProposalType: proposalType,
+		ProposalID:   proposalID,
+	}
+	return event
+}
+
 // CreateParamChangeEvent - create a parameter change event
 func (pm ProposalManager) CreateParamChangeEvent(
 	ctx sdk.Context, proposalID types.ProposalKey) (types.Event, sdk.Error) {
@@ -279,11 +588,67 @@ func (pm ProposalManager) GetPermlink(ctx sdk.Context, proposalID types.Proposal
 		return types.Permlink(""), err
 	}
 
-	p, ok := proposal.(*model.ContentCensorshipProposal)
+	switch p := proposal.(type) {
+	case *model.ContentCensorshipProposal:
+		return p.Permlink, nil
+	case *model.ContentAppealProposal:
+		return p.Permlink, nil
+	}
+	return types.Permlink(""), ErrIncorrectProposalType()
+}
+
+// GetCommunityPoolSpend - get recipient and amount from expired community pool spend proposal
+func (pm ProposalManager) GetCommunityPoolSpend(
+	ctx sdk.Context, proposalID types.ProposalKey) (types.AccountKey, types.Coin, sdk.Error) {
+	proposal, err := pm.storage.GetExpiredProposal(ctx, proposalID)
+	if err != nil {
+		return types.AccountKey(""), types.NewCoinFromInt64(0), err
+	}
+
+	p, ok := proposal.(*model.CommunityPoolSpendProposal)
+	if !ok {
+		return types.AccountKey(""), types.NewCoinFromInt64(0), ErrIncorrectProposalType()
+	}
+	return p.Recipient, p.Amount, nil
+}
+
+// ScheduleUpgrade - schedule upgrade from expired protocol upgrade proposal,
+// replace previous plan if any. Proposal without name or with passed height is dropped
+func (pm ProposalManager) ScheduleUpgrade(ctx sdk.Context, proposalID types.ProposalKey) sdk.Error {
+	proposal, err := pm.storage.GetExpiredProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	p, ok := proposal.(*model.ProtocolUpgradeProposal)
 	if !ok {
-		return types.Permlink(""), ErrIncorrectProposalType()
+		return ErrIncorrectProposalType()
+	}
+	if len(p.Name) == 0 || p.Height <= ctx.BlockHeader().Height {
+		ctx.Logger().Error(fmt.Sprintf(
+			"drop upgrade %s at height %d, proposal %s passed at height %d",
+			p.Name, p.Height, proposalID, ctx.BlockHeader().Height))
+		return nil
+	}
+	plan := &model.UpgradePlan{
+		Name:       p.Name,
+		Height:     p.Height,
+		ProposalID: proposalID,
 	}
-	return p.Permlink, nil
+	return pm.storage.SetUpgradePlan(ctx, plan)
+}
+
+// GetUpgradePlan - get scheduled upgrade plan, nil if no upgrade is scheduled
+func (pm ProposalManager) GetUpgradePlan(ctx sdk.Context) (*model.UpgradePlan, sdk.Error) {
+	if !pm.storage.DoesUpgradePlanExist(ctx) {
+		return nil, nil
+	}
+	return pm.storage.GetUpgradePlan(ctx)
+}
+
+// ClearUpgradePlan - remove scheduled upgrade plan after it is applied
+func (pm ProposalManager) ClearUpgradePlan(ctx sdk.Context) sdk.Error {
+	return pm.storage.DeleteUpgradePlan(ctx)
 }
 
 // GetOngoingProposalList - get ongoing proposal list
@@ -4,105 +4,12 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/proposal/model"
 	"github.com/stretchr/testify/assert"
 )
 
-func TestUpdateProposalVotingStatus(t *testing.T) {
-	ctx, _, pm, _, _, _, _ := setupTest(t, 0)
-	permlink := types.Permlink("permlink")
-	user1 := types.AccountKey("user1")
-	censorshipReason := "reason"
-	proposal1 := &model.ContentCensorshipProposal{
-		Permlink: permlink,
-		Reason:   censorshipReason,
-	}
-
-	pm.InitGenesis(ctx)
-	curTime := ctx.BlockHeader().Time.Unix()
-	decideSec := int64(100)
-	proposalID1, _ := pm.AddProposal(ctx, user1, proposal1, decideSec)
-
-	testCases := []struct {
-		testName     string
-		proposalID   types.ProposalKey
-		voter        types.AccountKey
-		voteResult   bool
-		votingPower  types.Coin
-		wantProposal model.Proposal
-	}{
-		{
-			testName:    "agree vote",
-			proposalID:  proposalID1,
-			voter:       user1,
-			voteResult:  true,
-			votingPower: types.NewCoinFromInt64(1),
-			wantProposal: &model.ContentCensorshipProposal{
-				ProposalInfo: model.ProposalInfo{
-					Creator:       user1,
-					ProposalID:    proposalID1,
-					AgreeVotes:    types.NewCoinFromInt64(1),
-					DisagreeVotes: types.NewCoinFromInt64(0),
-					CreatedAt:     curTime,
-					ExpiredAt:     curTime + decideSec,
-				},
-				Permlink: permlink,
-				Reason:   censorshipReason},
-		},
-		{
-			testName:    "one more agree vote",
-			proposalID:  proposalID1,
-			voter:       user1,
-			voteResult:  true,
-			votingPower: types.NewCoinFromInt64(2),
-			wantProposal: &model.ContentCensorshipProposal{
-				ProposalInfo: model.ProposalInfo{
-					Creator:       user1,
-					ProposalID:    proposalID1,
-					AgreeVotes:    types.NewCoinFromInt64(3),
-					DisagreeVotes: types.NewCoinFromInt64(0),
-					CreatedAt:     curTime,
-					ExpiredAt:     curTime + decideSec,
-				},
-				Permlink: permlink,
-				Reason:   censorshipReason},
-		},
-		{
-			testName:    "one disagree vote",
-			proposalID:  proposalID1,
-			voter:       user1,
-			voteResult:  false,
-			votingPower: types.NewCoinFromInt64(5),
-			wantProposal: &model.ContentCensorshipProposal{
-				ProposalInfo: model.ProposalInfo{
-					Creator:       user1,
-					ProposalID:    proposalID1,
-					AgreeVotes:    types.NewCoinFromInt64(3),
-					DisagreeVotes: types.NewCoinFromInt64(5),
-					CreatedAt:     curTime,
-					ExpiredAt:     curTime + decideSec,
-				},
-				Permlink: permlink,
-				Reason:   censorshipReason},
-		},
-	}
-	for _, tc := range testCases {
-		err := pm.UpdateProposalVotingStatus(ctx, tc.proposalID, tc.voter, tc.voteResult, tc.votingPower)
-		if err != nil {
-			t.Errorf("%s: failed to update proposal voting status, got err %v", tc.testName, err)
-		}
-
-		proposal, err := pm.storage.GetOngoingProposal(ctx, tc.proposalID)
-		if err != nil {
-			t.Errorf("%s: failed to get proposal, got err %v", tc.testName, err)
-		}
-		if !assert.Equal(t, tc.wantProposal, proposal) {
-			t.Errorf("%s: diff result, got %v, want %v", tc.testName, proposal, tc.wantProposal)
-		}
-	}
-}
-
 func TestUpdateProposalPassStatus(t *testing.T) {
 	ctx, _, pm, _, _, _, _ := setupTest(t, 100000000)
 	permlink := types.Permlink("permlink")
@@ -126,6 +33,9 @@ func TestUpdateProposalPassStatus(t *testing.T) {
 	curTime := ctx.BlockHeader().Time.Unix()
 	proposalParam, _ := pm.paramHolder.GetProposalParam(ctx)
 	decideSec := proposalParam.ContentCensorshipDecideSec
+	// quorum equals pass votes
+	totalLinoStake := types.RatToCoin(
+		proposalParam.ContentCensorshipPassVotes.ToRat().Quo(proposalParam.ContentCensorshipQuorumRatio))
 
 	proposalID1, _ := pm.AddProposal(ctx, user1, proposal1, decideSec)
 	proposalID2, _ := pm.AddProposal(ctx, user1, proposal2, decideSec)
@@ -153,6 +63,8 @@ func TestUpdateProposalPassStatus(t *testing.T) {
 					ProposalID:    proposalID1,
 					AgreeVotes:    proposalParam.ContentCensorshipPassVotes,
 					DisagreeVotes: proposalParam.ContentCensorshipPassVotes,
+					AbstainVotes:  types.NewCoinFromInt64(0),
+					VetoVotes:     types.NewCoinFromInt64(0),
 					Result:        types.ProposalNotPass,
 					CreatedAt:     curTime,
 					ExpiredAt:     curTime + decideSec,
@@ -174,6 +86,8 @@ func TestUpdateProposalPassStatus(t *testing.T) {
 					ProposalID:    proposalID2,
 					AgreeVotes:    proposalParam.ContentCensorshipPassVotes.Minus(types.NewCoinFromInt64(10)),
 					DisagreeVotes: types.NewCoinFromInt64(0),
+					AbstainVotes:  types.NewCoinFromInt64(0),
+					VetoVotes:     types.NewCoinFromInt64(0),
 					Result:        types.ProposalNotPass,
 					CreatedAt:     curTime,
 					ExpiredAt:     curTime + decideSec,
@@ -195,6 +109,8 @@ func TestUpdateProposalPassStatus(t *testing.T) {
 					ProposalID:    proposalID3,
 					AgreeVotes:    proposalParam.ContentCensorshipPassVotes.Plus(types.NewCoinFromInt64(10)),
 					DisagreeVotes: proposalParam.ContentCensorshipPassVotes.Plus(types.NewCoinFromInt64(11)),
+					AbstainVotes:  types.NewCoinFromInt64(0),
+					VetoVotes:     types.NewCoinFromInt64(0),
 					Result:        types.ProposalNotPass,
 					CreatedAt:     curTime,
 					ExpiredAt:     curTime + decideSec,
@@ -209,7 +125,7 @@ func TestUpdateProposalPassStatus(t *testing.T) {
 			t.Errorf("%s: failed to add proposal info, got err %v", tc.testName, err)
 		}
 
-		res, err := pm.UpdateProposalPassStatus(ctx, tc.proposalType, tc.proposalID)
+		res, err := pm.UpdateProposalPassStatus(ctx, tc.proposalType, tc.proposalID, totalLinoStake)
 		if err != nil {
 			t.Errorf("%s: failed to update proposal pass status, got err %v", tc.testName, err)
 		}
@@ -232,6 +148,247 @@ func TestUpdateProposalPassStatus(t *testing.T) {
 	}
 }
 
+func TestUpdateProposalStatusWithAbstain(t *testing.T) {
+	ctx, _, pm, _, _, _, _ := setupTest(t, 0)
+	pm.InitGenesis(ctx)
+	user1 := types.AccountKey("user1")
+	proposalParam, _ := pm.paramHolder.GetProposalParam(ctx)
+	totalLinoStake := types.NewCoinFromInt64(1000000 * types.Decimals)
+	quorum, _ := pm.GetProposalQuorum(ctx, types.ContentCensorship, totalLinoStake)
+	c10 := types.NewCoinFromInt64(10)
+	zero := types.NewCoinFromInt64(0)
+
+	testCases := []struct {
+		testName        string
+		agreeVotes      types.Coin
+		disagreeVotes   types.Coin
+		abstainVotes    types.Coin
+		vetoVotes       types.Coin
+		wantProposalRes types.ProposalResult
+	}{
+		{
+			testName:        "abstain votes help reach quorum",
+			agreeVotes:      c10,
+			disagreeVotes:   zero,
+			abstainVotes:    quorum,
+			vetoVotes:       zero,
+			wantProposalRes: types.ProposalPass,
+		},
+		{
+			testName:        "quorum not reached without abstain votes",
+			agreeVotes:      c10,
+			disagreeVotes:   zero,
+			abstainVotes:    zero,
+			vetoVotes:       zero,
+			wantProposalRes: types.ProposalNotPass,
+		},
+		{
+			testName:        "only abstain votes",
+			agreeVotes:      zero,
+			disagreeVotes:   zero,
+			abstainVotes:    quorum.Plus(c10),
+			vetoVotes:       zero,
+			wantProposalRes: types.ProposalNotPass,
+		},
+		{
+			testName:        "abstain votes don't count toward pass ratio",
+			agreeVotes:      c10,
+			disagreeVotes:   c10,
+			abstainVotes:    quorum,
+			vetoVotes:       zero,
+			wantProposalRes: types.ProposalNotPass,
+		},
+		{
+			testName:        "veto votes count as disagree",
+			agreeVotes:      quorum,
+			disagreeVotes:   quorum.Minus(c10),
+			abstainVotes:    zero,
+			vetoVotes:       c10,
+			wantProposalRes: types.ProposalNotPass,
+		},
+		{
+			testName:        "veto share exceeds veto ratio",
+			agreeVotes:      quorum.Plus(quorum),
+			disagreeVotes:   zero,
+			abstainVotes:    zero,
+			vetoVotes:       quorum,
+			wantProposalRes: types.ProposalNotPass,
+		},
+		{
+			testName:        "veto share below veto ratio",
+			agreeVotes:      quorum.Plus(quorum).Plus(quorum),
+			disagreeVotes:   zero,
+			abstainVotes:    zero,
+			vetoVotes:       quorum,
+			wantProposalRes: types.ProposalPass,
+		},
+	}
+	for _, tc := range testCases {
+		proposal := &model.ContentCensorshipProposal{
+			Permlink: types.Permlink("permlink"),
+			Reason:   "reason",
+		}
+		proposalID, err := pm.AddProposal(ctx, user1, proposal, proposalParam.ContentCensorshipDecideSec)
+		assert.Nil(t, err)
+		err = pm.SetProposalTally(
+			ctx, proposalID, tc.agreeVotes, tc.disagreeVotes, tc.abstainVotes, tc.vetoVotes)
+		assert.Nil(t, err)
+
+		res, err := pm.UpdateProposalStatus(ctx, types.ContentCensorship, proposalID, totalLinoStake)
+		if err != nil {
+			t.Errorf("%s: failed to update proposal status, got err %v", tc.testName, err)
+		}
+		if res != tc.wantProposalRes {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, res, tc.wantProposalRes)
+		}
+	}
+}
+
+func TestShouldForfeitDeposit(t *testing.T) {
+	ctx, _, pm, _, _, _, _ := setupTest(t, 0)
+	pm.InitGenesis(ctx)
+	user1 := types.AccountKey("user1")
+	proposalParam, _ := pm.paramHolder.GetProposalParam(ctx)
+	totalLinoStake := types.NewCoinFromInt64(1000000 * types.Decimals)
+	quorum, _ := pm.GetProposalQuorum(ctx, types.ContentCensorship, totalLinoStake)
+	c10 := types.NewCoinFromInt64(10)
+	zero := types.NewCoinFromInt64(0)
+
+	testCases := []struct {
+		testName      string
+		agreeVotes    types.Coin
+		disagreeVotes types.Coin
+		vetoVotes     types.Coin
+		wantForfeit   bool
+	}{
+		{
+			testName:      "no one votes",
+			agreeVotes:    zero,
+			disagreeVotes: zero,
+			vetoVotes:     zero,
+			wantForfeit:   true,
+		},
+		{
+			testName:      "participation is too low",
+			agreeVotes:    c10,
+			disagreeVotes: c10,
+			vetoVotes:     zero,
+			wantForfeit:   true,
+		},
+		{
+			testName:      "rejected proposal with enough participation",
+			agreeVotes:    zero,
+			disagreeVotes: quorum,
+			vetoVotes:     zero,
+			wantForfeit:   false,
+		},
+		{
+			testName:      "veto share is too large",
+			agreeVotes:    quorum,
+			disagreeVotes: zero,
+			vetoVotes:     quorum,
+			wantForfeit:   true,
+		},
+		{
+			testName:      "veto share is small",
+			agreeVotes:    quorum,
+			disagreeVotes: zero,
+			vetoVotes:     c10,
+			wantForfeit:   false,
+		},
+	}
+	for _, tc := range testCases {
+		proposal := &model.ContentCensorshipProposal{
+			Permlink: types.Permlink("permlink"),
+			Reason:   "reason",
+		}
+		proposalID, err := pm.AddProposal(ctx, user1, proposal, proposalParam.ContentCensorshipDecideSec)
+		assert.Nil(t, err)
+		err = pm.SetProposalTally(ctx, proposalID, tc.agreeVotes, tc.disagreeVotes, zero, tc.vetoVotes)
+		assert.Nil(t, err)
+		_, err = pm.UpdateProposalStatus(ctx, types.ContentCensorship, proposalID, totalLinoStake)
+		assert.Nil(t, err)
+
+		forfeit, err := pm.ShouldForfeitDeposit(ctx, types.ContentCensorship, proposalID, totalLinoStake)
+		if err != nil {
+			t.Errorf("%s: failed to check deposit, got err %v", tc.testName, err)
+		}
+		if forfeit != tc.wantForfeit {
+			t.Errorf("%s: diff forfeit, got %v, want %v", tc.testName, forfeit, tc.wantForfeit)
+		}
+	}
+}
+
+func TestUnsetProposalRatios(t *testing.T) {
+	ctx, _, pm, _, _, _, _ := setupTest(t, 100000000)
+	pm.InitGenesis(ctx)
+	user1 := types.AccountKey("user1")
+	proposalParam, _ := pm.paramHolder.GetProposalParam(ctx)
+	totalLinoStake := types.NewCoinFromInt64(1000000 * types.Decimals)
+	passVotes := proposalParam.ContentCensorshipPassVotes
+	c10 := types.NewCoinFromInt64(10)
+	zero := types.NewCoinFromInt64(0)
+
+	// ratios stored before they were introduced
+	proposalParam.ContentCensorshipQuorumRatio = sdk.ZeroRat()
+	proposalParam.VetoRatio = sdk.ZeroRat()
+	proposalParam.DepositForfeitQuorumRatio = sdk.ZeroRat()
+	proposalParam.DepositForfeitVetoRatio = sdk.ZeroRat()
+	err := param.ChangeParamEvent{Param: *proposalParam}.Execute(ctx, pm.paramHolder)
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName    string
+		agreeVotes  types.Coin
+		vetoVotes   types.Coin
+		wantResult  types.ProposalResult
+		wantForfeit bool
+	}{
+		{
+			testName:    "pass votes are required without quorum ratio",
+			agreeVotes:  c10,
+			vetoVotes:   zero,
+			wantResult:  types.ProposalNotPass,
+			wantForfeit: false,
+		},
+		{
+			testName:    "veto doesn't fail proposal or forfeit deposit without veto ratio",
+			agreeVotes:  passVotes.Plus(c10),
+			vetoVotes:   c10,
+			wantResult:  types.ProposalPass,
+			wantForfeit: false,
+		},
+	}
+	for _, tc := range testCases {
+		proposal := &model.ContentCensorshipProposal{
+			Permlink: types.Permlink("permlink"),
+			Reason:   "reason",
+		}
+		proposalID, err := pm.AddProposal(ctx, user1, proposal, proposalParam.ContentCensorshipDecideSec)
+		assert.Nil(t, err)
+		err = pm.SetProposalTally(ctx, proposalID, tc.agreeVotes, zero, zero, tc.vetoVotes)
+		assert.Nil(t, err)
+
+		res, err := pm.UpdateProposalPassStatus(ctx, types.ContentCensorship, proposalID, totalLinoStake)
+		if err != nil {
+			t.Errorf("%s: failed to update proposal pass status, got err %v", tc.testName, err)
+		}
+		if res != tc.wantResult {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, res, tc.wantResult)
+		}
+		if pm.IsOngoingProposal(ctx, proposalID) {
+			t.Errorf("%s: proposal is still ongoing", tc.testName)
+		}
+		forfeit, err := pm.ShouldForfeitDeposit(ctx, types.ContentCensorship, proposalID, totalLinoStake)
+		if err != nil {
+			t.Errorf("%s: failed to check deposit, got err %v", tc.testName, err)
+		}
+		if forfeit != tc.wantForfeit {
+			t.Errorf("%s: diff forfeit, got %v, want %v", tc.testName, forfeit, tc.wantForfeit)
+		}
+	}
+}
+
 func TestGetProposalPassParam(t *testing.T) {
 	ctx, _, pm, _, _, _, _ := setupTest(t, 0)
 
@@ -293,3 +450,116 @@ func TestGetProposalPassParam(t *testing.T) {
 	}
 
 }
+
+func TestGetProposalQuorum(t *testing.T) {
+	ctx, _, pm, _, _, _, _ := setupTest(t, 0)
+
+	proposalParam, _ := pm.paramHolder.GetProposalParam(ctx)
+	totalLinoStake := types.NewCoinFromInt64(1000000 * types.Decimals)
+	testCases := []struct {
+		testName     string
+		proposalType types.ProposalType
+		wantError    sdk.Error
+		wantQuorum   types.Coin
+	}{
+		{
+			testName:     "quorum for changeParamProposal",
+			proposalType: types.ChangeParam,
+			wantError:    nil,
+			wantQuorum:   types.RatToCoin(totalLinoStake.ToRat().Mul(proposalParam.ChangeParamQuorumRatio)),
+		},
+		{
+			testName:     "quorum for contentAppealProposal",
+			proposalType: types.ContentAppeal,
+			wantError:    nil,
+			wantQuorum:   types.RatToCoin(totalLinoStake.ToRat().Mul(proposalParam.ContentCensorshipQuorumRatio)),
+		},
+		{
+			testName:     "quorum for textProposal",
+			proposalType: types.TextProposal,
+			wantError:    nil,
+			wantQuorum:   types.RatToCoin(totalLinoStake.ToRat().Mul(proposalParam.ProtocolUpgradeQuorumRatio)),
+		},
+		{
+			testName:     "wrong proposal type",
+			proposalType: 23,
+			wantError:    ErrIncorrectProposalType(),
+			wantQuorum:   types.NewCoinFromInt64(0),
+		},
+	}
+	for _, tc := range testCases {
+		quorum, err := pm.GetProposalQuorum(ctx, tc.proposalType, totalLinoStake)
+		if !assert.Equal(t, tc.wantError, err) {
+			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.wantError)
+		}
+		if !quorum.IsEqual(tc.wantQuorum) {
+			t.Errorf("%s: diff quorum, got %v, want %v", tc.testName, quorum, tc.wantQuorum)
+		}
+	}
+}
+
+func TestScheduleUpgrade(t *testing.T) {
+	ctx, _, pm, _, _, _, _ := setupTest(t, 5)
+	pm.InitGenesis(ctx)
+	user1 := types.AccountKey("user1")
+
+	testCases := []struct {
+		testName string
+		proposal model.Proposal
+		wantErr  sdk.Error
+		wantPlan *model.UpgradePlan
+	}{
+		{
+			testName: "schedule upgrade",
+			proposal: pm.CreateProtocolUpgradeProposal(ctx, "v2", 100, "link", ""),
+			wantErr:  nil,
+			wantPlan: &model.UpgradePlan{Name: "v2", Height: 100, ProposalID: "1"},
+		},
+		{
+			testName: "upgrade height already passed is dropped",
+			proposal: pm.CreateProtocolUpgradeProposal(ctx, "v3", 5, "link", ""),
+			wantErr:  nil,
+			wantPlan: &model.UpgradePlan{Name: "v2", Height: 100, ProposalID: "1"},
+		},
+		{
+			testName: "upgrade without name is dropped",
+			proposal: &model.ProtocolUpgradeProposal{Link: "link"},
+			wantErr:  nil,
+			wantPlan: &model.UpgradePlan{Name: "v2", Height: 100, ProposalID: "1"},
+		},
+		{
+			testName: "later upgrade replaces previous plan",
+			proposal: pm.CreateProtocolUpgradeProposal(ctx, "v4", 200, "link", ""),
+			wantErr:  nil,
+			wantPlan: &model.UpgradePlan{Name: "v4", Height: 200, ProposalID: "4"},
+		},
+		{
+			testName: "incorrect proposal type",
+			proposal: pm.CreateTextProposal(ctx, "text"),
+			wantErr:  ErrIncorrectProposalType(),
+			wantPlan: &model.UpgradePlan{Name: "v4", Height: 200, ProposalID: "4"},
+		},
+	}
+	for _, tc := range testCases {
+		proposalID, err := pm.AddProposal(ctx, user1, tc.proposal, 10)
+		assert.Nil(t, err)
+		_, err = pm.UpdateProposalStatus(ctx, types.ProtocolUpgrade, proposalID, types.NewCoinFromInt64(0))
+		assert.Nil(t, err)
+
+		err = pm.ScheduleUpgrade(ctx, proposalID)
+		if !assert.Equal(t, tc.wantErr, err) {
+			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.wantErr)
+		}
+		plan, err := pm.GetUpgradePlan(ctx)
+		assert.Nil(t, err)
+		if !assert.Equal(t, tc.wantPlan, plan) {
+			t.Errorf("%s: diff plan, got %v, want %v", tc.testName, plan, tc.wantPlan)
+		}
+	}
+
+	err := pm.ClearUpgradePlan(ctx)
+	assert.Nil(t, err)
+	plan, err := pm.GetUpgradePlan(ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, plan)
+}
@@ -36,3 +36,33 @@ func ErrFailedToUnmarshalProposal(err error) sdk.Error {
 func ErrFailedToUnmarshalNextProposalID(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalNextProposalID, fmt.Sprintf("failed to unmarshal next proposal id: %s", err.Error()))
 }
+
+// ErrUpgradePlanNotFound - error if upgrade plan is not found in KVStore
+func ErrUpgradePlanNotFound() sdk.Error {
+	return types.NewError(types.CodeUpgradePlanNotFound, fmt.Sprintf("upgrade plan is not found"))
+}
+
+// ErrFailedToMarshalUpgradePlan - error if marshal upgrade plan failed
+func ErrFailedToMarshalUpgradePlan(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalUpgradePlan, fmt.Sprintf("failed to marshal upgrade plan: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalUpgradePlan - error if unmarshal upgrade plan failed
+func ErrFailedToUnmarshalUpgradePlan(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalUpgradePlan, fmt.Sprintf("failed to unmarshal upgrade plan: %s", err.Error()))
+}
+
+// ErrDepositNotFound - error if proposal deposit is not found in KVStore
+func ErrDepositNotFound() sdk.Error {
+	return types.NewError(types.CodeDepositNotFound, fmt.Sprintf("proposal deposit is not found"))
+}
+
+// ErrFailedToMarshalDeposit - error if marshal proposal deposit failed
+func ErrFailedToMarshalDeposit(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalDeposit, fmt.Sprintf("failed to marshal proposal deposit: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalDeposit - error if unmarshal proposal deposit failed
+func ErrFailedToUnmarshalDeposit(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalDeposit, fmt.Sprintf("failed to unmarshal proposal deposit: %s", err.Error()))
+}
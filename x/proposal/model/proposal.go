@@ -5,10 +5,13 @@ import (
 	types "github.com/lino-network/lino/types"
 )
 
-// Proposal - there are three proposal types
+// Proposal - there are six proposal types
 // 1) change parameter proposal
 // 2) content censorship proposal
 // 3) protocol upgrade proposal
+// 4) content appeal proposal
+// 5) text proposal
+// 6) community pool spend proposal
 type Proposal interface {
 	GetProposalInfo() ProposalInfo
 	SetProposalInfo(ProposalInfo)
@@ -20,6 +23,8 @@ type ProposalInfo struct {
 	ProposalID    types.ProposalKey    `json:"proposal_id"`
 	AgreeVotes    types.Coin           `json:"agree_vote"`
 	DisagreeVotes types.Coin           `json:"disagree_vote"`
+	AbstainVotes  types.Coin           `json:"abstain_vote"`
+	VetoVotes     types.Coin           `json:"veto_vote"`
 	Result        types.ProposalResult `json:"result"`
 	CreatedAt     int64                `json:"created_at"`
 	ExpiredAt     int64                `json:"expired_at"`
@@ -52,9 +57,25 @@ func (p *ContentCensorshipProposal) GetProposalInfo() ProposalInfo { return p.Pr
 // SetProposalInfo - implements Proposal
 func (p *ContentCensorshipProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
 
-// ProtocolUpgradeProposal - protocol upgrade proposal
+// ContentAppealProposal - author's appeal against hiding or censorship of a post
+type ContentAppealProposal struct {
+	ProposalInfo
+	Permlink types.Permlink `json:"permlink"`
+	Reason   string         `json:"reason"`
+}
+
+// GetProposalInfo - implements Proposal
+func (p *ContentAppealProposal) GetProposalInfo() ProposalInfo { return p.ProposalInfo }
+
+// SetProposalInfo - implements Proposal
+func (p *ContentAppealProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
+
+// ProtocolUpgradeProposal - protocol upgrade proposal, chain halts at height
+// unless binary has upgrade handler registered under name
 type ProtocolUpgradeProposal struct {
 	ProposalInfo
+	Name   string `json:"name"`
+	Height int64  `json:"height"`
 	Link   string `json:"link"`
 	Reason string `json:"reason"`
 }
@@ -65,6 +86,52 @@ func (p *ProtocolUpgradeProposal) GetProposalInfo() ProposalInfo { return p.Prop
 // SetProposalInfo - implements Proposal
 func (p *ProtocolUpgradeProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
 
+// TextProposal - signaling proposal without on-chain effect
+type TextProposal struct {
+	ProposalInfo
+	Reason string `json:"reason"`
+}
+
+// GetProposalInfo - implements Proposal
+func (p *TextProposal) GetProposalInfo() ProposalInfo { return p.ProposalInfo }
+
+// SetProposalInfo - implements Proposal
+func (p *TextProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
+
+// CommunityPoolSpendProposal - transfer coin from community pool to recipient
+type CommunityPoolSpendProposal struct {
+	ProposalInfo
+	Recipient types.AccountKey `json:"recipient"`
+	Amount    types.Coin       `json:"amount"`
+	Reason    string           `json:"reason"`
+}
+
+// GetProposalInfo - implements Proposal
+func (p *CommunityPoolSpendProposal) GetProposalInfo() ProposalInfo { return p.ProposalInfo }
+
+// SetProposalInfo - implements Proposal
+func (p *CommunityPoolSpendProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
+
+// UpgradePlan - protocol upgrade scheduled by passed proposal
+type UpgradePlan struct {
+	Name       string            `json:"name"`
+	Height     int64             `json:"height"`
+	ProposalID types.ProposalKey `json:"proposal_id"`
+}
+
+// Deposit - coin deposited by an account to sponsor a proposal
+type Deposit struct {
+	Depositor types.AccountKey `json:"depositor"`
+	Amount    types.Coin       `json:"amount"`
+}
+
+// ProposalDeposit - all deposits on a proposal, returned to depositors
+// or forfeited when the proposal is decided
+type ProposalDeposit struct {
+	Deposits []Deposit  `json:"deposits"`
+	Total    types.Coin `json:"total"`
+}
+
 // NextProposalID - store next proposal ID to KVStore
 type NextProposalID struct {
 	NextProposalID int64 `json:"next_proposal_id"`
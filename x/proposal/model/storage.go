@@ -11,6 +11,9 @@ var (
 	nextProposalIDSubstore  = []byte{0x00}
 	ongoingProposalSubStore = []byte{0x01}
 	expiredProposalSubStore = []byte{0x02}
+	upgradePlanSubstore     = []byte{0x03}
+	pendingProposalSubStore = []byte{0x04}
+	depositSubstore         = []byte{0x05}
 )
 
 // ProposalStorage - proposal storage
@@ -26,6 +29,9 @@ func NewProposalStorage(key sdk.StoreKey) ProposalStorage {
 	cdc.RegisterConcrete(&ChangeParamProposal{}, "changeParam", nil)
 	cdc.RegisterConcrete(&ProtocolUpgradeProposal{}, "upgrade", nil)
 	cdc.RegisterConcrete(&ContentCensorshipProposal{}, "censorship", nil)
+	cdc.RegisterConcrete(&ContentAppealProposal{}, "appeal", nil)
+	cdc.RegisterConcrete(&TextProposal{}, "text", nil)
+	cdc.RegisterConcrete(&CommunityPoolSpendProposal{}, "communityPoolSpend", nil)
 
 	cdc.RegisterInterface((*param.Parameter)(nil), nil)
 	cdc.RegisterConcrete(param.GlobalAllocationParam{}, "allocation", nil)
@@ -39,6 +45,7 @@ func NewProposalStorage(key sdk.StoreKey) ProposalStorage {
 	cdc.RegisterConcrete(param.BandwidthParam{}, "bandwidthParam", nil)
 	cdc.RegisterConcrete(param.AccountParam{}, "accountParam", nil)
 	cdc.RegisterConcrete(param.PostParam{}, "postParam", nil)
+	cdc.RegisterConcrete(param.ReputationParam{}, "reputationParam", nil)
 
 	wire.RegisterCrypto(cdc)
 	vs := ProposalStorage{
@@ -62,7 +69,40 @@ func (ps ProposalStorage) InitGenesis(ctx sdk.Context) sdk.Error {
 // DoesProposalExist - check if proposal exists in KVStore or not
 func (ps ProposalStorage) DoesProposalExist(ctx sdk.Context, proposalID types.ProposalKey) bool {
 	store := ctx.KVStore(ps.key)
-	return store.Has(GetOngoingProposalKey(proposalID)) || store.Has(GetExpiredProposalKey(proposalID))
+	return store.Has(GetOngoingProposalKey(proposalID)) || store.Has(GetExpiredProposalKey(proposalID)) ||
+		store.Has(GetPendingProposalKey(proposalID))
+}
+
+// GetPendingProposal - get proposal from pending proposal KVStore
+func (ps ProposalStorage) GetPendingProposal(ctx sdk.Context, proposalID types.ProposalKey) (Proposal, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	proposalByte := store.Get(GetPendingProposalKey(proposalID))
+	if proposalByte == nil {
+		return nil, ErrProposalNotFound()
+	}
+	proposal := new(Proposal)
+	if err := ps.cdc.UnmarshalJSON(proposalByte, proposal); err != nil {
+		return nil, ErrFailedToUnmarshalProposal(err)
+	}
+	return *proposal, nil
+}
+
+// SetPendingProposal - set proposal to pending proposal KVStore
+func (ps ProposalStorage) SetPendingProposal(ctx sdk.Context, proposalID types.ProposalKey, proposal Proposal) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	proposalByte, err := ps.cdc.MarshalJSON(proposal)
+	if err != nil {
+		return ErrFailedToMarshalProposal(err)
+	}
+	store.Set(GetPendingProposalKey(proposalID), proposalByte)
+	return nil
+}
+
+// DeletePendingProposal - delete proposal from pending proposal KVStore
+func (ps ProposalStorage) DeletePendingProposal(ctx sdk.Context, proposalID types.ProposalKey) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	store.Delete(GetPendingProposalKey(proposalID))
+	return nil
 }
 
 // GetOngoingProposal - get proposal from ongoing proposal KVStore
@@ -194,6 +234,82 @@ func (ps ProposalStorage) SetNextProposalID(ctx sdk.Context, nextProposalID *Nex
 	return nil
 }
 
+// DoesUpgradePlanExist - check if upgrade plan exists in KVStore or not
+func (ps ProposalStorage) DoesUpgradePlanExist(ctx sdk.Context) bool {
+	store := ctx.KVStore(ps.key)
+	return store.Has(GetUpgradePlanKey())
+}
+
+// GetUpgradePlan - get scheduled upgrade plan from KVStore
+func (ps ProposalStorage) GetUpgradePlan(ctx sdk.Context) (*UpgradePlan, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	planByte := store.Get(GetUpgradePlanKey())
+	if planByte == nil {
+		return nil, ErrUpgradePlanNotFound()
+	}
+	plan := new(UpgradePlan)
+	if err := ps.cdc.UnmarshalJSON(planByte, plan); err != nil {
+		return nil, ErrFailedToUnmarshalUpgradePlan(err)
+	}
+	return plan, nil
+}
+
+// SetUpgradePlan - set scheduled upgrade plan to KVStore
+func (ps ProposalStorage) SetUpgradePlan(ctx sdk.Context, plan *UpgradePlan) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	planByte, err := ps.cdc.MarshalJSON(*plan)
+	if err != nil {
+		return ErrFailedToMarshalUpgradePlan(err)
+	}
+	store.Set(GetUpgradePlanKey(), planByte)
+	return nil
+}
+
+// DeleteUpgradePlan - delete scheduled upgrade plan from KVStore
+func (ps ProposalStorage) DeleteUpgradePlan(ctx sdk.Context) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	store.Delete(GetUpgradePlanKey())
+	return nil
+}
+
+// DoesDepositExist - check if proposal deposit exists in KVStore or not
+func (ps ProposalStorage) DoesDepositExist(ctx sdk.Context, proposalID types.ProposalKey) bool {
+	store := ctx.KVStore(ps.key)
+	return store.Has(GetDepositKey(proposalID))
+}
+
+// GetDeposit - get proposal deposit from KVStore
+func (ps ProposalStorage) GetDeposit(ctx sdk.Context, proposalID types.ProposalKey) (*ProposalDeposit, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	depositByte := store.Get(GetDepositKey(proposalID))
+	if depositByte == nil {
+		return nil, ErrDepositNotFound()
+	}
+	deposit := new(ProposalDeposit)
+	if err := ps.cdc.UnmarshalJSON(depositByte, deposit); err != nil {
+		return nil, ErrFailedToUnmarshalDeposit(err)
+	}
+	return deposit, nil
+}
+
+// SetDeposit - set proposal deposit to KVStore
+func (ps ProposalStorage) SetDeposit(ctx sdk.Context, proposalID types.ProposalKey, deposit *ProposalDeposit) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	depositByte, err := ps.cdc.MarshalJSON(*deposit)
+	if err != nil {
+		return ErrFailedToMarshalDeposit(err)
+	}
+	store.Set(GetDepositKey(proposalID), depositByte)
+	return nil
+}
+
+// DeleteDeposit - delete proposal deposit from KVStore
+func (ps ProposalStorage) DeleteDeposit(ctx sdk.Context, proposalID types.ProposalKey) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	store.Delete(GetDepositKey(proposalID))
+	return nil
+}
+
 // GetOngoingProposalKey - "ongoing proposal substore" + "proposal ID"
 func GetOngoingProposalKey(proposalID types.ProposalKey) []byte {
 	return append(ongoingProposalSubStore, proposalID...)
@@ -204,6 +320,21 @@ func GetExpiredProposalKey(proposalID types.ProposalKey) []byte {
 	return append(expiredProposalSubStore, proposalID...)
 }
 
+// GetPendingProposalKey - "pending proposal subStore" + "proposal ID"
+func GetPendingProposalKey(proposalID types.ProposalKey) []byte {
+	return append(pendingProposalSubStore, proposalID...)
+}
+
+// GetDepositKey - "deposit substore" + "proposal ID"
+func GetDepositKey(proposalID types.ProposalKey) []byte {
+	return append(depositSubstore, proposalID...)
+}
+
+// GetUpgradePlanKey - "upgrade plan substore"
+func GetUpgradePlanKey() []byte {
+	return upgradePlanSubstore
+}
+
 func getNextProposalIDKey() []byte {
 	return nextProposalIDSubstore
 }
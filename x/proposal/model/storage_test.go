@@ -41,6 +41,8 @@ func TestProposalList(t *testing.T) {
 			ProposalID:    types.ProposalKey("123"),
 			AgreeVotes:    types.NewCoinFromInt64(0),
 			DisagreeVotes: types.NewCoinFromInt64(0),
+			AbstainVotes:  types.NewCoinFromInt64(0),
+			VetoVotes:     types.NewCoinFromInt64(0),
 		},
 		Param: param.GlobalAllocationParam{
 			GlobalGrowthRate:         sdk.NewRat(98, 1000),
@@ -48,6 +50,7 @@ func TestProposalList(t *testing.T) {
 			ContentCreatorAllocation: sdk.NewRat(0),
 			DeveloperAllocation:      sdk.NewRat(0),
 			ValidatorAllocation:      sdk.NewRat(0),
+			CommunityPoolAllocation:  sdk.NewRat(0),
 		},
 	}
 
@@ -86,6 +89,8 @@ func TestProposal(t *testing.T) {
 					ProposalID:    proposalID,
 					AgreeVotes:    types.NewCoinFromInt64(0),
 					DisagreeVotes: types.NewCoinFromInt64(0),
+					AbstainVotes:  types.NewCoinFromInt64(0),
+					VetoVotes:     types.NewCoinFromInt64(0),
 					Result:        res,
 					CreatedAt:     curTime,
 					ExpiredAt:     curTime + 100,
@@ -96,6 +101,7 @@ func TestProposal(t *testing.T) {
 					ContentCreatorAllocation: sdk.NewRat(0),
 					DeveloperAllocation:      sdk.NewRat(0),
 					ValidatorAllocation:      sdk.NewRat(0),
+					CommunityPoolAllocation:  sdk.NewRat(0),
 				},
 			},
 		},
@@ -171,3 +177,31 @@ func TestNextProposalID(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, nextProposalID, id)
 }
+
+func TestDeposit(t *testing.T) {
+	ctx, ps := setup(t)
+	proposalID := types.ProposalKey("1")
+
+	assert.False(t, ps.DoesDepositExist(ctx, proposalID))
+	_, err := ps.GetDeposit(ctx, proposalID)
+	assert.Equal(t, ErrDepositNotFound(), err)
+
+	deposit := &ProposalDeposit{
+		Deposits: []Deposit{
+			{Depositor: types.AccountKey("user1"), Amount: types.NewCoinFromInt64(1)},
+			{Depositor: types.AccountKey("user2"), Amount: types.NewCoinFromInt64(2)},
+		},
+		Total: types.NewCoinFromInt64(3),
+	}
+	err = ps.SetDeposit(ctx, proposalID, deposit)
+	assert.Nil(t, err)
+	assert.True(t, ps.DoesDepositExist(ctx, proposalID))
+
+	res, err := ps.GetDeposit(ctx, proposalID)
+	assert.Nil(t, err)
+	assert.Equal(t, deposit, res)
+
+	err = ps.DeleteDeposit(ctx, proposalID)
+	assert.Nil(t, err)
+	assert.False(t, ps.DoesDepositExist(ctx, proposalID))
+}
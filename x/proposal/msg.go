@@ -11,7 +11,10 @@ import (
 )
 
 var _ types.Msg = DeletePostContentMsg{}
+var _ types.Msg = AppealPostContentMsg{}
 var _ types.Msg = UpgradeProtocolMsg{}
+var _ types.Msg = SubmitTextMsg{}
+var _ types.Msg = SpendCommunityPoolMsg{}
 var _ types.Msg = ChangeGlobalAllocationParamMsg{}
 var _ types.Msg = ChangeEvaluateOfContentValueParamMsg{}
 var _ types.Msg = ChangeInfraInternalAllocationParamMsg{}
@@ -22,7 +25,11 @@ var _ types.Msg = ChangeValidatorParamMsg{}
 var _ types.Msg = ChangeBandwidthParamMsg{}
 var _ types.Msg = ChangeAccountParamMsg{}
 var _ types.Msg = ChangePostParamMsg{}
+var _ types.Msg = ChangeCoinDayParamMsg{}
+var _ types.Msg = ChangeReputationParamMsg{}
 var _ types.Msg = VoteProposalMsg{}
+var _ types.Msg = WithdrawProposalVoteMsg{}
+var _ types.Msg = DepositProposalMsg{}
 
 var _ ChangeParamMsg = ChangeGlobalAllocationParamMsg{}
 var _ ChangeParamMsg = ChangeEvaluateOfContentValueParamMsg{}
@@ -34,11 +41,19 @@ var _ ChangeParamMsg = ChangeValidatorParamMsg{}
 var _ ChangeParamMsg = ChangeBandwidthParamMsg{}
 var _ ChangeParamMsg = ChangeAccountParamMsg{}
 var _ ChangeParamMsg = ChangePostParamMsg{}
+var _ ChangeParamMsg = ChangeCoinDayParamMsg{}
+var _ ChangeParamMsg = ChangeReputationParamMsg{}
 
 var _ ContentCensorshipMsg = DeletePostContentMsg{}
 
+var _ ContentAppealMsg = AppealPostContentMsg{}
+
 var _ ProtocolUpgradeMsg = UpgradeProtocolMsg{}
 
+var _ TextProposalMsg = SubmitTextMsg{}
+
+var _ CommunityPoolSpendMsg = SpendCommunityPoolMsg{}
+
 // ChangeParamMsg - change parameter msg
 type ChangeParamMsg interface {
 	GetParameter() param.Parameter
@@ -53,13 +68,36 @@ type ContentCensorshipMsg interface {
 	GetReason() string
 }
 
+// ContentAppealMsg - content appeal msg
+type ContentAppealMsg interface {
+	GetCreator() types.AccountKey
+	GetPermlink() types.Permlink
+	GetReason() string
+}
+
 // ProtocolUpgradeMsg - protocol upgrade msg
 type ProtocolUpgradeMsg interface {
 	GetCreator() types.AccountKey
+	GetName() string
+	GetHeight() int64
 	GetLink() string
 	GetReason() string
 }
 
+// TextProposalMsg - text proposal msg
+type TextProposalMsg interface {
+	GetCreator() types.AccountKey
+	GetReason() string
+}
+
+// CommunityPoolSpendMsg - community pool spend msg
+type CommunityPoolSpendMsg interface {
+	GetCreator() types.AccountKey
+	GetRecipient() types.AccountKey
+	GetAmount() types.LNO
+	GetReason() string
+}
+
 // DeletePostContentMsg - implement of content censorship msg
 type DeletePostContentMsg struct {
 	Creator  types.AccountKey `json:"creator"`
@@ -67,13 +105,36 @@ type DeletePostContentMsg struct {
 	Reason   string           `json:"reason"`
 }
 
+// AppealPostContentMsg - implement of content appeal msg
+type AppealPostContentMsg struct {
+	Creator  types.AccountKey `json:"creator"`
+	Permlink types.Permlink   `json:"permlink"`
+	Reason   string           `json:"reason"`
+}
+
 // UpgradeProtocolMsg - implement of protocol upgrade msg
 type UpgradeProtocolMsg struct {
 	Creator types.AccountKey `json:"creator"`
+	Name    string           `json:"name"`
+	Height  int64            `json:"height"`
 	Link    string           `json:"link"`
 	Reason  string           `json:"reason"`
 }
 
+// SubmitTextMsg - implement of text proposal msg
+type SubmitTextMsg struct {
+	Creator types.AccountKey `json:"creator"`
+	Reason  string           `json:"reason"`
+}
+
+// SpendCommunityPoolMsg - implement of community pool spend msg
+type SpendCommunityPoolMsg struct {
+	Creator   types.AccountKey `json:"creator"`
+	Recipient types.AccountKey `json:"recipient"`
+	Amount    types.LNO        `json:"amount"`
+	Reason    string           `json:"reason"`
+}
+
 // ChangeGlobalAllocationParamMsg - implement of change parameter msg
 type ChangeGlobalAllocationParamMsg struct {
 	Creator   types.AccountKey            `json:"creator"`
@@ -144,11 +205,173 @@ type ChangePostParamMsg struct {
 	Reason    string           `json:"reason"`
 }
 
+// ChangeCoinDayParamMsg - implement of change parameter msg
+type ChangeCoinDayParamMsg struct {
+	Creator   types.AccountKey   `json:"creator"`
+	Parameter param.CoinDayParam `json:"parameter"`
+	Reason    string             `json:"reason"`
+}
+
+// ChangeReputationParamMsg - implement of change parameter msg
+type ChangeReputationParamMsg struct {
+	Creator   types.AccountKey      `json:"creator"`
+	Parameter param.ReputationParam `json:"parameter"`
+	Reason    string                `json:"reason"`
+}
+
 // VoteProposalMsg - implement of change parameter msg
 type VoteProposalMsg struct {
 	Voter      types.AccountKey  `json:"voter"`
 	ProposalID types.ProposalKey `json:"proposal_id"`
 	Result     bool              `json:"result"`
+	Abstain    bool              `json:"abstain"`
+	Veto       bool              `json:"veto"`
+}
+
+// WithdrawProposalVoteMsg - withdraw vote from an ongoing proposal
+type WithdrawProposalVoteMsg struct {
+	Voter      types.AccountKey  `json:"voter"`
+	ProposalID types.ProposalKey `json:"proposal_id"`
+}
+
+// DepositProposalMsg - add deposit to a proposal which is collecting min deposit
+type DepositProposalMsg struct {
+	Depositor  types.AccountKey  `json:"depositor"`
+	ProposalID types.ProposalKey `json:"proposal_id"`
+	Amount     types.LNO         `json:"amount"`
+}
+
+//----------------------------------------
+// SubmitTextMsg Msg Implementations
+
+func NewSubmitTextMsg(creator, reason string) SubmitTextMsg {
+	return SubmitTextMsg{
+		Creator: types.AccountKey(creator),
+		Reason:  reason,
+	}
+}
+
+// GetCreator - implement SubmitTextMsg
+func (msg SubmitTextMsg) GetCreator() types.AccountKey { return msg.Creator }
+
+// GetReason - implement SubmitTextMsg
+func (msg SubmitTextMsg) GetReason() string { return msg.Reason }
+
+// Type - implement sdk.Msg
+func (msg SubmitTextMsg) Type() string { return types.ProposalRouterName }
+
+// ValidateBasic - implement sdk.Msg
+func (msg SubmitTextMsg) ValidateBasic() sdk.Error {
+	if len(msg.Creator) < types.MinimumUsernameLength ||
+		len(msg.Creator) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
+		return ErrReasonTooLong()
+	}
+	return nil
+}
+
+func (msg SubmitTextMsg) String() string {
+	return fmt.Sprintf("SubmitTextMsg{Creator:%v, Reason:%v}", msg.Creator, msg.Reason)
+}
+
+// GetPermission - implement types.Msg
+func (msg SubmitTextMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg SubmitTextMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg SubmitTextMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg SubmitTextMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+//----------------------------------------
+// SpendCommunityPoolMsg Msg Implementations
+
+func NewSpendCommunityPoolMsg(
+	creator, recipient string, amount types.LNO, reason string) SpendCommunityPoolMsg {
+	return SpendCommunityPoolMsg{
+		Creator:   types.AccountKey(creator),
+		Recipient: types.AccountKey(recipient),
+		Amount:    amount,
+		Reason:    reason,
+	}
+}
+
+// GetCreator - implement SpendCommunityPoolMsg
+func (msg SpendCommunityPoolMsg) GetCreator() types.AccountKey { return msg.Creator }
+
+// GetRecipient - implement SpendCommunityPoolMsg
+func (msg SpendCommunityPoolMsg) GetRecipient() types.AccountKey { return msg.Recipient }
+
+// GetAmount - implement SpendCommunityPoolMsg
+func (msg SpendCommunityPoolMsg) GetAmount() types.LNO { return msg.Amount }
+
+// GetReason - implement SpendCommunityPoolMsg
+func (msg SpendCommunityPoolMsg) GetReason() string { return msg.Reason }
+
+// Type - implement sdk.Msg
+func (msg SpendCommunityPoolMsg) Type() string { return types.ProposalRouterName }
+
+// ValidateBasic - implement sdk.Msg
+func (msg SpendCommunityPoolMsg) ValidateBasic() sdk.Error {
+	if len(msg.Creator) < types.MinimumUsernameLength ||
+		len(msg.Creator) > types.MaximumUsernameLength ||
+		len(msg.Recipient) < types.MinimumUsernameLength ||
+		len(msg.Recipient) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if _, err := types.LinoToCoin(msg.Amount); err != nil {
+		return err
+	}
+	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
+		return ErrReasonTooLong()
+	}
+	return nil
+}
+
+func (msg SpendCommunityPoolMsg) String() string {
+	return fmt.Sprintf("SpendCommunityPoolMsg{Creator:%v, Recipient:%v, Amount:%v}",
+		msg.Creator, msg.Recipient, msg.Amount)
+}
+
+// GetPermission - implement types.Msg
+func (msg SpendCommunityPoolMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg SpendCommunityPoolMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg SpendCommunityPoolMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg SpendCommunityPoolMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
 }
 
 //----------------------------------------
@@ -218,13 +441,82 @@ func (msg DeletePostContentMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
 
+//----------------------------------------
+// AppealPostContentMsg Msg Implementations
+
+func NewAppealPostContentMsg(
+	creator string, permlink types.Permlink, reason string) AppealPostContentMsg {
+	return AppealPostContentMsg{
+		Creator:  types.AccountKey(creator),
+		Permlink: permlink,
+		Reason:   reason,
+	}
+}
+
+// GetPermlink - implement AppealPostContentMsg
+func (msg AppealPostContentMsg) GetPermlink() types.Permlink { return msg.Permlink }
+
+// GetCreator - implement AppealPostContentMsg
+func (msg AppealPostContentMsg) GetCreator() types.AccountKey { return msg.Creator }
+
+// GetReason - implement AppealPostContentMsg
+func (msg AppealPostContentMsg) GetReason() string { return msg.Reason }
+
+// Type - implement sdk.Msg
+func (msg AppealPostContentMsg) Type() string { return types.ProposalRouterName }
+
+// ValidateBasic - implement sdk.Msg
+func (msg AppealPostContentMsg) ValidateBasic() sdk.Error {
+	if len(msg.Creator) < types.MinimumUsernameLength ||
+		len(msg.Creator) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if len(msg.GetPermlink()) == 0 {
+		return ErrInvalidPermlink()
+	}
+	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
+		return ErrReasonTooLong()
+	}
+	return nil
+}
+
+func (msg AppealPostContentMsg) String() string {
+	return fmt.Sprintf("AppealPostContentMsg{Creator:%v, post:%v}", msg.Creator, msg.GetPermlink())
+}
+
+// GetPermission - implement types.Msg
+func (msg AppealPostContentMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg AppealPostContentMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg AppealPostContentMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg AppealPostContentMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
 //----------------------------------------
 // UpgradeProtocolMsg Msg Implementations
 
 func NewUpgradeProtocolMsg(
-	creator, link, reason string) UpgradeProtocolMsg {
+	creator, name string, height int64, link, reason string) UpgradeProtocolMsg {
 	return UpgradeProtocolMsg{
 		Creator: types.AccountKey(creator),
+		Name:    name,
+		Height:  height,
 		Link:    link,
 		Reason:  reason,
 	}
@@ -233,6 +525,12 @@ func NewUpgradeProtocolMsg(
 // GetCreator - implement UpgradeProtocolMsg
 func (msg UpgradeProtocolMsg) GetCreator() types.AccountKey { return msg.Creator }
 
+// GetName - implement UpgradeProtocolMsg
+func (msg UpgradeProtocolMsg) GetName() string { return msg.Name }
+
+// GetHeight - implement UpgradeProtocolMsg
+func (msg UpgradeProtocolMsg) GetHeight() int64 { return msg.Height }
+
 // GetLink - implement UpgradeProtocolMsg
 func (msg UpgradeProtocolMsg) GetLink() string { return msg.Link }
 
@@ -248,6 +546,12 @@ func (msg UpgradeProtocolMsg) ValidateBasic() sdk.Error {
 		len(msg.Creator) > types.MaximumUsernameLength {
 		return ErrInvalidUsername()
 	}
+	if len(msg.Name) == 0 || len(msg.Name) > types.MaximumUpgradeNameLength {
+		return ErrInvalidUpgradeName()
+	}
+	if msg.Height <= 0 {
+		return ErrInvalidUpgradeHeight(msg.Height)
+	}
 	if len(msg.GetLink()) == 0 {
 		return ErrInvalidLink()
 	}
@@ -261,7 +565,8 @@ func (msg UpgradeProtocolMsg) ValidateBasic() sdk.Error {
 }
 
 func (msg UpgradeProtocolMsg) String() string {
-	return fmt.Sprintf("UpgradeProtocolMsg{Creator:%v, Link:%v}", msg.Creator, msg.GetLink())
+	return fmt.Sprintf("UpgradeProtocolMsg{Creator:%v, Name:%v, Height:%v, Link:%v}",
+		msg.Creator, msg.Name, msg.Height, msg.GetLink())
 }
 
 // GetPermission - implement types.Msg
@@ -322,13 +627,15 @@ func (msg ChangeGlobalAllocationParamMsg) ValidateBasic() sdk.Error {
 	if !msg.Parameter.InfraAllocation.
 		Add(msg.Parameter.ContentCreatorAllocation).
 		Add(msg.Parameter.DeveloperAllocation).
-		Add(msg.Parameter.ValidatorAllocation).Equal(sdk.NewRat(1)) {
+		Add(msg.Parameter.ValidatorAllocation).
+		Add(msg.Parameter.CommunityPoolAllocation).Equal(sdk.NewRat(1)) {
 		return ErrIllegalParameter()
 	}
 	if msg.Parameter.InfraAllocation.LT(sdk.ZeroRat()) ||
 		msg.Parameter.ContentCreatorAllocation.LT(sdk.ZeroRat()) ||
 		msg.Parameter.DeveloperAllocation.LT(sdk.ZeroRat()) ||
-		msg.Parameter.ValidatorAllocation.LT(sdk.ZeroRat()) {
+		msg.Parameter.ValidatorAllocation.LT(sdk.ZeroRat()) ||
+		msg.Parameter.CommunityPoolAllocation.LT(sdk.ZeroRat()) {
 		return ErrIllegalParameter()
 	}
 	if msg.Parameter.GlobalGrowthRate.GT(param.AnnualInflationCeiling) {
@@ -543,7 +850,16 @@ func (msg ChangeVoteParamMsg) ValidateBasic() sdk.Error {
 	if msg.Parameter.DelegatorCoinReturnIntervalSec <= 0 ||
 		msg.Parameter.VoterCoinReturnIntervalSec <= 0 ||
 		msg.Parameter.DelegatorCoinReturnTimes <= 0 ||
-		msg.Parameter.VoterCoinReturnTimes <= 0 {
+		msg.Parameter.VoterCoinReturnTimes <= 0 ||
+		msg.Parameter.RedelegateIntervalSec < 0 ||
+		msg.Parameter.CommissionUpdateIntervalSec < 0 {
+		return ErrIllegalParameter()
+	}
+
+	if msg.Parameter.DefaultCommissionRate.LT(sdk.ZeroRat()) ||
+		msg.Parameter.DefaultCommissionRate.GT(sdk.OneRat()) ||
+		!msg.Parameter.MaxCommissionChangeRate.GT(sdk.ZeroRat()) ||
+		msg.Parameter.MaxCommissionChangeRate.GT(sdk.OneRat()) {
 		return ErrIllegalParameter()
 	}
 
@@ -619,7 +935,8 @@ func (msg ChangeProposalParamMsg) ValidateBasic() sdk.Error {
 	if msg.Parameter.ContentCensorshipDecideSec <= 0 ||
 		msg.Parameter.ChangeParamExecutionSec <= 0 ||
 		msg.Parameter.ChangeParamDecideSec <= 0 ||
-		msg.Parameter.ProtocolUpgradeDecideSec <= 0 {
+		msg.Parameter.ProtocolUpgradeDecideSec <= 0 ||
+		msg.Parameter.DepositPeriodSec <= 0 {
 		return ErrIllegalParameter()
 	}
 
@@ -641,6 +958,29 @@ func (msg ChangeProposalParamMsg) ValidateBasic() sdk.Error {
 		return ErrIllegalParameter()
 	}
 
+	// creator pays a positive part of min deposit, forfeit thresholds are fractions,
+	// zero veto forfeit threshold is reserved for unset parameter
+	if !msg.Parameter.InitialDepositRatio.GT(sdk.ZeroRat()) ||
+		msg.Parameter.InitialDepositRatio.GT(sdk.NewRat(1, 1)) ||
+		msg.Parameter.DepositForfeitQuorumRatio.LT(sdk.ZeroRat()) ||
+		msg.Parameter.DepositForfeitQuorumRatio.GT(sdk.NewRat(1, 1)) ||
+		!msg.Parameter.DepositForfeitVetoRatio.GT(sdk.ZeroRat()) ||
+		msg.Parameter.DepositForfeitVetoRatio.GT(sdk.NewRat(1, 1)) {
+		return ErrIllegalParameter()
+	}
+
+	// quorum is a positive fraction of total stake, veto threshold is a positive fraction of total votes
+	if !msg.Parameter.ContentCensorshipQuorumRatio.GT(sdk.ZeroRat()) ||
+		!msg.Parameter.ChangeParamQuorumRatio.GT(sdk.ZeroRat()) ||
+		!msg.Parameter.ProtocolUpgradeQuorumRatio.GT(sdk.ZeroRat()) ||
+		!msg.Parameter.VetoRatio.GT(sdk.ZeroRat()) ||
+		msg.Parameter.ContentCensorshipQuorumRatio.GT(sdk.NewRat(1, 1)) ||
+		msg.Parameter.ChangeParamQuorumRatio.GT(sdk.NewRat(1, 1)) ||
+		msg.Parameter.ProtocolUpgradeQuorumRatio.GT(sdk.NewRat(1, 1)) ||
+		msg.Parameter.VetoRatio.GT(sdk.NewRat(1, 1)) {
+		return ErrIllegalParameter()
+	}
+
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
 		return ErrReasonTooLong()
 	}
@@ -782,7 +1122,19 @@ func (msg ChangeValidatorParamMsg) ValidateBasic() sdk.Error {
 	if msg.Parameter.ValidatorCoinReturnIntervalSec <= 0 ||
 		msg.Parameter.ValidatorCoinReturnTimes <= 0 ||
 		msg.Parameter.AbsentCommitLimitation <= 0 ||
-		msg.Parameter.ValidatorListSize <= 0 {
+		msg.Parameter.ValidatorListSize <= 0 ||
+		msg.Parameter.AbsentCommitWindow <= msg.Parameter.AbsentCommitLimitation ||
+		msg.Parameter.JailDurationSec <= 0 ||
+		msg.Parameter.MaxJailDurationSec < msg.Parameter.JailDurationSec ||
+		msg.Parameter.KeyUnbondingSec <= 0 ||
+		msg.Parameter.StandbyListSize < 0 ||
+		msg.Parameter.HeartbeatIntervalSec <= 0 ||
+		msg.Parameter.CommissionUpdateIntervalSec < 0 {
+		return ErrIllegalParameter()
+	}
+
+	if msg.Parameter.StandbyInflationRate.LT(sdk.ZeroRat()) ||
+		msg.Parameter.StandbyInflationRate.GT(sdk.OneRat()) {
 		return ErrIllegalParameter()
 	}
 
@@ -934,7 +1286,11 @@ func (msg ChangePostParamMsg) ValidateBasic() sdk.Error {
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
 		return ErrReasonTooLong()
 	}
-	if msg.Parameter.PostIntervalSec < 0 || msg.Parameter.ReportOrUpvoteIntervalSec < 0 {
+	if msg.Parameter.PostIntervalSec < 0 || msg.Parameter.ReportOrUpvoteIntervalSec < 0 ||
+		msg.Parameter.SubscriptionIntervalSec <= 0 || msg.Parameter.AppealPeriodSec < 0 {
+		return ErrIllegalParameter()
+	}
+	if msg.Parameter.HideReportCoinDay.IsNil() || !msg.Parameter.HideReportCoinDay.IsPositive() {
 		return ErrIllegalParameter()
 	}
 	return nil
@@ -1042,6 +1398,143 @@ func (msg ChangeBandwidthParamMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
 
+//----------------------------------------
+// ChangeCoinDayParamMsg Msg Implementations
+
+func NewChangeCoinDayParamMsg(
+	creator string, parameter param.CoinDayParam, reason string) ChangeCoinDayParamMsg {
+	return ChangeCoinDayParamMsg{
+		Creator:   types.AccountKey(creator),
+		Parameter: parameter,
+		Reason:    reason,
+	}
+}
+
+// GetParameter - implement ChangeParamMsg
+func (msg ChangeCoinDayParamMsg) GetParameter() param.Parameter { return msg.Parameter }
+
+// GetCreator - implement ChangeParamMsg
+func (msg ChangeCoinDayParamMsg) GetCreator() types.AccountKey { return msg.Creator }
+
+// GetReason - implement ChangeParamMsg
+func (msg ChangeCoinDayParamMsg) GetReason() string { return msg.Reason }
+
+// Type - implement sdk.Msg
+func (msg ChangeCoinDayParamMsg) Type() string { return types.ProposalRouterName }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ChangeCoinDayParamMsg) ValidateBasic() sdk.Error {
+	if len(msg.Creator) < types.MinimumUsernameLength ||
+		len(msg.Creator) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+
+	if msg.Parameter.SecondsToRecoverCoinDay <= 0 {
+		return ErrIllegalParameter()
+	}
+	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
+		return ErrReasonTooLong()
+	}
+	return nil
+}
+
+func (msg ChangeCoinDayParamMsg) String() string {
+	return fmt.Sprintf("ChangeCoinDayParamMsg{Creator:%v, param:%v}", msg.Creator, msg.Parameter)
+}
+
+// GetPermission - implement types.Msg
+func (msg ChangeCoinDayParamMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ChangeCoinDayParamMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ChangeCoinDayParamMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ChangeCoinDayParamMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+//----------------------------------------
+// ChangeReputationParamMsg Msg Implementations
+
+func NewChangeReputationParamMsg(
+	creator string, parameter param.ReputationParam, reason string) ChangeReputationParamMsg {
+	return ChangeReputationParamMsg{
+		Creator:   types.AccountKey(creator),
+		Parameter: parameter,
+		Reason:    reason,
+	}
+}
+
+// GetParameter - implement ChangeParamMsg
+func (msg ChangeReputationParamMsg) GetParameter() param.Parameter { return msg.Parameter }
+
+// GetCreator - implement ChangeParamMsg
+func (msg ChangeReputationParamMsg) GetCreator() types.AccountKey { return msg.Creator }
+
+// GetReason - implement ChangeParamMsg
+func (msg ChangeReputationParamMsg) GetReason() string { return msg.Reason }
+
+// Type - implement sdk.Msg
+func (msg ChangeReputationParamMsg) Type() string { return types.ProposalRouterName }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ChangeReputationParamMsg) ValidateBasic() sdk.Error {
+	if len(msg.Creator) < types.MinimumUsernameLength ||
+		len(msg.Creator) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+
+	if msg.Parameter.BestContentIndexN <= 0 ||
+		msg.Parameter.BestContentIndexN > types.MaximumBestContentIndexN {
+		return ErrIllegalParameter()
+	}
+	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
+		return ErrReasonTooLong()
+	}
+	return nil
+}
+
+func (msg ChangeReputationParamMsg) String() string {
+	return fmt.Sprintf("ChangeReputationParamMsg{Creator:%v, param:%v}", msg.Creator, msg.Parameter)
+}
+
+// GetPermission - implement types.Msg
+func (msg ChangeReputationParamMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ChangeReputationParamMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ChangeReputationParamMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ChangeReputationParamMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
 //----------------------------------------
 // VoteProposalMsg Msg Implementations
 func NewVoteProposalMsg(voter string, proposalID int64, result bool) VoteProposalMsg {
@@ -1052,6 +1545,24 @@ func NewVoteProposalMsg(voter string, proposalID int64, result bool) VoteProposa
 	}
 }
 
+// NewAbstainVoteProposalMsg - abstain from voting on a proposal
+func NewAbstainVoteProposalMsg(voter string, proposalID int64) VoteProposalMsg {
+	return VoteProposalMsg{
+		Voter:      types.AccountKey(voter),
+		ProposalID: types.ProposalKey(strconv.FormatInt(proposalID, 10)),
+		Abstain:    true,
+	}
+}
+
+// NewVetoVoteProposalMsg - vote no with veto on a proposal
+func NewVetoVoteProposalMsg(voter string, proposalID int64) VoteProposalMsg {
+	return VoteProposalMsg{
+		Voter:      types.AccountKey(voter),
+		ProposalID: types.ProposalKey(strconv.FormatInt(proposalID, 10)),
+		Veto:       true,
+	}
+}
+
 // Type - implement sdk.Msg
 func (msg VoteProposalMsg) Type() string { return types.ProposalRouterName }
 
@@ -1061,11 +1572,15 @@ func (msg VoteProposalMsg) ValidateBasic() sdk.Error {
 		len(msg.Voter) > types.MaximumUsernameLength {
 		return ErrInvalidUsername()
 	}
+	if (msg.Abstain && msg.Result) || (msg.Veto && (msg.Result || msg.Abstain)) {
+		return ErrInvalidVoteOption()
+	}
 	return nil
 }
 
 func (msg VoteProposalMsg) String() string {
-	return fmt.Sprintf("VoteProposalMsg{Voter:%v, ProposalID:%v, Result:%v}", msg.Voter, msg.ProposalID, msg.Result)
+	return fmt.Sprintf("VoteProposalMsg{Voter:%v, ProposalID:%v, Result:%v, Abstain:%v, Veto:%v}",
+		msg.Voter, msg.ProposalID, msg.Result, msg.Abstain, msg.Veto)
 }
 
 // GetPermission - implement types.Msg
@@ -1091,3 +1606,106 @@ func (msg VoteProposalMsg) GetSigners() []sdk.AccAddress {
 func (msg VoteProposalMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+//----------------------------------------
+// WithdrawProposalVoteMsg Msg Implementations
+func NewWithdrawProposalVoteMsg(voter string, proposalID int64) WithdrawProposalVoteMsg {
+	return WithdrawProposalVoteMsg{
+		Voter:      types.AccountKey(voter),
+		ProposalID: types.ProposalKey(strconv.FormatInt(proposalID, 10)),
+	}
+}
+
+// Type - implement sdk.Msg
+func (msg WithdrawProposalVoteMsg) Type() string { return types.ProposalRouterName }
+
+// ValidateBasic - implement sdk.Msg
+func (msg WithdrawProposalVoteMsg) ValidateBasic() sdk.Error {
+	if len(msg.Voter) < types.MinimumUsernameLength ||
+		len(msg.Voter) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	return nil
+}
+
+func (msg WithdrawProposalVoteMsg) String() string {
+	return fmt.Sprintf("WithdrawProposalVoteMsg{Voter:%v, ProposalID:%v}", msg.Voter, msg.ProposalID)
+}
+
+// GetPermission - implement types.Msg
+func (msg WithdrawProposalVoteMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg WithdrawProposalVoteMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg WithdrawProposalVoteMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Voter)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg WithdrawProposalVoteMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+//----------------------------------------
+// DepositProposalMsg Msg Implementations
+func NewDepositProposalMsg(depositor string, proposalID int64, amount types.LNO) DepositProposalMsg {
+	return DepositProposalMsg{
+		Depositor:  types.AccountKey(depositor),
+		ProposalID: types.ProposalKey(strconv.FormatInt(proposalID, 10)),
+		Amount:     amount,
+	}
+}
+
+// Type - implement sdk.Msg
+func (msg DepositProposalMsg) Type() string { return types.ProposalRouterName }
+
+// ValidateBasic - implement sdk.Msg
+func (msg DepositProposalMsg) ValidateBasic() sdk.Error {
+	if len(msg.Depositor) < types.MinimumUsernameLength ||
+		len(msg.Depositor) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if _, err := types.LinoToCoin(msg.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg DepositProposalMsg) String() string {
+	return fmt.Sprintf("DepositProposalMsg{Depositor:%v, ProposalID:%v, Amount:%v}",
+		msg.Depositor, msg.ProposalID, msg.Amount)
+}
+
+// GetPermission - implement types.Msg
+func (msg DepositProposalMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg DepositProposalMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg DepositProposalMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Depositor)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg DepositProposalMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
@@ -56,6 +56,46 @@ func TestVoteProposalMsg(t *testing.T) {
 			voteProposalMsg: NewVoteProposalMsg("", 1, true),
 			expectedError:   ErrInvalidUsername(),
 		},
+		{
+			testName:        "abstain",
+			voteProposalMsg: NewAbstainVoteProposalMsg("user1", 1),
+			expectedError:   nil,
+		},
+		{
+			testName: "agree and abstain at the same time is illegal",
+			voteProposalMsg: VoteProposalMsg{
+				Voter:      types.AccountKey("user1"),
+				ProposalID: types.ProposalKey("1"),
+				Result:     true,
+				Abstain:    true,
+			},
+			expectedError: ErrInvalidVoteOption(),
+		},
+		{
+			testName:        "veto",
+			voteProposalMsg: NewVetoVoteProposalMsg("user1", 1),
+			expectedError:   nil,
+		},
+		{
+			testName: "agree and veto at the same time is illegal",
+			voteProposalMsg: VoteProposalMsg{
+				Voter:      types.AccountKey("user1"),
+				ProposalID: types.ProposalKey("1"),
+				Result:     true,
+				Veto:       true,
+			},
+			expectedError: ErrInvalidVoteOption(),
+		},
+		{
+			testName: "abstain and veto at the same time is illegal",
+			voteProposalMsg: VoteProposalMsg{
+				Voter:      types.AccountKey("user1"),
+				ProposalID: types.ProposalKey("1"),
+				Abstain:    true,
+				Veto:       true,
+			},
+			expectedError: ErrInvalidVoteOption(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -66,6 +106,68 @@ func TestVoteProposalMsg(t *testing.T) {
 	}
 }
 
+func TestWithdrawProposalVoteMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		msg           WithdrawProposalVoteMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			msg:           NewWithdrawProposalVoteMsg("user1", 1),
+			expectedError: nil,
+		},
+		{
+			testName:      "empty username is illegal",
+			msg:           NewWithdrawProposalVoteMsg("", 1),
+			expectedError: ErrInvalidUsername(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestDepositProposalMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		msg           DepositProposalMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			msg:           NewDepositProposalMsg("user1", 1, "10"),
+			expectedError: nil,
+		},
+		{
+			testName:      "empty username is illegal",
+			msg:           NewDepositProposalMsg("", 1, "10"),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "zero amount is illegal",
+			msg:           NewDepositProposalMsg("user1", 1, "0"),
+			expectedError: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+		{
+			testName:      "illegal amount",
+			msg:           NewDepositProposalMsg("user1", 1, "lino"),
+			expectedError: types.ErrInvalidCoins("Illegal LNO"),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestChangeGlobalAllocationParamMsg(t *testing.T) {
 	p1 := param.GlobalAllocationParam{
 		GlobalGrowthRate:         sdk.NewRat(98, 1000),
@@ -73,6 +175,7 @@ func TestChangeGlobalAllocationParamMsg(t *testing.T) {
 		ContentCreatorAllocation: sdk.NewRat(55, 100),
 		DeveloperAllocation:      sdk.NewRat(20, 100),
 		ValidatorAllocation:      sdk.NewRat(5, 100),
+		CommunityPoolAllocation:  sdk.NewRat(0, 100),
 	}
 	p2 := p1
 	p2.DeveloperAllocation = sdk.NewRat(25, 100)
@@ -80,6 +183,14 @@ func TestChangeGlobalAllocationParamMsg(t *testing.T) {
 	p3 := p1
 	p3.GlobalGrowthRate = sdk.NewRat(1, 10)
 
+	p4 := p1
+	p4.DeveloperAllocation = sdk.NewRat(15, 100)
+	p4.CommunityPoolAllocation = sdk.NewRat(5, 100)
+
+	p5 := p1
+	p5.DeveloperAllocation = sdk.NewRat(25, 100)
+	p5.CommunityPoolAllocation = sdk.NewRat(-5, 100)
+
 	testCases := []struct {
 		testName                       string
 		ChangeGlobalAllocationParamMsg ChangeGlobalAllocationParamMsg
@@ -100,6 +211,16 @@ func TestChangeGlobalAllocationParamMsg(t *testing.T) {
 			ChangeGlobalAllocationParamMsg: NewChangeGlobalAllocationParamMsg("user1", p3, ""),
 			expectedError:                  ErrIllegalParameter(),
 		},
+		{
+			testName:                       "allocate inflation to community pool",
+			ChangeGlobalAllocationParamMsg: NewChangeGlobalAllocationParamMsg("user1", p4, ""),
+			expectedError:                  nil,
+		},
+		{
+			testName:                       "negative community pool allocation",
+			ChangeGlobalAllocationParamMsg: NewChangeGlobalAllocationParamMsg("user1", p5, ""),
+			expectedError:                  ErrIllegalParameter(),
+		},
 		{
 			testName:                       "empty username is illegal",
 			ChangeGlobalAllocationParamMsg: NewChangeGlobalAllocationParamMsg("", p1, ""),
@@ -194,6 +315,10 @@ func TestChangeVoteParamMsg(t *testing.T) {
 		VoterCoinReturnTimes:           int64(7),
 		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DelegatorCoinReturnTimes:       int64(7),
+		RedelegateIntervalSec:          int64(24 * 3600),
+		DefaultCommissionRate:          sdk.NewRat(10, 100),
+		MaxCommissionChangeRate:        sdk.NewRat(5, 100),
+		CommissionUpdateIntervalSec:    int64(24 * 3600),
 	}
 
 	p2 := p1
@@ -211,6 +336,18 @@ func TestChangeVoteParamMsg(t *testing.T) {
 	p6 := p1
 	p6.DelegatorCoinReturnTimes = int64(0)
 
+	p7 := p1
+	p7.RedelegateIntervalSec = int64(-1)
+
+	p8 := p1
+	p8.DefaultCommissionRate = sdk.NewRat(101, 100)
+
+	p9 := p1
+	p9.MaxCommissionChangeRate = sdk.ZeroRat()
+
+	p10 := p1
+	p10.CommissionUpdateIntervalSec = int64(-1)
+
 	testCases := []struct {
 		testName           string
 		ChangeVoteParamMsg ChangeVoteParamMsg
@@ -246,6 +383,26 @@ func TestChangeVoteParamMsg(t *testing.T) {
 			ChangeVoteParamMsg: NewChangeVoteParamMsg("user1", p5, ""),
 			expectedError:      ErrIllegalParameter(),
 		},
+		{
+			testName:           "negative RedelegateIntervalSec is illegal",
+			ChangeVoteParamMsg: NewChangeVoteParamMsg("user1", p7, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "DefaultCommissionRate larger than 1 is illegal",
+			ChangeVoteParamMsg: NewChangeVoteParamMsg("user1", p8, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "zero MaxCommissionChangeRate is illegal",
+			ChangeVoteParamMsg: NewChangeVoteParamMsg("user1", p9, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "negative CommissionUpdateIntervalSec is illegal",
+			ChangeVoteParamMsg: NewChangeVoteParamMsg("user1", p10, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
 		{
 			testName:           "empty username is illegal",
 			ChangeVoteParamMsg: NewChangeVoteParamMsg("", p1, ""),
@@ -353,6 +510,16 @@ func TestChangeValidatorParamMsg(t *testing.T) {
 		PenaltyByzantine:               types.NewCoinFromInt64(1000 * types.Decimals),
 		ValidatorListSize:              int64(21),
 		AbsentCommitLimitation:         int64(100),
+		SlashDelegators:                false,
+		AbsentCommitWindow:             int64(1200), // 1h
+		JailDurationSec:                int64(24 * 3600),
+		MaxJailDurationSec:             int64(30 * 24 * 3600),
+		KeyUnbondingSec:                int64(7 * 24 * 3600),
+		WeightedInflation:              false,
+		StandbyListSize:                int64(21),
+		StandbyInflationRate:           sdk.NewRat(5, 100),
+		HeartbeatIntervalSec:           int64(3600),
+		CommissionUpdateIntervalSec:    int64(24 * 3600),
 	}
 
 	p2 := p1
@@ -385,6 +552,30 @@ func TestChangeValidatorParamMsg(t *testing.T) {
 	p11 := p1
 	p11.ValidatorListSize = int64(-1)
 
+	p12 := p1
+	p12.AbsentCommitWindow = p1.AbsentCommitLimitation
+
+	p13 := p1
+	p13.JailDurationSec = int64(0)
+
+	p14 := p1
+	p14.MaxJailDurationSec = p1.JailDurationSec - 1
+
+	p15 := p1
+	p15.KeyUnbondingSec = int64(0)
+
+	p16 := p1
+	p16.StandbyListSize = int64(-1)
+
+	p17 := p1
+	p17.StandbyInflationRate = sdk.NewRat(11, 10)
+
+	p18 := p1
+	p18.HeartbeatIntervalSec = int64(0)
+
+	p19 := p1
+	p19.CommissionUpdateIntervalSec = int64(-1)
+
 	testCases := []struct {
 		testName                string
 		ChangeValidatorParamMsg ChangeValidatorParamMsg
@@ -445,6 +636,46 @@ func TestChangeValidatorParamMsg(t *testing.T) {
 			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p11, ""),
 			expectedError:           ErrIllegalParameter(),
 		},
+		{
+			testName:                "AbsentCommitWindow no larger than AbsentCommitLimitation is illegal",
+			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p12, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
+		{
+			testName:                "zero JailDurationSec is illegal",
+			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p13, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
+		{
+			testName:                "MaxJailDurationSec less than JailDurationSec is illegal",
+			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p14, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
+		{
+			testName:                "zero KeyUnbondingSec is illegal",
+			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p15, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
+		{
+			testName:                "negative StandbyListSize is illegal",
+			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p16, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
+		{
+			testName:                "StandbyInflationRate larger than one is illegal",
+			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p17, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
+		{
+			testName:                "zero HeartbeatIntervalSec is illegal",
+			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p18, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
+		{
+			testName:                "negative CommissionUpdateIntervalSec is illegal",
+			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p19, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
 		{
 			testName:                "empty username is illegal",
 			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("", p1, ""),
@@ -489,6 +720,17 @@ func TestChangeProposalParamMsg(t *testing.T) {
 		ProtocolUpgradePassRatio:  sdk.NewRat(80, 100),
 		ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 		ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+
+		DepositPeriodSec:          int64(24 * 7 * 3600),
+		InitialDepositRatio:       sdk.NewRat(1, 1),
+		DepositForfeitQuorumRatio: sdk.NewRat(10, 100),
+		DepositForfeitVetoRatio:   sdk.NewRat(33, 100),
+		DepositForfeitToValidator: false,
+
+		ContentCensorshipQuorumRatio: sdk.NewRat(10, 100),
+		ChangeParamQuorumRatio:       sdk.NewRat(20, 100),
+		ProtocolUpgradeQuorumRatio:   sdk.NewRat(30, 100),
+		VetoRatio:                    sdk.NewRat(33, 100),
 	}
 
 	p2 := p1
@@ -527,6 +769,36 @@ func TestChangeProposalParamMsg(t *testing.T) {
 	p13 := p1
 	p13.ProtocolUpgradeMinDeposit = types.NewCoinFromInt64(-1000000 * types.Decimals)
 
+	p14 := p1
+	p14.DepositPeriodSec = int64(0)
+
+	p15 := p1
+	p15.InitialDepositRatio = sdk.NewRat(0, 100)
+
+	p16 := p1
+	p16.InitialDepositRatio = sdk.NewRat(101, 100)
+
+	p17 := p1
+	p17.DepositForfeitQuorumRatio = sdk.NewRat(-1, 100)
+
+	p18 := p1
+	p18.DepositForfeitVetoRatio = sdk.NewRat(101, 100)
+
+	p19 := p1
+	p19.ContentCensorshipQuorumRatio = sdk.NewRat(0, 100)
+
+	p20 := p1
+	p20.ChangeParamQuorumRatio = sdk.NewRat(101, 100)
+
+	p21 := p1
+	p21.ProtocolUpgradeQuorumRatio = sdk.NewRat(-1, 100)
+
+	p22 := p1
+	p22.VetoRatio = sdk.NewRat(0, 100)
+
+	p23 := p1
+	p23.DepositForfeitVetoRatio = sdk.NewRat(0, 100)
+
 	testCases := []struct {
 		testName               string
 		ChangeProposalParamMsg ChangeProposalParamMsg
@@ -602,6 +874,56 @@ func TestChangeProposalParamMsg(t *testing.T) {
 			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p13, ""),
 			expectedError:          ErrIllegalParameter(),
 		},
+		{
+			testName:               "zero DepositPeriodSec is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p14, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "zero InitialDepositRatio is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p15, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "InitialDepositRatio that is larger than one is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p16, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "negative DepositForfeitQuorumRatio is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p17, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "DepositForfeitVetoRatio that is larger than one is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p18, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "zero ContentCensorshipQuorumRatio is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p19, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "ChangeParamQuorumRatio that is larger than one is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p20, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "negative ProtocolUpgradeQuorumRatio is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p21, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "zero VetoRatio is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p22, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "zero DepositForfeitVetoRatio is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p23, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
 		{
 			testName: "reason is too long",
 			ChangeProposalParamMsg: NewChangeProposalParamMsg(
@@ -791,6 +1113,8 @@ func TestChangePostParamMsg(t *testing.T) {
 	p1 := param.PostParam{
 		ReportOrUpvoteIntervalSec: 1,
 		PostIntervalSec:           1,
+		SubscriptionIntervalSec:   1,
+		HideReportCoinDay:         types.NewCoinFromInt64(1),
 	}
 
 	p2 := p1
@@ -799,6 +1123,21 @@ func TestChangePostParamMsg(t *testing.T) {
 	p3 := p1
 	p3.PostIntervalSec = int64(-1)
 
+	p4 := p1
+	p4.SubscriptionIntervalSec = int64(-1)
+
+	p5 := p1
+	p5.AppealPeriodSec = int64(-1)
+
+	p6 := p1
+	p6.SubscriptionIntervalSec = int64(0)
+
+	p7 := p1
+	p7.HideReportCoinDay = types.NewCoinFromInt64(0)
+
+	p8 := p1
+	p8.HideReportCoinDay = types.Coin{}
+
 	testCases := []struct {
 		testName           string
 		changePostParamMsg ChangePostParamMsg
@@ -819,6 +1158,31 @@ func TestChangePostParamMsg(t *testing.T) {
 			changePostParamMsg: NewChangePostParamMsg("user1", p3, ""),
 			expectedError:      ErrIllegalParameter(),
 		},
+		{
+			testName:           "illegal subscription interval",
+			changePostParamMsg: NewChangePostParamMsg("user1", p4, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "illegal appeal period",
+			changePostParamMsg: NewChangePostParamMsg("user1", p5, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "zero subscription interval",
+			changePostParamMsg: NewChangePostParamMsg("user1", p6, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "zero hide report coin day",
+			changePostParamMsg: NewChangePostParamMsg("user1", p7, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "missing hide report coin day",
+			changePostParamMsg: NewChangePostParamMsg("user1", p8, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
 		{
 			testName:           "username too short",
 			changePostParamMsg: NewChangePostParamMsg("us", p1, ""),
@@ -839,6 +1203,96 @@ func TestChangePostParamMsg(t *testing.T) {
 	}
 }
 
+func TestChangeCoinDayParamMsg(t *testing.T) {
+	p1 := param.CoinDayParam{
+		SecondsToRecoverCoinDay: int64(7 * 24 * 3600),
+	}
+
+	p2 := p1
+	p2.SecondsToRecoverCoinDay = int64(0)
+
+	testCases := []struct {
+		testName              string
+		changeCoinDayParamMsg ChangeCoinDayParamMsg
+		expectedError         sdk.Error
+	}{
+		{
+			testName:              "normal case",
+			changeCoinDayParamMsg: NewChangeCoinDayParamMsg("user1", p1, ""),
+			expectedError:         nil,
+		},
+		{
+			testName:              "zero SecondsToRecoverCoinDay is illegal",
+			changeCoinDayParamMsg: NewChangeCoinDayParamMsg("user1", p2, ""),
+			expectedError:         ErrIllegalParameter(),
+		},
+		{
+			testName:              "username too short",
+			changeCoinDayParamMsg: NewChangeCoinDayParamMsg("us", p1, ""),
+			expectedError:         ErrInvalidUsername(),
+		},
+		{
+			testName: "reason is too long",
+			changeCoinDayParamMsg: NewChangeCoinDayParamMsg(
+				"user1", p1, string(make([]byte, types.MaximumLengthOfProposalReason+1))),
+			expectedError: ErrReasonTooLong(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.changeCoinDayParamMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestChangeReputationParamMsg(t *testing.T) {
+	p1 := param.ReputationParam{
+		BestContentIndexN: 10,
+	}
+
+	p2 := p1
+	p2.BestContentIndexN = 0
+
+	p3 := p1
+	p3.BestContentIndexN = types.MaximumBestContentIndexN + 1
+
+	testCases := []struct {
+		testName                 string
+		changeReputationParamMsg ChangeReputationParamMsg
+		expectedError            sdk.Error
+	}{
+		{
+			testName:                 "normal case",
+			changeReputationParamMsg: NewChangeReputationParamMsg("user1", p1, ""),
+			expectedError:            nil,
+		},
+		{
+			testName:                 "zero BestContentIndexN is illegal",
+			changeReputationParamMsg: NewChangeReputationParamMsg("user1", p2, ""),
+			expectedError:            ErrIllegalParameter(),
+		},
+		{
+			testName:                 "too large BestContentIndexN is illegal",
+			changeReputationParamMsg: NewChangeReputationParamMsg("user1", p3, ""),
+			expectedError:            ErrIllegalParameter(),
+		},
+		{
+			testName:                 "username too short",
+			changeReputationParamMsg: NewChangeReputationParamMsg("us", p1, ""),
+			expectedError:            ErrInvalidUsername(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.changeReputationParamMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestChangeEvaluateOfContentValueParamMsg(t *testing.T) {
 	p1 := param.EvaluateOfContentValueParam{
 		ConsumptionTimeAdjustBase:      3153600,
@@ -955,6 +1409,43 @@ func TestDeletePostContentMsg(t *testing.T) {
 	}
 }
 
+func TestAppealPostContentMsg(t *testing.T) {
+	testCases := []struct {
+		testName             string
+		appealPostContentMsg AppealPostContentMsg
+		expectedError        sdk.Error
+	}{
+		{
+			testName:             "normal case",
+			appealPostContentMsg: NewAppealPostContentMsg("user1", "permlink", "reason"),
+			expectedError:        nil,
+		},
+		{
+			testName:             "too short username is illegal",
+			appealPostContentMsg: NewAppealPostContentMsg("us", "permlink", "reason"),
+			expectedError:        ErrInvalidUsername(),
+		},
+		{
+			testName:             "empty permlink is illegal",
+			appealPostContentMsg: NewAppealPostContentMsg("user1", "", "reason"),
+			expectedError:        ErrInvalidPermlink(),
+		},
+		{
+			testName: "reason is too long",
+			appealPostContentMsg: NewAppealPostContentMsg(
+				"user1", "permlink", string(make([]byte, types.MaximumLengthOfProposalReason+1))),
+			expectedError: ErrReasonTooLong(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.appealPostContentMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestUpgradeProtocolMsg(t *testing.T) {
 	testCases := []struct {
 		testName           string
@@ -963,32 +1454,48 @@ func TestUpgradeProtocolMsg(t *testing.T) {
 	}{
 		{
 			testName:           "normal case",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "link", ""),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "v2", 100, "link", ""),
 			expectedError:      nil,
 		},
 		{
 			testName:           "too short username is illegal",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("us", "link", ""),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("us", "v2", 100, "link", ""),
 			expectedError:      ErrInvalidUsername(),
 		},
 		{
 			testName:           "too long username is illegal",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1user1user1user1user1user1", "link", ""),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1user1user1user1user1user1", "v2", 100, "link", ""),
 			expectedError:      ErrInvalidUsername(),
 		},
+		{
+			testName:           "empty upgrade name is illegal",
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "", 100, "link", ""),
+			expectedError:      ErrInvalidUpgradeName(),
+		},
+		{
+			testName: "too long upgrade name is illegal",
+			upgradeProtocolMsg: NewUpgradeProtocolMsg(
+				"user1", string(make([]byte, types.MaximumUpgradeNameLength+1)), 100, "link", ""),
+			expectedError: ErrInvalidUpgradeName(),
+		},
+		{
+			testName:           "zero upgrade height is illegal",
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "v2", 0, "link", ""),
+			expectedError:      ErrInvalidUpgradeHeight(0),
+		},
 		{
 			testName:           "empty link is illegal",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "", ""),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "v2", 100, "", ""),
 			expectedError:      ErrInvalidLink(),
 		},
 		{
 			testName:           "reason is too long",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "", string(make([]byte, types.MaximumLengthOfProposalReason+1))),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "v2", 100, "", string(make([]byte, types.MaximumLengthOfProposalReason+1))),
 			expectedError:      ErrInvalidLink(),
 		},
 		{
 			testName:           "utf8 reason is too long",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "", tooLongOfUTF8Reason),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "v2", 100, "", tooLongOfUTF8Reason),
 			expectedError:      ErrInvalidLink(),
 		},
 	}
@@ -1001,6 +1508,88 @@ func TestUpgradeProtocolMsg(t *testing.T) {
 	}
 }
 
+func TestSubmitTextMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		submitTextMsg SubmitTextMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			submitTextMsg: NewSubmitTextMsg("user1", "reason"),
+			expectedError: nil,
+		},
+		{
+			testName:      "too short username is illegal",
+			submitTextMsg: NewSubmitTextMsg("us", "reason"),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "reason is too long",
+			submitTextMsg: NewSubmitTextMsg("user1", string(make([]byte, types.MaximumLengthOfProposalReason+1))),
+			expectedError: ErrReasonTooLong(),
+		},
+		{
+			testName:      "utf8 reason is too long",
+			submitTextMsg: NewSubmitTextMsg("user1", tooLongOfUTF8Reason),
+			expectedError: ErrReasonTooLong(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.submitTextMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestSpendCommunityPoolMsg(t *testing.T) {
+	testCases := []struct {
+		testName              string
+		spendCommunityPoolMsg SpendCommunityPoolMsg
+		expectedError         sdk.Error
+	}{
+		{
+			testName:              "normal case",
+			spendCommunityPoolMsg: NewSpendCommunityPoolMsg("user1", "user2", "10", "reason"),
+			expectedError:         nil,
+		},
+		{
+			testName:              "too short creator is illegal",
+			spendCommunityPoolMsg: NewSpendCommunityPoolMsg("us", "user2", "10", "reason"),
+			expectedError:         ErrInvalidUsername(),
+		},
+		{
+			testName:              "too long recipient is illegal",
+			spendCommunityPoolMsg: NewSpendCommunityPoolMsg("user1", "user1user1user1user1user1user1", "10", "reason"),
+			expectedError:         ErrInvalidUsername(),
+		},
+		{
+			testName:              "zero amount is illegal",
+			spendCommunityPoolMsg: NewSpendCommunityPoolMsg("user1", "user2", "0", "reason"),
+			expectedError:         types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+		{
+			testName:              "illegal amount",
+			spendCommunityPoolMsg: NewSpendCommunityPoolMsg("user1", "user2", "lino", "reason"),
+			expectedError:         types.ErrInvalidCoins("Illegal LNO"),
+		},
+		{
+			testName:              "utf8 reason is too long",
+			spendCommunityPoolMsg: NewSpendCommunityPoolMsg("user1", "user2", "10", tooLongOfUTF8Reason),
+			expectedError:         ErrReasonTooLong(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.spendCommunityPoolMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestMsgPermission(t *testing.T) {
 	testCases := []struct {
 		testName         string
@@ -1015,7 +1604,17 @@ func TestMsgPermission(t *testing.T) {
 		},
 		{
 			testName:         "upgrade protocol msg",
-			msg:              NewUpgradeProtocolMsg("creator", "link", ""),
+			msg:              NewUpgradeProtocolMsg("creator", "v2", 100, "link", ""),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "submit text msg",
+			msg:              NewSubmitTextMsg("creator", "reason"),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "spend community pool msg",
+			msg:              NewSpendCommunityPoolMsg("creator", "recipient", "1", ""),
 			expectPermission: types.TransactionPermission,
 		},
 		{
@@ -1078,11 +1677,38 @@ func TestMsgPermission(t *testing.T) {
 				"creator", param.PostParam{}, ""),
 			expectPermission: types.TransactionPermission,
 		},
+		{
+			testName: "change coin day param msg",
+			msg: NewChangeCoinDayParamMsg(
+				"creator", param.CoinDayParam{}, ""),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName: "change reputation param msg",
+			msg: NewChangeReputationParamMsg(
+				"creator", param.ReputationParam{}, ""),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "appeal post content msg",
+			msg:              NewAppealPostContentMsg("creator", "permlink", "reason"),
+			expectPermission: types.TransactionPermission,
+		},
 		{
 			testName:         "vote proposal msg",
 			msg:              NewVoteProposalMsg("voter", 1, true),
 			expectPermission: types.TransactionPermission,
 		},
+		{
+			testName:         "withdraw proposal vote msg",
+			msg:              NewWithdrawProposalVoteMsg("voter", 1),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "deposit proposal msg",
+			msg:              NewDepositProposalMsg("depositor", 1, "1"),
+			expectPermission: types.TransactionPermission,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1106,7 +1732,15 @@ func TestGetSignBytes(t *testing.T) {
 		},
 		{
 			testName: "upgrade protocol msg",
-			msg:      NewUpgradeProtocolMsg("creator", "link", ""),
+			msg:      NewUpgradeProtocolMsg("creator", "v2", 100, "link", ""),
+		},
+		{
+			testName: "submit text msg",
+			msg:      NewSubmitTextMsg("creator", "reason"),
+		},
+		{
+			testName: "spend community pool msg",
+			msg:      NewSpendCommunityPoolMsg("creator", "recipient", "1", ""),
 		},
 		{
 			testName: "change global allocaiton param msg",
@@ -1158,10 +1792,28 @@ func TestGetSignBytes(t *testing.T) {
 			msg: NewChangePostParamMsg(
 				"creator", param.PostParam{}, ""),
 		},
+		{
+			testName: "change coin day param msg",
+			msg: NewChangeCoinDayParamMsg(
+				"creator", param.CoinDayParam{}, ""),
+		},
+		{
+			testName: "change reputation param msg",
+			msg: NewChangeReputationParamMsg(
+				"creator", param.ReputationParam{}, ""),
+		},
 		{
 			testName: "vote proposal msg",
 			msg:      NewVoteProposalMsg("voter", 1, true),
 		},
+		{
+			testName: "withdraw proposal vote msg",
+			msg:      NewWithdrawProposalVoteMsg("voter", 1),
+		},
+		{
+			testName: "deposit proposal msg",
+			msg:      NewDepositProposalMsg("depositor", 1, "1"),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1183,7 +1835,17 @@ func TestGetSigners(t *testing.T) {
 		},
 		{
 			testName:      "upgrade protocol msg",
-			msg:           NewUpgradeProtocolMsg("creator", "link", ""),
+			msg:           NewUpgradeProtocolMsg("creator", "v2", 100, "link", ""),
+			expectSigners: []types.AccountKey{"creator"},
+		},
+		{
+			testName:      "submit text msg",
+			msg:           NewSubmitTextMsg("creator", "reason"),
+			expectSigners: []types.AccountKey{"creator"},
+		},
+		{
+			testName:      "spend community pool msg",
+			msg:           NewSpendCommunityPoolMsg("creator", "recipient", "1", ""),
 			expectSigners: []types.AccountKey{"creator"},
 		},
 		{
@@ -1246,11 +1908,33 @@ func TestGetSigners(t *testing.T) {
 				"creator", param.PostParam{}, ""),
 			expectSigners: []types.AccountKey{"creator"},
 		},
+		{
+			testName: "change coin day param msg",
+			msg: NewChangeCoinDayParamMsg(
+				"creator", param.CoinDayParam{}, ""),
+			expectSigners: []types.AccountKey{"creator"},
+		},
+		{
+			testName: "change reputation param msg",
+			msg: NewChangeReputationParamMsg(
+				"creator", param.ReputationParam{}, ""),
+			expectSigners: []types.AccountKey{"creator"},
+		},
 		{
 			testName:      "vote proposal msg",
 			msg:           NewVoteProposalMsg("voter", 1, true),
 			expectSigners: []types.AccountKey{"voter"},
 		},
+		{
+			testName:      "withdraw proposal vote msg",
+			msg:           NewWithdrawProposalVoteMsg("voter", 1),
+			expectSigners: []types.AccountKey{"voter"},
+		},
+		{
+			testName:      "deposit proposal msg",
+			msg:           NewDepositProposalMsg("depositor", 1, "1"),
+			expectSigners: []types.AccountKey{"depositor"},
+		},
 	}
 
 	for _, tc := range testCases {
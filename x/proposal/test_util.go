@@ -55,6 +55,7 @@ func setupTest(t *testing.T, height int64) (
 	cdc.RegisterConcrete(acc.ReturnCoinEvent{}, "1", nil)
 	cdc.RegisterConcrete(param.ChangeParamEvent{}, "2", nil)
 	cdc.RegisterConcrete(DecideProposalEvent{}, "3", nil)
+	cdc.RegisterConcrete(ExpireProposalDepositEvent{}, "4", nil)
 
 	err := initGlobalManager(ctx, globalManager)
 	assert.Nil(t, err)
@@ -108,7 +109,7 @@ func createTestPost(
 	err = pm.CreatePost(
 		ctx, msg.Author, msg.PostID, msg.SourceAuthor, msg.SourcePostID,
 		msg.ParentAuthor, msg.ParentPostID, msg.Content,
-		msg.Title, splitRate, msg.Links)
+		msg.Title, splitRate, msg.Links, nil)
 
 	assert.Nil(t, err)
 	return user, postID
@@ -7,8 +7,13 @@ import (
 // Register concrete types on wire codec
 func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(VoteProposalMsg{}, "lino/voteProposal", nil)
+	cdc.RegisterConcrete(WithdrawProposalVoteMsg{}, "lino/withdrawProposalVote", nil)
+	cdc.RegisterConcrete(DepositProposalMsg{}, "lino/depositProposal", nil)
 	cdc.RegisterConcrete(DeletePostContentMsg{}, "lino/deletePostContent", nil)
+	cdc.RegisterConcrete(AppealPostContentMsg{}, "lino/appealPostContent", nil)
 	cdc.RegisterConcrete(UpgradeProtocolMsg{}, "lino/upgradeProtocol", nil)
+	cdc.RegisterConcrete(SubmitTextMsg{}, "lino/submitText", nil)
+	cdc.RegisterConcrete(SpendCommunityPoolMsg{}, "lino/spendCommunityPool", nil)
 	cdc.RegisterConcrete(ChangeGlobalAllocationParamMsg{}, "lino/changeGlobalAllocation", nil)
 	cdc.RegisterConcrete(ChangeEvaluateOfContentValueParamMsg{}, "lino/changeEvaluation", nil)
 	cdc.RegisterConcrete(ChangeInfraInternalAllocationParamMsg{}, "lino/changeInfraAllocation", nil)
@@ -19,6 +24,8 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(ChangeBandwidthParamMsg{}, "lino/changeBandwidthParam", nil)
 	cdc.RegisterConcrete(ChangeAccountParamMsg{}, "lino/changeAccountParam", nil)
 	cdc.RegisterConcrete(ChangePostParamMsg{}, "lino/changePostParam", nil)
+	cdc.RegisterConcrete(ChangeCoinDayParamMsg{}, "lino/changeCoinDayParam", nil)
+	cdc.RegisterConcrete(ChangeReputationParamMsg{}, "lino/changeReputationParam", nil)
 }
 
 var msgCdc = wire.NewCodec()
@@ -141,6 +141,8 @@ type roundMeta struct {
 	SumDp   Dp
 	StartAt Time
 	TopN    []PostDpPair
+	// BestContentIndexN is fixed when the round starts, 0 for rounds started before it's recorded.
+	BestContentIndexN int
 }
 
 type roundPostMeta struct {
@@ -192,7 +194,7 @@ func getGameKey() []byte {
 	return repGameMetaPrefix
 }
 
-// The only state is the number of bestContentIndex
+// The only state is the number of bestContentIndex, applied to rounds started afterwards
 type reputationStoreImpl struct {
 	store             Store
 	BestContentIndexN int
@@ -476,10 +478,11 @@ func (impl reputationStoreImpl) StartNewRound(t Time) {
 	newRoundId := rst.CurrentRound + 1
 
 	newRoundMeta := &roundMeta{
-		Result:  nil,
-		SumDp:   big.NewInt(0),
-		StartAt: t,
-		TopN:    nil,
+		Result:            nil,
+		SumDp:             big.NewInt(0),
+		StartAt:           t,
+		TopN:              nil,
+		BestContentIndexN: impl.BestContentIndexN,
 	}
 	impl.setRoundMeta(newRoundId, newRoundMeta)
 
@@ -518,8 +521,12 @@ func (impl reputationStoreImpl) SetRoundPostSumDp(r RoundId, p Pid, dp Dp) {
 		return topN[i].SumDp.Cmp(topN[j].SumDp) > 0
 	})
 
-	if len(topN) > impl.BestContentIndexN {
-		topN = topN[:impl.BestContentIndexN]
+	bestN := roundMeta.BestContentIndexN
+	if bestN == 0 {
+		bestN = impl.BestContentIndexN
+	}
+	if len(topN) > bestN {
+		topN = topN[:bestN]
 	}
 	roundMeta.TopN = topN
 	impl.setRoundMeta(r, roundMeta)
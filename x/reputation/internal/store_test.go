@@ -50,6 +50,26 @@ func TestStartNewRound(t *testing.T) {
 	assert.Equal(big.NewInt(0), store.GetRoundSumDp(2))
 }
 
+func TestTopNChangedAtRoundBoundary(t *testing.T) {
+	assert := assert.New(t)
+	mockStore := newMockStore()
+	store := NewReputationStore(mockStore, 2)
+	store.StartNewRound(222)
+
+	// new N doesn't apply to the ongoing round
+	store = NewReputationStore(mockStore, 3)
+	for i := 1; i <= 3; i++ {
+		store.SetRoundPostSumDp(2, "p"+string(i), big.NewInt(int64(i)))
+	}
+	assert.Equal(2, len(store.GetRoundTopNPosts(2)))
+
+	store.StartNewRound(333)
+	for i := 1; i <= 3; i++ {
+		store.SetRoundPostSumDp(3, "p"+string(i), big.NewInt(int64(i)))
+	}
+	assert.Equal(3, len(store.GetRoundTopNPosts(3)))
+}
+
 func TestTopN(t *testing.T) {
 	assert := assert.New(t)
 	post1 := "bla"
@@ -39,6 +39,71 @@ func GetValidatorCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetValidatorStatsCmd returns target validator uptime and performance statistics
+func GetValidatorStatsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "validator-stats",
+		Short: "Query validator uptime, proposal participation and penalty history",
+		RunE:  cmdr.getValidatorStatsCmd,
+	}
+}
+
+// GetStandbyValidatorsCmd returns standby validators in promotion order
+func GetStandbyValidatorsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "validator-standby",
+		Short: "Query standby validators in promotion order",
+		RunE:  cmdr.getStandbyValidatorsCmd,
+	}
+}
+
+// standbyValidator - standby validator rank and heartbeat
+type standbyValidator struct {
+	Rank            int              `json:"rank"`
+	Username        types.AccountKey `json:"username"`
+	Deposit         types.Coin       `json:"deposit"`
+	LastHeartbeatAt int64            `json:"last_heartbeat_at"`
+}
+
+// validatorStats - validator uptime and performance statistics
+type validatorStats struct {
+	Username             types.AccountKey      `json:"username"`
+	Uptime               string                `json:"uptime"`
+	BlocksInWindow       int64                 `json:"blocks_in_window"`
+	MissedBlocksInWindow int64                 `json:"missed_blocks_in_window"`
+	ProducedBlocks       int64                 `json:"produced_blocks"`
+	VotedProposals       int64                 `json:"voted_proposals"`
+	MissedProposals      int64                 `json:"missed_proposals"`
+	Participation        string                `json:"participation"`
+	IsJailed             bool                  `json:"is_jailed"`
+	PenaltyHistory       []model.PenaltyRecord `json:"penalty_history"`
+}
+
+// validatorDetail - validator profile, commission and proposal participation shown in validator list
+type validatorDetail struct {
+	Username      types.AccountKey  `json:"username"`
+	Deposit       types.Coin        `json:"deposit"`
+	IsOncall      bool              `json:"is_oncall"`
+	IsJailed      bool              `json:"is_jailed"`
+	Description   model.Description `json:"description"`
+	Commission    *model.Commission `json:"commission"`
+	Participation string            `json:"participation"`
+}
+
+// validatorsInfo - validator list with details of all validators
+type validatorsInfo struct {
+	ValidatorList *model.ValidatorList `json:"validator_list"`
+	Validators    []validatorDetail    `json:"validators"`
+}
+
 type commander struct {
 	storeName string
 	cdc       *wire.Codec
@@ -56,8 +121,39 @@ func (c commander) getValidatorsCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	info := validatorsInfo{
+		ValidatorList: validatorList,
+		Validators:    []validatorDetail{},
+	}
+	for _, username := range validatorList.AllValidators {
+		res, err := ctx.Query(model.GetValidatorKey(username), c.storeName)
+		if err != nil {
+			return err
+		}
+		validator := new(model.Validator)
+		if err := c.cdc.UnmarshalJSON(res, validator); err != nil {
+			return err
+		}
+		isOncall := false
+		for _, oncall := range validatorList.OncallValidators {
+			if oncall == username {
+				isOncall = true
+				break
+			}
+		}
+		info.Validators = append(info.Validators, validatorDetail{
+			Username:      validator.Username,
+			Deposit:       validator.Deposit,
+			IsOncall:      isOncall,
+			IsJailed:      validator.IsJailed,
+			Description:   validator.Description,
+			Commission:    validator.Commission,
+			Participation: participation(validator),
+		})
+	}
+
 	// print out whole bank
-	output, err := json.MarshalIndent(validatorList, "", "  ")
+	output, err := json.MarshalIndent(info, "", "  ")
 	if err != nil {
 		return err
 	}
@@ -90,3 +186,105 @@ func (c commander) getValidatorCmd(cmd *cobra.Command, args []string) error {
 	fmt.Println(string(output))
 	return nil
 }
+
+func (c commander) getValidatorStatsCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a username")
+	}
+
+	accKey := types.AccountKey(args[0])
+
+	res, err := ctx.Query(model.GetValidatorKey(accKey), c.storeName)
+	if err != nil {
+		return err
+	}
+	validator := new(model.Validator)
+	if err := c.cdc.UnmarshalJSON(res, validator); err != nil {
+		return err
+	}
+
+	history := new(model.PenaltyHistory)
+	res, err = ctx.Query(model.GetPenaltyHistoryKey(accKey), c.storeName)
+	if err != nil {
+		return err
+	}
+	if len(res) > 0 {
+		if err := c.cdc.UnmarshalJSON(res, history); err != nil {
+			return err
+		}
+	}
+
+	window := validator.SigningWindow
+	uptime := "0.00%"
+	if window.RecordedBlocks > 0 {
+		uptime = fmt.Sprintf("%.2f%%",
+			float64(window.RecordedBlocks-window.MissedBlocks)*100/float64(window.RecordedBlocks))
+	}
+	stats := validatorStats{
+		Username:             validator.Username,
+		Uptime:               uptime,
+		BlocksInWindow:       window.RecordedBlocks,
+		MissedBlocksInWindow: window.MissedBlocks,
+		ProducedBlocks:       validator.ProducedBlocks,
+		VotedProposals:       validator.VotedProposals,
+		MissedProposals:      validator.MissedProposals,
+		Participation:        participation(validator),
+		IsJailed:             validator.IsJailed,
+		PenaltyHistory:       history.Details,
+	}
+
+	output, err := json.MarshalIndent(stats, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+func (c commander) getStandbyValidatorsCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	res, err := ctx.Query(model.GetValidatorListKey(), c.storeName)
+	if err != nil {
+		return err
+	}
+
+	validatorList := new(model.ValidatorList)
+	if err := c.cdc.UnmarshalJSON(res, validatorList); err != nil {
+		return err
+	}
+
+	standbyValidators := []standbyValidator{}
+	for i, username := range validatorList.StandbyValidators {
+		res, err := ctx.Query(model.GetValidatorKey(username), c.storeName)
+		if err != nil {
+			return err
+		}
+		validator := new(model.Validator)
+		if err := c.cdc.UnmarshalJSON(res, validator); err != nil {
+			return err
+		}
+		standbyValidators = append(standbyValidators, standbyValidator{
+			Rank:            i + 1,
+			Username:        validator.Username,
+			Deposit:         validator.Deposit,
+			LastHeartbeatAt: validator.LastHeartbeatAt,
+		})
+	}
+
+	output, err := json.MarshalIndent(standbyValidators, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+// participation - share of decided proposals the validator voted on while oncall
+func participation(validator *model.Validator) string {
+	decided := validator.VotedProposals + validator.MissedProposals
+	if decided == 0 {
+		return "0.00%"
+	}
+	return fmt.Sprintf("%.2f%%", float64(validator.VotedProposals)*100/float64(decided))
+}
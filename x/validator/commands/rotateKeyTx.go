@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/x/validator"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	pvm "github.com/tendermint/tendermint/privval"
+)
+
+// RotateKeyTxCmd will create a rotate key tx and sign it with the given key
+func RotateKeyTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-rotate-key",
+		Short: "replace validator consensus key with the key in private validator file",
+		RunE:  sendRotateKeyTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "user of this transaction")
+	cmd.Flags().String(client.FlagPrivValidatorFile, "", "private validator file of new key, generated if not exist")
+	return cmd
+}
+
+// send rotate key transaction to the blockchain
+func sendRotateKeyTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		name := viper.GetString(client.FlagUser)
+
+		privValFile := viper.GetString(client.FlagPrivValidatorFile)
+		if len(privValFile) == 0 {
+			return fmt.Errorf("private validator file is required")
+		}
+
+		var privValidator *pvm.FilePV
+		if cmn.FileExists(privValFile) {
+			privValidator = pvm.LoadFilePV(privValFile)
+		} else {
+			privValidator = pvm.GenFilePV(privValFile)
+			privValidator.Save()
+		}
+
+		// create the message
+		msg := validator.NewValidatorRotateKeyMsg(name, privValidator.GetPubKey())
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/x/validator"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpdateTxCmd will create an update validator tx and sign it with the given key
+func UpdateTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-update",
+		Short: "update validator description and commission, empty field is left unchanged",
+		RunE:  sendUpdateTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "user of this transaction")
+	cmd.Flags().String(client.FlagMoniker, "", "validator name")
+	cmd.Flags().String(client.FlagWebsite, "", "validator website")
+	cmd.Flags().String(client.FlagDetails, "", "validator details")
+	cmd.Flags().String(client.FlagIdentity, "", "validator identity signature")
+	cmd.Flags().String(client.FlagCommissionRate, "", "commission rate of validator inflation, e.g. 0.1")
+	cmd.Flags().String(client.FlagMaxCommissionRate, "", "max commission rate, required when commission is first set and fixed afterwards")
+	cmd.Flags().String(client.FlagMaxCommissionChangeRate, "", "max commission rate change per day, required when commission is first set and fixed afterwards")
+	return cmd
+}
+
+// send update transaction to the blockchain
+func sendUpdateTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		name := viper.GetString(client.FlagUser)
+
+		// create the message
+		msg := validator.NewValidatorUpdateMsg(
+			name, viper.GetString(client.FlagMoniker), viper.GetString(client.FlagWebsite),
+			viper.GetString(client.FlagDetails), viper.GetString(client.FlagIdentity),
+			viper.GetString(client.FlagCommissionRate), viper.GetString(client.FlagMaxCommissionRate),
+			viper.GetString(client.FlagMaxCommissionChangeRate))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
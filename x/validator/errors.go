@@ -46,3 +46,63 @@ func ErrInvalidWebsite() sdk.Error {
 func ErrValidatorPubKeyAlreadyExist() sdk.Error {
 	return types.NewError(types.CodeValidatorPubKeyAlreadyExist, fmt.Sprintf("validator public key has been registered"))
 }
+
+// ErrValidatorNotJailed - error if unjail a validator which is not jailed
+func ErrValidatorNotJailed() sdk.Error {
+	return types.NewError(types.CodeValidatorNotJailed, fmt.Sprintf("validator is not jailed"))
+}
+
+// ErrValidatorStillJailed - error if unjail before jail period ends
+func ErrValidatorStillJailed(jailedUntil int64) sdk.Error {
+	return types.NewError(types.CodeValidatorStillJailed, fmt.Sprintf("validator is jailed until %v", jailedUntil))
+}
+
+// ErrValidatorJailed - error if a jailed validator tries to become oncall validator or withdraw deposit
+func ErrValidatorJailed() sdk.Error {
+	return types.NewError(types.CodeValidatorJailed, fmt.Sprintf("validator is jailed"))
+}
+
+// ErrKeyRotationTooOften - error if validator rotates consensus key more than once in a block
+func ErrKeyRotationTooOften() sdk.Error {
+	return types.NewError(types.CodeKeyRotationTooOften, fmt.Sprintf("validator key can only be rotated once in a block"))
+}
+
+// ErrInvalidValidatorPubKey - error if validator public key is invalid
+func ErrInvalidValidatorPubKey() sdk.Error {
+	return types.NewError(types.CodeInvalidValidatorPubKey, fmt.Sprintf("invalid validator public key"))
+}
+
+// ErrInvalidValidatorDescription - error if validator description is invalid
+func ErrInvalidValidatorDescription(field string) sdk.Error {
+	return types.NewError(types.CodeInvalidValidatorDescription, fmt.Sprintf("invalid validator %v", field))
+}
+
+// ErrInvalidValidatorCommissionRate - error if commission rate is invalid
+func ErrInvalidValidatorCommissionRate() sdk.Error {
+	return types.NewError(types.CodeInvalidValidatorCommissionRate, fmt.Sprintf("invalid commission rate"))
+}
+
+// ErrCommissionRateExceedMaxRate - error if commission rate is larger than max rate
+func ErrCommissionRateExceedMaxRate() sdk.Error {
+	return types.NewError(types.CodeCommissionRateExceedMaxRate, fmt.Sprintf("commission rate exceeds max rate"))
+}
+
+// ErrCommissionRateChangeTooLarge - error if commission rate change is larger than max change rate
+func ErrCommissionRateChangeTooLarge() sdk.Error {
+	return types.NewError(types.CodeCommissionRateChangeTooLarge, fmt.Sprintf("commission rate change exceeds max change rate"))
+}
+
+// ErrCommissionUpdateTooOften - error if commission rate is updated within update interval
+func ErrCommissionUpdateTooOften() sdk.Error {
+	return types.NewError(types.CodeCommissionUpdateTooOften, fmt.Sprintf("commission rate can only be updated once a day"))
+}
+
+// ErrCommissionLimitImmutable - error if max rate or max change rate is changed after commission is set
+func ErrCommissionLimitImmutable() sdk.Error {
+	return types.NewError(types.CodeCommissionLimitImmutable, fmt.Sprintf("commission max rate and max change rate can't be changed"))
+}
+
+// ErrCommissionLimitRequired - error if max rate or max change rate is missing when commission is set for the first time
+func ErrCommissionLimitRequired() sdk.Error {
+	return types.NewError(types.CodeCommissionLimitRequired, fmt.Sprintf("commission max rate and max change rate are required"))
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/lino-network/lino/types"
 	acc "github.com/lino-network/lino/x/account"
 	"github.com/lino-network/lino/x/global"
+	"github.com/lino-network/lino/x/validator/model"
 	vote "github.com/lino-network/lino/x/vote"
 )
 
@@ -21,6 +22,14 @@ func NewHandler(am acc.AccountManager, valManager ValidatorManager, voteManager
 			return handleWithdrawMsg(ctx, valManager, gm, am, msg)
 		case ValidatorRevokeMsg:
 			return handleRevokeMsg(ctx, valManager, gm, am, msg)
+		case ValidatorUnjailMsg:
+			return handleUnjailMsg(ctx, valManager, voteManager, msg)
+		case ValidatorRotateKeyMsg:
+			return handleRotateKeyMsg(ctx, valManager, msg)
+		case ValidatorUpdateMsg:
+			return handleUpdateMsg(ctx, valManager, voteManager, msg)
+		case ValidatorHeartbeatMsg:
+			return handleHeartbeatMsg(ctx, valManager, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized validator msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -73,6 +82,11 @@ func handleDepositMsg(
 		return ErrUnbalancedAccount().Result()
 	}
 
+	// jailed validator can top up deposit, but it has to unjail to become oncall validator
+	if valManager.IsJailed(ctx, msg.Username) {
+		return sdk.Result{}
+	}
+
 	// Try to become oncall validator
 	if err := valManager.TryBecomeOncallValidator(ctx, msg.Username); err != nil {
 		return err.Result()
@@ -89,6 +103,11 @@ func handleWithdrawMsg(
 		return err.Result()
 	}
 
+	// jailed validator keeps deposit till unjailed in case of further punishment
+	if vm.IsJailed(ctx, msg.Username) {
+		return ErrValidatorJailed().Result()
+	}
+
 	if !vm.IsLegalWithdraw(ctx, msg.Username, coin) {
 		return ErrIllegalWithdraw().Result()
 	}
@@ -113,6 +132,9 @@ func handleWithdrawMsg(
 func handleRevokeMsg(
 	ctx sdk.Context, vm ValidatorManager, gm global.GlobalManager, am acc.AccountManager,
 	msg ValidatorRevokeMsg) sdk.Result {
+	if vm.IsJailed(ctx, msg.Username) {
+		return ErrValidatorJailed().Result()
+	}
 	coin, withdrawErr := vm.ValidatorWithdrawAll(ctx, msg.Username)
 	if withdrawErr != nil {
 		return withdrawErr.Result()
@@ -135,6 +157,92 @@ func handleRevokeMsg(
 	return sdk.Result{}
 }
 
+// Handle Unjail Msg
+func handleUnjailMsg(
+	ctx sdk.Context, vm ValidatorManager, voteManager vote.VoteManager, msg ValidatorUnjailMsg) sdk.Result {
+	// Deposit must be balanced
+	linoStake, err := voteManager.GetLinoStake(ctx, msg.Username)
+	if err != nil {
+		return err.Result()
+	}
+
+	if !vm.IsBalancedAccount(ctx, msg.Username, linoStake) {
+		return ErrUnbalancedAccount().Result()
+	}
+
+	if err := vm.Unjail(ctx, msg.Username); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// Handle RotateKey Msg
+func handleRotateKeyMsg(ctx sdk.Context, vm ValidatorManager, msg ValidatorRotateKeyMsg) sdk.Result {
+	if err := vm.RotateValidatorKey(ctx, msg.Username, msg.ValPubKey); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// Handle Update Msg
+func handleUpdateMsg(
+	ctx sdk.Context, vm ValidatorManager, voteManager vote.VoteManager, msg ValidatorUpdateMsg) sdk.Result {
+	description := model.Description{
+		Moniker:  msg.Moniker,
+		Website:  msg.Website,
+		Details:  msg.Details,
+		Identity: msg.Identity,
+	}
+	if err := vm.UpdateValidatorDescription(ctx, msg.Username, description); err != nil {
+		return err.Result()
+	}
+	if len(msg.CommissionRate) == 0 {
+		return sdk.Result{}
+	}
+
+	rate, err := parseCommissionRate(msg.CommissionRate)
+	if err != nil {
+		return err.Result()
+	}
+	if len(msg.MaxCommissionRate) == 0 && len(msg.MaxCommissionChangeRate) == 0 {
+		if err := vm.UpdateValidatorCommissionRate(ctx, msg.Username, rate); err != nil {
+			return err.Result()
+		}
+		return sdk.Result{}
+	}
+
+	// commission limits are set together for the first time
+	if len(msg.MaxCommissionRate) == 0 || len(msg.MaxCommissionChangeRate) == 0 {
+		return ErrCommissionLimitRequired().Result()
+	}
+	maxRate, err := parseCommissionRate(msg.MaxCommissionRate)
+	if err != nil {
+		return err.Result()
+	}
+	maxChangeRate, err := parseCommissionRate(msg.MaxCommissionChangeRate)
+	if err != nil {
+		return err.Result()
+	}
+	// validator is paid with its voter commission rate before commission is set
+	pool, err := voteManager.GetRewardPool(ctx, msg.Username)
+	if err != nil {
+		return err.Result()
+	}
+	if err := vm.InitValidatorCommission(
+		ctx, msg.Username, pool.CommissionRate, rate, maxRate, maxChangeRate); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// Handle Heartbeat Msg
+func handleHeartbeatMsg(ctx sdk.Context, vm ValidatorManager, msg ValidatorHeartbeatMsg) sdk.Result {
+	if err := vm.Heartbeat(ctx, msg.Username); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
 func returnCoinTo(
 	ctx sdk.Context, name types.AccountKey, gm global.GlobalManager, am acc.AccountManager,
 	times int64, interval int64, coin types.Coin) sdk.Error {
@@ -144,7 +252,7 @@ func returnCoinTo(
 		return err
 	}
 
-	events, err := acc.CreateCoinReturnEvents(ctx, name, times, interval, coin, types.ValidatorReturnCoin)
+	events, err := am.CreatePendingCoinReturnEvents(ctx, name, times, interval, coin, types.ValidatorReturnCoin)
 	if err != nil {
 		return err
 	}
@@ -3,6 +3,7 @@ package validator
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/validator/model"
@@ -12,6 +13,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestRegisterBasic(t *testing.T) {
@@ -496,6 +498,95 @@ func TestRegisterWithDupKey(t *testing.T) {
 
 }
 
+func TestJailAndUnjail(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, gm)
+	valManager.InitGenesis(ctx)
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	minBalance := types.NewCoinFromInt64(100000 * types.Decimals)
+	user1 := createTestAccount(ctx, am, "user1", minBalance.Plus(valParam.ValidatorMinCommittingDeposit))
+	voteManager.AddVoter(ctx, user1, valParam.ValidatorMinVotingDeposit)
+
+	baseTime := int64(1000)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime, 0)})
+	deposit := coinToString(valParam.ValidatorMinCommittingDeposit)
+	result := handler(ctx, NewValidatorDepositMsg("user1", deposit, secp256k1.GenPrivKey().PubKey(), ""))
+	assert.Equal(t, sdk.Result{}, result)
+
+	// jail user1 as byzantine, remaining deposit is kept
+	_, err := valManager.PunishOncallValidator(ctx, user1, valParam.PenaltyMissVote, types.PunishByzantine)
+	assert.Nil(t, err)
+	validator, _ := valManager.storage.GetValidator(ctx, user1)
+	assert.True(t, validator.IsJailed)
+	assert.Equal(t, int64(1), validator.JailedTimes)
+	assert.Equal(t, baseTime+valParam.JailDurationSec, validator.JailedUntil)
+	assert.Equal(t, valParam.ValidatorMinCommittingDeposit.Minus(valParam.PenaltyMissVote), validator.Deposit)
+	lst, _ := valManager.storage.GetValidatorList(ctx)
+	assert.Equal(t, -1, types.FindAccountInList(user1, lst.OncallValidators))
+	assert.NotEqual(t, -1, types.FindAccountInList(user1, lst.AllValidators))
+
+	// can't unjail during jail period
+	result = handler(ctx, NewValidatorUnjailMsg("user1"))
+	assert.Equal(t, ErrValidatorStillJailed(validator.JailedUntil).Result(), result)
+
+	// can't unjail without enough deposit
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(validator.JailedUntil, 0)})
+	result = handler(ctx, NewValidatorUnjailMsg("user1"))
+	assert.Equal(t, ErrInsufficientDeposit().Result(), result)
+
+	// top up deposit won't bring jailed validator back
+	result = handler(ctx, NewValidatorDepositMsg("user1", coinToString(valParam.PenaltyMissVote), nil, ""))
+	assert.Equal(t, sdk.Result{}, result)
+	lst, _ = valManager.storage.GetValidatorList(ctx)
+	assert.Equal(t, -1, types.FindAccountInList(user1, lst.OncallValidators))
+
+	result = handler(ctx, NewValidatorUnjailMsg("user1"))
+	assert.Equal(t, sdk.Result{}, result)
+	lst, _ = valManager.storage.GetValidatorList(ctx)
+	assert.NotEqual(t, -1, types.FindAccountInList(user1, lst.OncallValidators))
+	assert.False(t, valManager.IsJailed(ctx, user1))
+
+	result = handler(ctx, NewValidatorUnjailMsg("user1"))
+	assert.Equal(t, ErrValidatorNotJailed().Result(), result)
+
+	// repeated offence doubles jail period
+	_, err = valManager.PunishOncallValidator(ctx, user1, valParam.PenaltyMissVote, types.PunishByzantine)
+	assert.Nil(t, err)
+	validator, _ = valManager.storage.GetValidator(ctx, user1)
+	assert.Equal(t, int64(2), validator.JailedTimes)
+	assert.Equal(t, ctx.BlockHeader().Time.Unix()+2*valParam.JailDurationSec, validator.JailedUntil)
+}
+
+func TestJailedValidatorCannotWithdraw(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, gm)
+	valManager.InitGenesis(ctx)
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	minBalance := types.NewCoinFromInt64(100000 * types.Decimals)
+	user1 := createTestAccount(ctx, am, "user1", minBalance.Plus(valParam.ValidatorMinCommittingDeposit))
+	voteManager.AddVoter(ctx, user1, valParam.ValidatorMinVotingDeposit)
+
+	deposit := coinToString(valParam.ValidatorMinCommittingDeposit)
+	result := handler(ctx, NewValidatorDepositMsg("user1", deposit, secp256k1.GenPrivKey().PubKey(), ""))
+	assert.Equal(t, sdk.Result{}, result)
+
+	_, err := valManager.PunishOncallValidator(ctx, user1, valParam.PenaltyMissVote, types.PunishByzantine)
+	assert.Nil(t, err)
+	validator, _ := valManager.storage.GetValidator(ctx, user1)
+
+	// jailed validator can't withdraw or revoke to escape further punishment
+	result = handler(ctx, NewValidatorWithdrawMsg("user1", coinToString(valParam.ValidatorMinWithdraw)))
+	assert.Equal(t, ErrValidatorJailed().Result(), result)
+	result = handler(ctx, NewValidatorRevokeMsg("user1"))
+	assert.Equal(t, ErrValidatorJailed().Result(), result)
+	afterValidator, _ := valManager.storage.GetValidator(ctx, user1)
+	assert.Equal(t, validator.Deposit, afterValidator.Deposit)
+	lst, _ := valManager.storage.GetValidatorList(ctx)
+	assert.NotEqual(t, -1, types.FindAccountInList(user1, lst.AllValidators))
+}
+
 func TestAddFrozenMoney(t *testing.T) {
 	ctx, am, valManager, _, gm := setupTest(t, 0)
 	valManager.InitGenesis(ctx)
@@ -560,3 +651,250 @@ func TestAddFrozenMoney(t *testing.T) {
 		}
 	}
 }
+
+func TestRotateValidatorKey(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, gm)
+	valManager.InitGenesis(ctx)
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	valKeys := []crypto.PubKey{secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()}
+	for i, valKey := range valKeys {
+		name := "user" + strconv.Itoa(i+1)
+		createTestAccount(ctx, am, name, minBalance.Plus(valParam.ValidatorMinCommittingDeposit))
+		voteManager.AddVoter(ctx, types.AccountKey(name), valParam.ValidatorMinVotingDeposit)
+		result := handler(ctx, NewValidatorDepositMsg(
+			name, coinToString(valParam.ValidatorMinCommittingDeposit), valKey, ""))
+		assert.Equal(t, sdk.Result{}, result)
+	}
+
+	baseTime := int64(1000)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Height: 10, Time: time.Unix(baseTime, 0)})
+	oldValidator, _ := valManager.storage.GetValidator(ctx, "user1")
+	newKey := secp256k1.GenPrivKey().PubKey()
+
+	// can't rotate to a key in use
+	result := handler(ctx, NewValidatorRotateKeyMsg("user1", valKeys[1]))
+	assert.Equal(t, ErrValidatorPubKeyAlreadyExist().Result(), result)
+
+	result = handler(ctx, NewValidatorRotateKeyMsg("user1", newKey))
+	assert.Equal(t, sdk.Result{}, result)
+	validator, _ := valManager.storage.GetValidator(ctx, "user1")
+	assert.Equal(t, tmtypes.TM2PB.PubKey(newKey), validator.ABCIValidator.PubKey)
+	assert.Equal(t, oldValidator.ABCIValidator.Power, validator.ABCIValidator.Power)
+	assert.Equal(t, 1, len(validator.RetiredKeys))
+	assert.Equal(t, oldValidator.ABCIValidator, validator.RetiredKeys[0].ABCIValidator)
+
+	// only one rotation in a block
+	result = handler(ctx, NewValidatorRotateKeyMsg("user1", secp256k1.GenPrivKey().PubKey()))
+	assert.Equal(t, ErrKeyRotationTooOften().Result(), result)
+
+	// retired key is still in use
+	createTestAccount(ctx, am, "user3", minBalance.Plus(valParam.ValidatorMinCommittingDeposit))
+	voteManager.AddVoter(ctx, "user3", valParam.ValidatorMinVotingDeposit)
+	result = handler(ctx, NewValidatorDepositMsg(
+		"user3", coinToString(valParam.ValidatorMinCommittingDeposit), valKeys[0], ""))
+	assert.Equal(t, ErrValidatorPubKeyAlreadyExist().Result(), result)
+
+	// old key is removed from consensus and new key joins in the same block
+	lst, _ := valManager.storage.GetValidatorList(ctx)
+	lst.PreBlockValidators = []types.AccountKey{"user1", "user2"}
+	valManager.storage.SetValidatorList(ctx, lst)
+	oldKeyNoPower := oldValidator.ABCIValidator
+	oldKeyNoPower.Power = 0
+	user2, _ := valManager.storage.GetValidator(ctx, "user2")
+	updateList, err := valManager.GetUpdateValidatorList(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []abci.Validator{oldKeyNoPower, validator.ABCIValidator, user2.ABCIValidator}, updateList)
+
+	// evidence against retired key out of unbonding period is ignored
+	evidence := []abci.Evidence{{Validator: oldValidator.ABCIValidator}}
+	ctx = ctx.WithBlockHeader(abci.Header{
+		ChainID: "Lino", Height: 11, Time: time.Unix(baseTime+valParam.KeyUnbondingSec, 0)})
+	_, _, err = valManager.FireIncompetentValidator(ctx, evidence)
+	assert.Nil(t, err)
+	assert.False(t, valManager.IsJailed(ctx, "user1"))
+
+	// evidence against retired key in unbonding period is tracked
+	ctx = ctx.WithBlockHeader(abci.Header{
+		ChainID: "Lino", Height: 11, Time: time.Unix(baseTime+valParam.KeyUnbondingSec-1, 0)})
+	_, _, err = valManager.FireIncompetentValidator(ctx, evidence)
+	assert.Nil(t, err)
+	assert.True(t, valManager.IsJailed(ctx, "user1"))
+}
+
+func TestUpdateValidator(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, gm)
+	valManager.InitGenesis(ctx)
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	for i := 1; i <= 2; i++ {
+		name := "user" + strconv.Itoa(i)
+		createTestAccount(ctx, am, name, minBalance.Plus(valParam.ValidatorMinCommittingDeposit))
+		voteManager.AddVoter(ctx, types.AccountKey(name), valParam.ValidatorMinVotingDeposit)
+		result := handler(ctx, NewValidatorDepositMsg(
+			name, coinToString(valParam.ValidatorMinCommittingDeposit), secp256k1.GenPrivKey().PubKey(), ""))
+		assert.Equal(t, sdk.Result{}, result)
+	}
+
+	baseTime := int64(1000)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Height: 10, Time: time.Unix(baseTime, 0)})
+
+	// non-exist validator can't update
+	result := handler(ctx, NewValidatorUpdateMsg("user3", "moniker", "", "", "", "", "", ""))
+	assert.Equal(t, model.ErrValidatorNotFound().Result(), result)
+
+	// update description only, commission is not set
+	result = handler(ctx, NewValidatorUpdateMsg(
+		"user1", "moniker", "https://lino.network", "details", "identity", "", "", ""))
+	assert.Equal(t, sdk.Result{}, result)
+	_, hasCommission, err := valManager.GetCommissionRate(ctx, "user1")
+	assert.Nil(t, err)
+	assert.False(t, hasCommission)
+
+	// empty field is left unchanged
+	result = handler(ctx, NewValidatorUpdateMsg("user1", "", "", "new details", "", "", "", ""))
+	assert.Equal(t, sdk.Result{}, result)
+	validator, _ := valManager.storage.GetValidator(ctx, "user1")
+	assert.Equal(t, model.Description{
+		Moniker:  "moniker",
+		Website:  "https://lino.network",
+		Details:  "new details",
+		Identity: "identity",
+	}, validator.Description)
+
+	// commission limits are required when commission is set for the first time
+	result = handler(ctx, NewValidatorUpdateMsg("user1", "", "", "", "", "0.1", "", ""))
+	assert.Equal(t, ErrCommissionLimitRequired().Result(), result)
+	result = handler(ctx, NewValidatorUpdateMsg("user1", "", "", "", "", "0.1", "0.2", ""))
+	assert.Equal(t, ErrCommissionLimitRequired().Result(), result)
+	_, hasCommission, err = valManager.GetCommissionRate(ctx, "user1")
+	assert.Nil(t, err)
+	assert.False(t, hasCommission)
+
+	// commission rate can't exceed max rate
+	result = handler(ctx, NewValidatorUpdateMsg("user1", "", "", "", "", "0.3", "0.2", "0.01"))
+	assert.Equal(t, ErrCommissionRateExceedMaxRate().Result(), result)
+
+	// max change rate can't exceed max rate
+	result = handler(ctx, NewValidatorUpdateMsg("user1", "", "", "", "", "0.1", "0.2", "0.3"))
+	assert.Equal(t, ErrInvalidValidatorCommissionRate().Result(), result)
+
+	result = handler(ctx, NewValidatorUpdateMsg("user1", "", "", "", "", "0.1", "0.2", "0.01"))
+	assert.Equal(t, sdk.Result{}, result)
+	validator, _ = valManager.storage.GetValidator(ctx, "user1")
+	assert.True(t, validator.Commission.Rate.Equal(sdk.NewRat(1, 10)))
+	assert.True(t, validator.Commission.MaxRate.Equal(sdk.NewRat(1, 5)))
+	assert.True(t, validator.Commission.MaxChangeRate.Equal(sdk.NewRat(1, 100)))
+	assert.Equal(t, baseTime, validator.Commission.UpdatedAt)
+
+	// commission limits can't be changed
+	result = handler(ctx, NewValidatorUpdateMsg("user1", "", "", "", "", "0.1", "0.3", "0.01"))
+	assert.Equal(t, ErrCommissionLimitImmutable().Result(), result)
+
+	// commission rate can only be changed once a day
+	result = handler(ctx, NewValidatorUpdateMsg("user1", "", "", "", "", "0.11", "", ""))
+	assert.Equal(t, ErrCommissionUpdateTooOften().Result(), result)
+
+	ctx = ctx.WithBlockHeader(abci.Header{
+		ChainID: "Lino", Height: 11, Time: time.Unix(baseTime+valParam.CommissionUpdateIntervalSec, 0)})
+	result = handler(ctx, NewValidatorUpdateMsg("user1", "", "", "", "", "0.12", "", ""))
+	assert.Equal(t, ErrCommissionRateChangeTooLarge().Result(), result)
+	result = handler(ctx, NewValidatorUpdateMsg("user1", "", "", "", "", "0.09", "", ""))
+	assert.Equal(t, sdk.Result{}, result)
+	rate, hasCommission, err := valManager.GetCommissionRate(ctx, "user1")
+	assert.Nil(t, err)
+	assert.True(t, hasCommission)
+	assert.True(t, rate.Equal(sdk.NewRat(9, 100)))
+
+	// commission rate can't exceed max rate after it is set
+	ctx = ctx.WithBlockHeader(abci.Header{
+		ChainID: "Lino", Height: 12, Time: time.Unix(baseTime+2*valParam.CommissionUpdateIntervalSec, 0)})
+	// initial commission rate can't be too far from voter commission rate
+	result = handler(ctx, NewValidatorUpdateMsg("user2", "", "", "", "", "0.3", "0.5", "0.1"))
+	assert.Equal(t, ErrCommissionRateChangeTooLarge().Result(), result)
+	result = handler(ctx, NewValidatorUpdateMsg("user2", "", "", "", "", "0.5", "0.5", "0.5"))
+	assert.Equal(t, sdk.Result{}, result)
+	ctx = ctx.WithBlockHeader(abci.Header{
+		ChainID: "Lino", Height: 13, Time: time.Unix(baseTime+3*valParam.CommissionUpdateIntervalSec, 0)})
+	result = handler(ctx, NewValidatorUpdateMsg("user2", "", "", "", "", "0.6", "", ""))
+	assert.Equal(t, ErrCommissionRateExceedMaxRate().Result(), result)
+	result = handler(ctx, NewValidatorUpdateMsg("user2", "", "", "", "", "0", "", ""))
+	assert.Equal(t, sdk.Result{}, result)
+	rate, _, _ = valManager.GetCommissionRate(ctx, "user2")
+	assert.True(t, rate.IsZero())
+}
+
+func TestStandbyValidatorPromotion(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, gm)
+	valManager.InitGenesis(ctx)
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	minBalance := types.NewCoinFromInt64(100000 * types.Decimals)
+	valMinCommitDeposit, _ := valParam.ValidatorMinCommittingDeposit.ToInt64()
+	deposit := func(name string, extra int64) {
+		createTestAccount(ctx, am, name, minBalance.Plus(valParam.ValidatorMinCommittingDeposit))
+		voteManager.AddVoter(ctx, types.AccountKey(name), valParam.ValidatorMinVotingDeposit)
+		num := extra + valMinCommitDeposit/types.Decimals
+		result := handler(ctx, NewValidatorDepositMsg(
+			name, types.LNO(strconv.FormatInt(num, 10)), secp256k1.GenPrivKey().PubKey(), ""))
+		assert.Equal(t, sdk.Result{}, result)
+	}
+
+	// fill oncall validator list
+	for i := 1; i <= 21; i++ {
+		deposit("user"+strconv.Itoa(i), 100)
+	}
+	// standby validators have less deposit than oncall validators
+	deposit("standby1", 0)
+	deposit("standby2", 20)
+	deposit("standby3", 10)
+
+	lst, _ := valManager.storage.GetValidatorList(ctx)
+	assert.Equal(t, 21, len(lst.OncallValidators))
+	assert.Equal(t, []types.AccountKey{"standby2", "standby3", "standby1"}, lst.StandbyValidators)
+
+	// best standby validator is promoted when oncall validator revokes
+	result := handler(ctx, NewValidatorRevokeMsg("user1"))
+	assert.Equal(t, sdk.Result{}, result)
+	lst, _ = valManager.storage.GetValidatorList(ctx)
+	assert.Equal(t, 21, len(lst.OncallValidators))
+	assert.NotEqual(t, -1, types.FindAccountInList("standby2", lst.OncallValidators))
+	assert.Equal(t, []types.AccountKey{"standby3", "standby1"}, lst.StandbyValidators)
+
+	// best standby validator is promoted when oncall validator is jailed
+	_, err := valManager.PunishOncallValidator(ctx, "user2", valParam.PenaltyByzantine, types.PunishByzantine)
+	assert.Nil(t, err)
+	lst, _ = valManager.storage.GetValidatorList(ctx)
+	assert.Equal(t, 21, len(lst.OncallValidators))
+	assert.Equal(t, -1, types.FindAccountInList("user2", lst.OncallValidators))
+	assert.NotEqual(t, -1, types.FindAccountInList("standby3", lst.OncallValidators))
+	assert.Equal(t, []types.AccountKey{"standby1"}, lst.StandbyValidators)
+
+	// standby validator is online after heartbeat
+	baseTime := int64(1000)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Height: 10, Time: time.Unix(baseTime, 0)})
+	online, err := valManager.GetOnlineStandbyValidators(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.AccountKey{}, online)
+	result = handler(ctx, NewValidatorHeartbeatMsg("standby1"))
+	assert.Equal(t, sdk.Result{}, result)
+	online, err = valManager.GetOnlineStandbyValidators(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.AccountKey{"standby1"}, online)
+
+	// jailed validator can't send heartbeat
+	result = handler(ctx, NewValidatorHeartbeatMsg("user2"))
+	assert.Equal(t, ErrValidatorJailed().Result(), result)
+
+	// standby validator is offline after heartbeat interval
+	ctx = ctx.WithBlockHeader(abci.Header{
+		ChainID: "Lino", Height: 11, Time: time.Unix(baseTime+valParam.HeartbeatIntervalSec+1, 0)})
+	online, err = valManager.GetOnlineStandbyValidators(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.AccountKey{}, online)
+}
@@ -3,6 +3,7 @@ package validator
 import (
 	"math"
 	"reflect"
+	"sort"
 
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
@@ -14,6 +15,18 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 )
 
+// DelegatorSlash - delegations under a punished validator should be slashed by SlashRate
+type DelegatorSlash struct {
+	Validator types.AccountKey
+	SlashRate sdk.Rat
+}
+
+// ValidatorInflation - inflation earned by an oncall validator
+type ValidatorInflation struct {
+	Validator types.AccountKey
+	Inflation types.Coin
+}
+
 // ValidatorManager - validator manager
 type ValidatorManager struct {
 	storage     model.ValidatorStorage
@@ -74,6 +87,15 @@ func (vm ValidatorManager) IsLegalWithdraw(
 	return res.IsGTE(param.ValidatorMinCommittingDeposit)
 }
 
+// IsJailed - check if validator is jailed or not
+func (vm ValidatorManager) IsJailed(ctx sdk.Context, username types.AccountKey) bool {
+	validator, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return false
+	}
+	return validator.IsJailed
+}
+
 // IsBalancedAccount - make sure voting deposit is much than committing (validator) deposit
 func (vm ValidatorManager) IsBalancedAccount(
 	ctx sdk.Context, accKey types.AccountKey, votingDeposit types.Coin) bool {
@@ -93,18 +115,30 @@ func (vm ValidatorManager) GetUpdateValidatorList(ctx sdk.Context) ([]abci.Valid
 	}
 	ABCIValList := []abci.Validator{}
 	for _, preValidator := range validatorList.PreBlockValidators {
+		validator, err := vm.storage.GetValidator(ctx, preValidator)
+		if err != nil {
+			return nil, err
+		}
+		// key retired in this block is still in consensus validator set, set its power to 0
+		retiredKey, rotated := getKeyRetiredAt(validator, ctx.BlockHeight())
+		if rotated {
+			retiredValidator := retiredKey.ABCIValidator
+			retiredValidator.Power = 0
+			ABCIValList = append(ABCIValList, retiredValidator)
+		}
+
 		// set power to 0 if a previous validator not in oncall list anymore
 		if types.FindAccountInList(preValidator, validatorList.OncallValidators) == -1 {
-			validator, err := vm.storage.GetValidator(ctx, preValidator)
-			if err != nil {
-				return nil, err
-			}
-			if validator.Deposit.IsZero() {
+			// jailed validator keeps its record even if all deposit is punished
+			if validator.Deposit.IsZero() && !validator.IsJailed {
 				vm.storage.DeleteValidator(ctx, validator.Username)
 			}
 
-			validator.ABCIValidator.Power = 0
-			ABCIValList = append(ABCIValList, validator.ABCIValidator)
+			// new key hasn't joined consensus validator set yet
+			if !rotated {
+				validator.ABCIValidator.Power = 0
+				ABCIValList = append(ABCIValList, validator.ABCIValidator)
+			}
 		}
 	}
 
@@ -118,6 +152,16 @@ func (vm ValidatorManager) GetUpdateValidatorList(ctx sdk.Context) ([]abci.Valid
 	return ABCIValList, nil
 }
 
+// get the key retired at given height
+func getKeyRetiredAt(validator *model.Validator, height int64) (model.RetiredKey, bool) {
+	for _, retiredKey := range validator.RetiredKeys {
+		if retiredKey.RetiredHeight == height {
+			return retiredKey, true
+		}
+	}
+	return model.RetiredKey{}, false
+}
+
 // GetValidatorList - get validator list from KV Store
 func (vm ValidatorManager) GetValidatorList(ctx sdk.Context) (*model.ValidatorList, sdk.Error) {
 	return vm.storage.GetValidatorList(ctx)
@@ -145,6 +189,11 @@ func (vm ValidatorManager) UpdateSigningValidator(
 		panic(err)
 	}
 
+	param, err := vm.paramHolder.GetValidatorParam(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	pkToSigningInfo := make(map[string]bool)
 
 	// go through signing validator and update sign and absent info
@@ -159,14 +208,19 @@ func (vm ValidatorManager) UpdateSigningValidator(
 			panic(getErr)
 		}
 		signedLastBlock, exist := pkToSigningInfo[string(validator.ABCIValidator.Address)]
-		if !exist || !signedLastBlock {
-			validator.AbsentCommit++
-		} else {
+		if !exist && len(validator.RetiredKeys) > 0 {
+			// latest retired key keeps signing till new key takes effect in consensus
+			latestKey := validator.RetiredKeys[len(validator.RetiredKeys)-1]
+			signedLastBlock, exist = pkToSigningInfo[string(latestKey.ABCIValidator.Address)]
+		}
+		signed := exist && signedLastBlock
+		if signed {
 			validator.ProducedBlocks++
-			if validator.AbsentCommit > 0 {
-				validator.AbsentCommit--
-			}
+			validator.InflationPeriod.SignedBlocks++
+		} else {
+			validator.InflationPeriod.MissedBlocks++
 		}
+		updateSigningWindow(validator, signed, param.AbsentCommitWindow)
 		if err := vm.storage.SetValidator(ctx, curValidator, validator); err != nil {
 			panic(err)
 		}
@@ -175,6 +229,59 @@ func (vm ValidatorManager) UpdateSigningValidator(
 	return nil
 }
 
+// updateSigningWindow - record if validator signed the latest block in its signing window,
+// the oldest block slides out once the window is full. Absent commits sliding out of window
+// are also removed from unpunished absent commits if they are recorded after last punishment.
+func updateSigningWindow(validator *model.Validator, signed bool, windowSize int64) {
+	if windowSize <= 0 {
+		// window is not configured, absent commits are counted till punished
+		if !signed {
+			validator.AbsentCommit++
+		}
+		return
+	}
+	window := &validator.SigningWindow
+	if window.Size != windowSize {
+		// window size changed, restart the window and absent commits counted in it
+		*window = model.SigningWindow{
+			Size:   windowSize,
+			Bitmap: make([]byte, (windowSize+7)/8),
+		}
+		validator.AbsentCommit = 0
+	}
+
+	byteIdx, mask := window.Index/8, byte(1)<<uint(window.Index%8)
+	if window.RecordedBlocks == window.Size {
+		if window.Bitmap[byteIdx]&mask == 0 {
+			window.MissedBlocks--
+			if window.UnpunishedBlocks == window.Size && validator.AbsentCommit > 0 {
+				validator.AbsentCommit--
+			}
+		}
+	} else {
+		window.RecordedBlocks++
+	}
+
+	if signed {
+		window.Bitmap[byteIdx] |= mask
+	} else {
+		window.Bitmap[byteIdx] &^= mask
+		window.MissedBlocks++
+		validator.AbsentCommit++
+	}
+	if window.UnpunishedBlocks < window.Size {
+		window.UnpunishedBlocks++
+	}
+	window.Index = (window.Index + 1) % window.Size
+}
+
+// clearAbsentCommit - absent commits are punished, signing window is kept as
+// uptime statistics but blocks recorded before are not counted again
+func clearAbsentCommit(validator *model.Validator) {
+	validator.AbsentCommit = 0
+	validator.SigningWindow.UnpunishedBlocks = 0
+}
+
 // PunishOncallValidator - punish oncall validator if 1) byzantine or 2) missing blocks reach limiation
 func (vm ValidatorManager) PunishOncallValidator(
 	ctx sdk.Context, username types.AccountKey, penalty types.Coin, punishType types.PunishType) (types.Coin, sdk.Error) {
@@ -192,7 +299,7 @@ func (vm ValidatorManager) PunishOncallValidator(
 	}
 
 	if punishType == types.PunishAbsentCommit {
-		validator.AbsentCommit = 0
+		clearAbsentCommit(validator)
 	}
 
 	param, err := vm.paramHolder.GetValidatorParam(ctx)
@@ -200,71 +307,313 @@ func (vm ValidatorManager) PunishOncallValidator(
 		return actualPenalty, err
 	}
 
-	// remove this validator if its remaining deposit is not enough
+	// jail this validator if its remaining deposit is not enough
 	// OR, we explicitly want to fire this validator
-	// all deposit will be added back to inflation pool
+	// jailed validator keeps its remaining deposit and record
 	if punishType == types.PunishByzantine || !validator.Deposit.IsGTE(param.ValidatorMinCommittingDeposit) {
-		if err := vm.RemoveValidatorFromAllLists(ctx, validator.Username); err != nil {
+		if err := vm.jailValidator(ctx, validator, param.JailDurationSec, param.MaxJailDurationSec); err != nil {
 			return actualPenalty, err
 		}
-		actualPenalty = actualPenalty.Plus(validator.Deposit)
-		validator.Deposit = types.NewCoinFromInt64(0)
 	}
 
 	if err := vm.storage.SetValidator(ctx, username, validator); err != nil {
 		return actualPenalty, err
 	}
 
+	if err := vm.addPenaltyRecord(ctx, username, model.PenaltyRecord{
+		PunishType: punishType,
+		Penalty:    actualPenalty,
+		Jailed:     validator.IsJailed,
+		Height:     ctx.BlockHeight(),
+		CreatedAt:  ctx.BlockHeader().Time.Unix(),
+	}); err != nil {
+		return actualPenalty, err
+	}
+
 	if err := vm.AdjustValidatorList(ctx); err != nil {
 		return actualPenalty, err
 	}
 	return actualPenalty, nil
 }
 
-// FireIncompetentValidator - fire oncall validator if 1) deposit insufficient 2) byzantine
+func (vm ValidatorManager) addPenaltyRecord(
+	ctx sdk.Context, username types.AccountKey, record model.PenaltyRecord) sdk.Error {
+	history, err := vm.storage.GetPenaltyHistory(ctx, username)
+	if err != nil {
+		return err
+	}
+	if history == nil {
+		history = &model.PenaltyHistory{}
+	}
+	history.Details = append(history.Details, record)
+	return vm.storage.SetPenaltyHistory(ctx, username, history)
+}
+
+// GetPenaltyHistory - get all penalties of validator
+func (vm ValidatorManager) GetPenaltyHistory(
+	ctx sdk.Context, username types.AccountKey) (*model.PenaltyHistory, sdk.Error) {
+	history, err := vm.storage.GetPenaltyHistory(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	if history == nil {
+		return &model.PenaltyHistory{}, nil
+	}
+	return history, nil
+}
+
+// GetUptime - get percentage of signed blocks in validator signing window
+func (vm ValidatorManager) GetUptime(ctx sdk.Context, username types.AccountKey) (sdk.Rat, sdk.Error) {
+	validator, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return sdk.ZeroRat(), err
+	}
+	window := validator.SigningWindow
+	if window.RecordedBlocks == 0 {
+		return sdk.ZeroRat(), nil
+	}
+	return sdk.NewRat(window.RecordedBlocks-window.MissedBlocks, window.RecordedBlocks), nil
+}
+
+// GetWeightedInflation - split inflation among oncall validators by deposit,
+// each share is scaled by uptime in current inflation period, return unearned inflation as well.
+// Blocks in the period are the most blocks recorded by an oncall validator,
+// so validator joined in the middle of the period doesn't get full uptime
+func (vm ValidatorManager) GetWeightedInflation(
+	ctx sdk.Context, inflation types.Coin) ([]ValidatorInflation, types.Coin, sdk.Error) {
+	lst, err := vm.storage.GetValidatorList(ctx)
+	if err != nil {
+		return nil, inflation, err
+	}
+	validators := []*model.Validator{}
+	totalDeposit := types.NewCoinFromInt64(0)
+	periodBlocks := int64(0)
+	for _, username := range lst.OncallValidators {
+		validator, err := vm.storage.GetValidator(ctx, username)
+		if err != nil {
+			return nil, inflation, err
+		}
+		validators = append(validators, validator)
+		totalDeposit = totalDeposit.Plus(validator.Deposit)
+		period := validator.InflationPeriod
+		if period.SignedBlocks+period.MissedBlocks > periodBlocks {
+			periodBlocks = period.SignedBlocks + period.MissedBlocks
+		}
+	}
+
+	res := []ValidatorInflation{}
+	remaining := inflation
+	if !totalDeposit.IsPositive() || periodBlocks == 0 {
+		return res, remaining, nil
+	}
+	for _, validator := range validators {
+		share := types.RatToCoin(inflation.ToRat().
+			Mul(validator.Deposit.ToRat().Quo(totalDeposit.ToRat())).
+			Mul(sdk.NewRat(validator.InflationPeriod.SignedBlocks, periodBlocks)))
+		if share.IsGT(remaining) {
+			share = remaining
+		}
+		remaining = remaining.Minus(share)
+		res = append(res, ValidatorInflation{Validator: validator.Username, Inflation: share})
+	}
+	return res, remaining, nil
+}
+
+// ResetInflationPeriod - start a new inflation period for all validators
+// after hourly inflation is distributed
+func (vm ValidatorManager) ResetInflationPeriod(ctx sdk.Context) sdk.Error {
+	lst, err := vm.storage.GetValidatorList(ctx)
+	if err != nil {
+		return err
+	}
+	for _, username := range lst.AllValidators {
+		validator, err := vm.storage.GetValidator(ctx, username)
+		if err != nil {
+			return err
+		}
+		if validator.InflationPeriod == (model.InflationPeriod{}) {
+			continue
+		}
+		validator.InflationPeriod = model.InflationPeriod{}
+		if err := vm.storage.SetValidator(ctx, username, validator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecordProposalParticipation - record if oncall validator voted on a decided proposal
+func (vm ValidatorManager) RecordProposalParticipation(
+	ctx sdk.Context, username types.AccountKey, voted bool) sdk.Error {
+	validator, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return err
+	}
+	if voted {
+		validator.VotedProposals++
+	} else {
+		validator.MissedProposals++
+	}
+	return vm.storage.SetValidator(ctx, username, validator)
+}
+
+// jailValidator - remove validator from oncall validator list till jail period ends,
+// jail period is doubled for each repeated offence
+func (vm ValidatorManager) jailValidator(
+	ctx sdk.Context, validator *model.Validator, jailDuration, maxJailDuration int64) sdk.Error {
+	if validator.IsJailed {
+		return nil
+	}
+	lst, err := vm.storage.GetValidatorList(ctx)
+	if err != nil {
+		return err
+	}
+	lst.OncallValidators = remove(validator.Username, lst.OncallValidators)
+	if err := vm.storage.SetValidatorList(ctx, lst); err != nil {
+		return err
+	}
+
+	validator.IsJailed = true
+	validator.JailedTimes++
+	validator.JailedUntil = ctx.BlockHeader().Time.Unix() +
+		getJailDuration(jailDuration, maxJailDuration, validator.JailedTimes)
+	validator.AbsentCommit = 0
+	return nil
+}
+
+// getJailDuration - double jail duration for each repeated offence, at most max jail duration
+func getJailDuration(jailDuration, maxJailDuration, jailedTimes int64) int64 {
+	duration := jailDuration
+	for i := int64(1); i < jailedTimes && duration < maxJailDuration; i++ {
+		duration *= 2
+	}
+	if duration > maxJailDuration {
+		return maxJailDuration
+	}
+	return duration
+}
+
+// Unjail - release validator after jail period ends, validator tries to
+// join the oncall validator list again if its deposit is enough
+func (vm ValidatorManager) Unjail(ctx sdk.Context, username types.AccountKey) sdk.Error {
+	validator, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return err
+	}
+	if !validator.IsJailed {
+		return ErrValidatorNotJailed()
+	}
+	if ctx.BlockHeader().Time.Unix() < validator.JailedUntil {
+		return ErrValidatorStillJailed(validator.JailedUntil)
+	}
+
+	param, err := vm.paramHolder.GetValidatorParam(ctx)
+	if err != nil {
+		return err
+	}
+	if !validator.Deposit.IsGTE(param.ValidatorMinCommittingDeposit) {
+		return ErrInsufficientDeposit()
+	}
+
+	validator.IsJailed = false
+	if err := vm.storage.SetValidator(ctx, username, validator); err != nil {
+		return err
+	}
+	return vm.TryBecomeOncallValidator(ctx, username)
+}
+
+// FireIncompetentValidator - fire oncall validator if 1) deposit insufficient 2) byzantine,
+// return delegator slashes of punished validators if delegator slashing is enabled
 func (vm ValidatorManager) FireIncompetentValidator(
-	ctx sdk.Context, byzantineValidators []abci.Evidence) (types.Coin, sdk.Error) {
+	ctx sdk.Context, byzantineValidators []abci.Evidence) (types.Coin, []DelegatorSlash, sdk.Error) {
 	totalPenalty := types.NewCoinFromInt64(0)
+	slashes := []DelegatorSlash{}
 	lst, err := vm.storage.GetValidatorList(ctx)
 	if err != nil {
-		return totalPenalty, err
+		return totalPenalty, slashes, err
 	}
 
 	param, err := vm.paramHolder.GetValidatorParam(ctx)
 	if err != nil {
-		return totalPenalty, err
+		return totalPenalty, slashes, err
 	}
 
 	for _, validatorName := range lst.OncallValidators {
 		validator, err := vm.storage.GetValidator(ctx, validatorName)
 		if err != nil {
-			return totalPenalty, err
+			return totalPenalty, slashes, err
 		}
 
 		for _, evidence := range byzantineValidators {
-			if reflect.DeepEqual(validator.ABCIValidator.Address, evidence.Validator.Address) {
+			if isEvidenceAgainst(
+				validator, evidence.Validator.Address,
+				ctx.BlockHeader().Time.Unix()-param.KeyUnbondingSec) {
+				if param.SlashDelegators {
+					slashes = append(slashes, DelegatorSlash{
+						Validator: validator.Username,
+						SlashRate: getSlashRate(param.PenaltyByzantine, validator.Deposit),
+					})
+				}
 				actualPenalty, err := vm.PunishOncallValidator(
 					ctx, validator.Username, param.PenaltyByzantine, types.PunishByzantine)
 				if err != nil {
-					return totalPenalty, err
+					return totalPenalty, slashes, err
 				}
 				totalPenalty = totalPenalty.Plus(actualPenalty)
 				break
 			}
 		}
 
+		// reload validator since it may be punished and jailed above
+		validator, err = vm.storage.GetValidator(ctx, validatorName)
+		if err != nil {
+			return totalPenalty, slashes, err
+		}
+		if validator.IsJailed {
+			continue
+		}
+
 		if validator.AbsentCommit > param.AbsentCommitLimitation {
+			if param.SlashDelegators {
+				slashes = append(slashes, DelegatorSlash{
+					Validator: validator.Username,
+					SlashRate: getSlashRate(param.PenaltyMissCommit, validator.Deposit),
+				})
+			}
 			actualPenalty, err := vm.PunishOncallValidator(
 				ctx, validator.Username, param.PenaltyMissCommit, types.PunishAbsentCommit)
 			if err != nil {
-				return totalPenalty, err
+				return totalPenalty, slashes, err
 			}
 
 			totalPenalty = totalPenalty.Plus(actualPenalty)
 		}
 	}
 
-	return totalPenalty, nil
+	return totalPenalty, slashes, nil
+}
+
+// isEvidenceAgainst - check if evidence address is validator's current key
+// or a key retired after unbonding lower bound
+func isEvidenceAgainst(validator *model.Validator, address []byte, unbondingLowerBound int64) bool {
+	if reflect.DeepEqual(validator.ABCIValidator.Address, address) {
+		return true
+	}
+	for _, retiredKey := range validator.RetiredKeys {
+		if retiredKey.RetiredAt > unbondingLowerBound &&
+			reflect.DeepEqual(retiredKey.ABCIValidator.Address, address) {
+			return true
+		}
+	}
+	return false
+}
+
+// getSlashRate - delegations are slashed at the same rate as validator deposit, at most all of it
+func getSlashRate(penalty types.Coin, deposit types.Coin) sdk.Rat {
+	if !deposit.IsPositive() || penalty.IsGTE(deposit) {
+		return sdk.OneRat()
+	}
+	return penalty.ToRat().Quo(deposit.ToRat())
 }
 
 // PunishValidatorsDidntVote - validators are required to vote Protocol Upgrade and Parameter Change proposal
@@ -300,19 +649,12 @@ func (vm ValidatorManager) RegisterValidator(
 	}
 
 	// make sure the pub key has not been registered
-	lst, err := vm.GetValidatorList(ctx)
+	inUse, err := vm.isPubKeyInUse(ctx, pubKey)
 	if err != nil {
 		return err
 	}
-
-	for _, validatorName := range lst.AllValidators {
-		validator, err := vm.storage.GetValidator(ctx, validatorName)
-		if err != nil {
-			return err
-		}
-		if reflect.DeepEqual(validator.ABCIValidator.PubKey, tmtypes.TM2PB.PubKey(pubKey)) {
-			return ErrValidatorPubKeyAlreadyExist()
-		}
+	if inUse {
+		return ErrValidatorPubKeyAlreadyExist()
 	}
 	curValidator := &model.Validator{
 		ABCIValidator: abci.Validator{Address: pubKey.Address(), PubKey: tmtypes.TM2PB.PubKey(pubKey), Power: 1000},
@@ -327,6 +669,83 @@ func (vm ValidatorManager) RegisterValidator(
 	return nil
 }
 
+// isPubKeyInUse - check if pub key is current or retired key of any validator
+func (vm ValidatorManager) isPubKeyInUse(ctx sdk.Context, pubKey crypto.PubKey) (bool, sdk.Error) {
+	lst, err := vm.GetValidatorList(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	abciPubKey := tmtypes.TM2PB.PubKey(pubKey)
+	for _, validatorName := range lst.AllValidators {
+		validator, err := vm.storage.GetValidator(ctx, validatorName)
+		if err != nil {
+			return false, err
+		}
+		if reflect.DeepEqual(validator.ABCIValidator.PubKey, abciPubKey) {
+			return true, nil
+		}
+		for _, retiredKey := range validator.RetiredKeys {
+			if reflect.DeepEqual(retiredKey.ABCIValidator.PubKey, abciPubKey) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// RotateValidatorKey - replace validator consensus key, old key is retired and
+// still tracked for byzantine evidence till unbonding period ends
+func (vm ValidatorManager) RotateValidatorKey(
+	ctx sdk.Context, username types.AccountKey, pubKey crypto.PubKey) sdk.Error {
+	validator, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	// key retired in this block must be the one in consensus validator set
+	if _, rotated := getKeyRetiredAt(validator, ctx.BlockHeight()); rotated {
+		return ErrKeyRotationTooOften()
+	}
+
+	inUse, err := vm.isPubKeyInUse(ctx, pubKey)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return ErrValidatorPubKeyAlreadyExist()
+	}
+
+	param, err := vm.paramHolder.GetValidatorParam(ctx)
+	if err != nil {
+		return err
+	}
+
+	// drop retired keys which are out of unbonding period
+	now := ctx.BlockHeader().Time.Unix()
+	retiredKeys := []model.RetiredKey{}
+	for _, retiredKey := range validator.RetiredKeys {
+		if retiredKey.RetiredAt > now-param.KeyUnbondingSec {
+			retiredKeys = append(retiredKeys, retiredKey)
+		}
+	}
+	validator.RetiredKeys = append(retiredKeys, model.RetiredKey{
+		ABCIValidator: validator.ABCIValidator,
+		RetiredAt:     now,
+		RetiredHeight: ctx.BlockHeight(),
+	})
+	validator.ABCIValidator = abci.Validator{
+		Address: pubKey.Address(),
+		PubKey:  tmtypes.TM2PB.PubKey(pubKey),
+		Power:   validator.ABCIValidator.Power,
+	}
+
+	if err := vm.storage.SetValidator(ctx, username, validator); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Deposit - deposit money to validator
 func (vm ValidatorManager) Deposit(
 	ctx sdk.Context, username types.AccountKey, coin types.Coin, link string) sdk.Error {
@@ -344,6 +763,106 @@ func (vm ValidatorManager) Deposit(
 	return nil
 }
 
+// UpdateValidatorDescription - update validator description, empty field is left unchanged
+func (vm ValidatorManager) UpdateValidatorDescription(
+	ctx sdk.Context, username types.AccountKey, description model.Description) sdk.Error {
+	validator, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return err
+	}
+	if len(description.Moniker) > 0 {
+		validator.Description.Moniker = description.Moniker
+	}
+	if len(description.Website) > 0 {
+		validator.Description.Website = description.Website
+	}
+	if len(description.Details) > 0 {
+		validator.Description.Details = description.Details
+	}
+	if len(description.Identity) > 0 {
+		validator.Description.Identity = description.Identity
+	}
+	return vm.storage.SetValidator(ctx, username, validator)
+}
+
+// InitValidatorCommission - set validator commission for the first time,
+// max rate and max change rate can't be changed after that. The rate can differ
+// from current rate, which is the voter commission rate, by at most max change rate
+func (vm ValidatorManager) InitValidatorCommission(
+	ctx sdk.Context, username types.AccountKey, currentRate, rate, maxRate, maxChangeRate sdk.Rat) sdk.Error {
+	validator, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return err
+	}
+	if validator.Commission != nil {
+		return ErrCommissionLimitImmutable()
+	}
+	if maxChangeRate.GT(maxRate) {
+		return ErrInvalidValidatorCommissionRate()
+	}
+	if rate.GT(maxRate) {
+		return ErrCommissionRateExceedMaxRate()
+	}
+	change := rate.Sub(currentRate)
+	if change.GT(maxChangeRate) || change.LT(maxChangeRate.Mul(sdk.NewRat(-1))) {
+		return ErrCommissionRateChangeTooLarge()
+	}
+	validator.Commission = &model.Commission{
+		Rate:          rate,
+		MaxRate:       maxRate,
+		MaxChangeRate: maxChangeRate,
+		UpdatedAt:     ctx.BlockHeader().Time.Unix(),
+	}
+	return vm.storage.SetValidator(ctx, username, validator)
+}
+
+// UpdateValidatorCommissionRate - change validator commission rate within its limits,
+// commission must be initialized with limits first
+func (vm ValidatorManager) UpdateValidatorCommissionRate(
+	ctx sdk.Context, username types.AccountKey, rate sdk.Rat) sdk.Error {
+	validator, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return err
+	}
+	if validator.Commission == nil {
+		return ErrCommissionLimitRequired()
+	}
+	commission := validator.Commission
+	if rate.Equal(commission.Rate) {
+		return nil
+	}
+	param, err := vm.paramHolder.GetValidatorParam(ctx)
+	if err != nil {
+		return err
+	}
+	if ctx.BlockHeader().Time.Unix() < commission.UpdatedAt+param.CommissionUpdateIntervalSec {
+		return ErrCommissionUpdateTooOften()
+	}
+	if rate.GT(commission.MaxRate) {
+		return ErrCommissionRateExceedMaxRate()
+	}
+	change := rate.Sub(commission.Rate)
+	if change.GT(commission.MaxChangeRate) || change.LT(commission.MaxChangeRate.Mul(sdk.NewRat(-1))) {
+		return ErrCommissionRateChangeTooLarge()
+	}
+	commission.Rate = rate
+	commission.UpdatedAt = ctx.BlockHeader().Time.Unix()
+	return vm.storage.SetValidator(ctx, username, validator)
+}
+
+// GetCommissionRate - get validator commission rate, return false if commission is not set
+func (vm ValidatorManager) GetCommissionRate(
+	ctx sdk.Context, username types.AccountKey) (sdk.Rat, bool, sdk.Error) {
+	validator, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return sdk.ZeroRat(), false, err
+	}
+	if validator.Commission == nil {
+		return sdk.ZeroRat(), false, nil
+	}
+	return validator.Commission.Rate, true, nil
+}
+
 // ValidatorWithdraw - this method won't check if it is a legal withdraw, caller should check by itself
 func (vm ValidatorManager) ValidatorWithdraw(ctx sdk.Context, username types.AccountKey, coin types.Coin) sdk.Error {
 	if coin.IsZero() {
@@ -358,7 +877,8 @@ func (vm ValidatorManager) ValidatorWithdraw(ctx sdk.Context, username types.Acc
 		return err
 	}
 
-	return nil
+	// standby ranking depends on deposit
+	return vm.updateStandbyValidators(ctx)
 }
 
 // ValidatorWithdrawAll - revoke validator
@@ -389,12 +909,16 @@ func (vm ValidatorManager) TryBecomeOncallValidator(ctx sdk.Context, username ty
 	if !curValidator.Deposit.IsGTE(param.ValidatorMinCommittingDeposit) {
 		return ErrInsufficientDeposit()
 	}
+	if curValidator.IsJailed {
+		return ErrValidatorJailed()
+	}
 
 	lst, err := vm.storage.GetValidatorList(ctx)
 	if err != nil {
 		return err
 	}
 	defer vm.updateLowestValidator(ctx)
+	defer vm.updateStandbyValidators(ctx)
 	// has alreay in the oncall validator list
 	if types.FindAccountInList(username, lst.OncallValidators) != -1 {
 		return nil
@@ -457,9 +981,35 @@ func (vm ValidatorManager) RemoveValidatorFromAllLists(ctx sdk.Context, username
 // if any change happens in oncall validator(remove, punish),
 // we should call this function to adjust validator list
 func (vm ValidatorManager) AdjustValidatorList(ctx sdk.Context) sdk.Error {
+	param, err := vm.paramHolder.GetValidatorParam(ctx)
+	if err != nil {
+		return err
+	}
+	// promote best candidates till oncall validator list is full
+	for {
+		lst, err := vm.storage.GetValidatorList(ctx)
+		if err != nil {
+			return err
+		}
+		if int64(len(lst.OncallValidators)) >= param.ValidatorListSize {
+			break
+		}
+		bestCandidate, err := vm.getBestCandidate(ctx)
+		if err != nil {
+			return err
+		}
+		if bestCandidate == types.AccountKey("") {
+			break
+		}
+		if err := vm.TryBecomeOncallValidator(ctx, bestCandidate); err != nil {
+			return err
+		}
+	}
+
 	if err := vm.updateLowestValidator(ctx); err != nil {
 		return err
 	}
+	// best candidate replaces the lowest oncall validator if it has more deposit
 	bestCandidate, err := vm.getBestCandidate(ctx)
 	if err != nil {
 		return err
@@ -470,7 +1020,7 @@ func (vm ValidatorManager) AdjustValidatorList(ctx sdk.Context) sdk.Error {
 			return err
 		}
 	}
-	return nil
+	return vm.updateStandbyValidators(ctx)
 }
 
 func remove(me types.AccountKey, users []types.AccountKey) []types.AccountKey {
@@ -522,26 +1072,110 @@ func (vm ValidatorManager) updateLowestValidator(ctx sdk.Context) sdk.Error {
 // find the person has the biggest power among people in the allValidators lists
 // but not in the oncall validator list
 func (vm ValidatorManager) getBestCandidate(ctx sdk.Context) (types.AccountKey, sdk.Error) {
-	bestCandidate := types.AccountKey("")
-	bestCandidatePower := types.NewCoinFromInt64(0)
+	candidates, err := vm.getRankedCandidates(ctx)
+	if err != nil {
+		return types.AccountKey(""), err
+	}
+	if len(candidates) == 0 {
+		return types.AccountKey(""), nil
+	}
+	return candidates[0], nil
+}
 
+// getRankedCandidates - validators which are qualified to be oncall validator
+// but not in the oncall validator list, ranked by deposit
+func (vm ValidatorManager) getRankedCandidates(ctx sdk.Context) ([]types.AccountKey, sdk.Error) {
 	lst, err := vm.storage.GetValidatorList(ctx)
 	if err != nil {
-		return bestCandidate, err
+		return nil, err
+	}
+	param, err := vm.paramHolder.GetValidatorParam(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	for i, validatorName := range lst.AllValidators {
-		validator, err := vm.storage.GetValidator(ctx, lst.AllValidators[i])
+	candidates := []*model.Validator{}
+	for _, validatorName := range lst.AllValidators {
+		validator, err := vm.storage.GetValidator(ctx, validatorName)
 		if err != nil {
-			return bestCandidate, err
+			return nil, err
 		}
-		// not in the oncall list and has a larger power
-		if types.FindAccountInList(validatorName, lst.OncallValidators) == -1 &&
-			validator.Deposit.IsGT(bestCandidatePower) {
-			bestCandidate = validator.Username
-			bestCandidatePower = validator.Deposit
+		// not jailed, not in the oncall list and has enough deposit
+		if !validator.IsJailed &&
+			types.FindAccountInList(validatorName, lst.OncallValidators) == -1 &&
+			validator.Deposit.IsGTE(param.ValidatorMinCommittingDeposit) {
+			candidates = append(candidates, validator)
 		}
 	}
-	return bestCandidate, nil
+	// validator registered earlier wins if deposits are equal
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Deposit.IsGT(candidates[j].Deposit)
+	})
+
+	res := []types.AccountKey{}
+	for _, candidate := range candidates {
+		res = append(res, candidate.Username)
+	}
+	return res, nil
+}
 
+// updateStandbyValidators - rank standby validators among candidates
+func (vm ValidatorManager) updateStandbyValidators(ctx sdk.Context) sdk.Error {
+	candidates, err := vm.getRankedCandidates(ctx)
+	if err != nil {
+		return err
+	}
+	param, err := vm.paramHolder.GetValidatorParam(ctx)
+	if err != nil {
+		return err
+	}
+	if int64(len(candidates)) > param.StandbyListSize {
+		candidates = candidates[:param.StandbyListSize]
+	}
+
+	lst, err := vm.storage.GetValidatorList(ctx)
+	if err != nil {
+		return err
+	}
+	lst.StandbyValidators = candidates
+	return vm.storage.SetValidatorList(ctx, lst)
+}
+
+// Heartbeat - record validator is online, standby validator with
+// recent heartbeat shares validator inflation
+func (vm ValidatorManager) Heartbeat(ctx sdk.Context, username types.AccountKey) sdk.Error {
+	validator, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return err
+	}
+	if validator.IsJailed {
+		return ErrValidatorJailed()
+	}
+	validator.LastHeartbeatAt = ctx.BlockHeader().Time.Unix()
+	return vm.storage.SetValidator(ctx, username, validator)
+}
+
+// GetOnlineStandbyValidators - get standby validators which sent heartbeat in heartbeat interval
+func (vm ValidatorManager) GetOnlineStandbyValidators(ctx sdk.Context) ([]types.AccountKey, sdk.Error) {
+	lst, err := vm.storage.GetValidatorList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	param, err := vm.paramHolder.GetValidatorParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []types.AccountKey{}
+	for _, username := range lst.StandbyValidators {
+		validator, err := vm.storage.GetValidator(ctx, username)
+		if err != nil {
+			return nil, err
+		}
+		if validator.LastHeartbeatAt > 0 &&
+			validator.LastHeartbeatAt >= ctx.BlockHeader().Time.Unix()-param.HeartbeatIntervalSec {
+			res = append(res, username)
+		}
+	}
+	return res, nil
 }
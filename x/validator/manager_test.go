@@ -4,6 +4,7 @@ import (
 	"math/rand"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/validator/model"
@@ -50,16 +51,34 @@ func TestByzantines(t *testing.T) {
 			PubKey:  tmtypes.TM2PB.PubKey(valKeys[idx]),
 			Power:   1000}})
 	}
-	_, err := valManager.FireIncompetentValidator(ctx, byzantines)
+	// byzantine validator which also misses too many blocks is only punished once
+	absentValidator, _ := valManager.storage.GetValidator(ctx, users[byzantineList[0]])
+	absentValidator.AbsentCommit = valParam.AbsentCommitLimitation + 1
+	valManager.storage.SetValidator(ctx, users[byzantineList[0]], absentValidator)
+
+	// byzantine penalty is larger than deposit, whole deposit is taken
+	expectPenalty := types.NewCoinFromInt64(0)
+	for _, idx := range byzantineList {
+		validator, _ := valManager.storage.GetValidator(ctx, users[idx])
+		expectPenalty = expectPenalty.Plus(validator.Deposit)
+	}
+	totalPenalty, _, err := valManager.FireIncompetentValidator(ctx, byzantines)
+	assert.Nil(t, err)
+	assert.True(t, expectPenalty.IsEqual(totalPenalty))
+	history, err := valManager.GetPenaltyHistory(ctx, users[byzantineList[0]])
 	assert.Nil(t, err)
+	assert.Equal(t, 1, len(history.Details))
+	assert.Equal(t, types.PunishByzantine, history.Details[0].PunishType)
 
 	validatorList3, _ := valManager.storage.GetValidatorList(ctx)
 	assert.Equal(t, 18, len(validatorList3.OncallValidators))
-	assert.Equal(t, 18, len(validatorList3.AllValidators))
+	assert.Equal(t, 21, len(validatorList3.AllValidators))
 
+	// byzantine validators are jailed but still recorded
 	for _, idx := range byzantineList {
 		assert.Equal(t, -1, types.FindAccountInList(users[idx], validatorList3.OncallValidators))
-		assert.Equal(t, -1, types.FindAccountInList(users[idx], validatorList3.AllValidators))
+		assert.NotEqual(t, -1, types.FindAccountInList(users[idx], validatorList3.AllValidators))
+		assert.True(t, valManager.IsJailed(ctx, users[idx]))
 	}
 
 }
@@ -148,16 +167,17 @@ func TestAbsentValidatorWillBeFired(t *testing.T) {
 		}
 	}
 
-	_, err = valManager.FireIncompetentValidator(ctx, []abci.Evidence{})
+	_, _, err = valManager.FireIncompetentValidator(ctx, []abci.Evidence{})
 	assert.Nil(t, err)
 	validatorList2, _ := valManager.storage.GetValidatorList(ctx)
 
 	assert.Equal(t, 18, len(validatorList2.OncallValidators))
-	assert.Equal(t, 18, len(validatorList2.AllValidators))
+	assert.Equal(t, 21, len(validatorList2.AllValidators))
 
 	for _, idx := range absentList {
 		assert.Equal(t, -1, types.FindAccountInList(types.AccountKey("user"+strconv.Itoa(idx)), validatorList2.OncallValidators))
-		assert.Equal(t, -1, types.FindAccountInList(types.AccountKey("user"+strconv.Itoa(idx)), validatorList2.AllValidators))
+		assert.NotEqual(t, -1, types.FindAccountInList(types.AccountKey("user"+strconv.Itoa(idx)), validatorList2.AllValidators))
+		assert.True(t, valManager.IsJailed(ctx, types.AccountKey("user"+strconv.Itoa(idx))))
 	}
 }
 
@@ -245,7 +265,7 @@ func TestAbsentValidatorWontBeFired(t *testing.T) {
 		}
 	}
 
-	_, err = valManager.FireIncompetentValidator(ctx, []abci.Evidence{})
+	_, _, err = valManager.FireIncompetentValidator(ctx, []abci.Evidence{})
 	assert.Nil(t, err)
 	validatorList2, _ := valManager.storage.GetValidatorList(ctx)
 
@@ -321,24 +341,27 @@ func TestPunishmentBasic(t *testing.T) {
 	handler(ctx, msg1)
 	handler(ctx, msg2)
 
-	// punish user2 as byzantine (explicitly remove)
+	// punish user2 as byzantine (explicitly jail)
 	valManager.PunishOncallValidator(ctx, types.AccountKey("user2"), valParam.PenaltyByzantine, types.PunishByzantine)
 	lst, _ := valManager.storage.GetValidatorList(ctx)
 	assert.Equal(t, 1, len(lst.OncallValidators))
-	assert.Equal(t, 1, len(lst.AllValidators))
+	assert.Equal(t, 2, len(lst.AllValidators))
 	assert.Equal(t, types.AccountKey("user1"), lst.OncallValidators[0])
 
 	validator, _ := valManager.storage.GetValidator(ctx, "user2")
 	assert.Equal(t, true, validator.Deposit.IsZero())
+	assert.Equal(t, true, validator.IsJailed)
 
-	// punish user1 as missing vote (wont explicitly remove)
+	// punish user1 as missing vote (wont explicitly jail)
+	// remaining deposit is less than minimum requirement, user1 is jailed with remaining deposit
 	valManager.PunishOncallValidator(ctx, types.AccountKey("user1"), valParam.PenaltyMissVote, types.PunishDidntVote)
 	lst2, _ := valManager.storage.GetValidatorList(ctx)
 	assert.Equal(t, 0, len(lst2.OncallValidators))
-	assert.Equal(t, 0, len(lst2.AllValidators))
+	assert.Equal(t, 2, len(lst2.AllValidators))
 
 	validator2, _ := valManager.storage.GetValidator(ctx, "user1")
-	assert.Equal(t, true, validator2.Deposit.IsZero())
+	assert.Equal(t, valParam.ValidatorMinCommittingDeposit.Minus(valParam.PenaltyMissVote), validator2.Deposit)
+	assert.Equal(t, true, validator2.IsJailed)
 }
 
 func TestPunishmentAndSubstitutionExists(t *testing.T) {
@@ -537,3 +560,268 @@ func TestIsLegalWithdraw(t *testing.T) {
 		}
 	}
 }
+
+func TestGetSlashRate(t *testing.T) {
+	testCases := []struct {
+		testName     string
+		penalty      types.Coin
+		deposit      types.Coin
+		expectedRate sdk.Rat
+	}{
+		{
+			testName:     "partial penalty",
+			penalty:      types.NewCoinFromInt64(100 * types.Decimals),
+			deposit:      types.NewCoinFromInt64(1000 * types.Decimals),
+			expectedRate: sdk.NewRat(1, 10),
+		},
+		{
+			testName:     "penalty exceeds deposit",
+			penalty:      types.NewCoinFromInt64(2000 * types.Decimals),
+			deposit:      types.NewCoinFromInt64(1000 * types.Decimals),
+			expectedRate: sdk.OneRat(),
+		},
+		{
+			testName:     "empty deposit",
+			penalty:      types.NewCoinFromInt64(100 * types.Decimals),
+			deposit:      types.NewCoinFromInt64(0),
+			expectedRate: sdk.OneRat(),
+		},
+	}
+	for _, tc := range testCases {
+		rate := getSlashRate(tc.penalty, tc.deposit)
+		if !rate.Equal(tc.expectedRate) {
+			t.Errorf("%s: diff slash rate, got %v, want %v", tc.testName, rate, tc.expectedRate)
+		}
+	}
+}
+
+func TestGetJailDuration(t *testing.T) {
+	testCases := []struct {
+		testName         string
+		jailedTimes      int64
+		expectedDuration int64
+	}{
+		{
+			testName:         "first offence",
+			jailedTimes:      1,
+			expectedDuration: 100,
+		},
+		{
+			testName:         "third offence",
+			jailedTimes:      3,
+			expectedDuration: 400,
+		},
+		{
+			testName:         "jail duration is capped",
+			jailedTimes:      100,
+			expectedDuration: 1000,
+		},
+	}
+	for _, tc := range testCases {
+		duration := getJailDuration(100, 1000, tc.jailedTimes)
+		if duration != tc.expectedDuration {
+			t.Errorf("%s: diff jail duration, got %v, want %v", tc.testName, duration, tc.expectedDuration)
+		}
+	}
+}
+
+func TestUpdateSigningWindow(t *testing.T) {
+	validator := &model.Validator{}
+	windowSize := int64(4)
+
+	// missed, missed, signed, signed
+	for _, signed := range []bool{false, false, true, true} {
+		updateSigningWindow(validator, signed, windowSize)
+	}
+	assert.Equal(t, int64(4), validator.SigningWindow.RecordedBlocks)
+	assert.Equal(t, int64(2), validator.SigningWindow.MissedBlocks)
+	assert.Equal(t, int64(2), validator.AbsentCommit)
+	assert.Equal(t, []byte{0xc}, validator.SigningWindow.Bitmap)
+
+	// the first missed block slides out of window
+	updateSigningWindow(validator, true, windowSize)
+	assert.Equal(t, int64(4), validator.SigningWindow.RecordedBlocks)
+	assert.Equal(t, int64(1), validator.SigningWindow.MissedBlocks)
+	assert.Equal(t, int64(1), validator.AbsentCommit)
+
+	// punished absent commit isn't counted again when missed block slides out
+	clearAbsentCommit(validator)
+	updateSigningWindow(validator, false, windowSize)
+	assert.Equal(t, int64(1), validator.SigningWindow.MissedBlocks)
+	assert.Equal(t, int64(1), validator.AbsentCommit)
+	updateSigningWindow(validator, true, windowSize)
+	updateSigningWindow(validator, true, windowSize)
+	updateSigningWindow(validator, true, windowSize)
+	assert.Equal(t, int64(1), validator.SigningWindow.MissedBlocks)
+	assert.Equal(t, int64(1), validator.AbsentCommit)
+
+	// window restarts if window size changes
+	updateSigningWindow(validator, false, windowSize+1)
+	assert.Equal(t, windowSize+1, validator.SigningWindow.Size)
+	assert.Equal(t, int64(1), validator.SigningWindow.RecordedBlocks)
+	assert.Equal(t, int64(1), validator.SigningWindow.MissedBlocks)
+	assert.Equal(t, int64(1), validator.AbsentCommit)
+
+	// absent commits are counted without window if window is not configured
+	validator = &model.Validator{}
+	updateSigningWindow(validator, false, 0)
+	updateSigningWindow(validator, true, 0)
+	updateSigningWindow(validator, false, 0)
+	assert.Equal(t, int64(2), validator.AbsentCommit)
+	assert.Equal(t, int64(0), validator.SigningWindow.RecordedBlocks)
+}
+
+func TestAlwaysAbsentValidatorIsPunishedInEveryWindow(t *testing.T) {
+	validator := &model.Validator{}
+	windowSize := int64(10)
+	limitation := int64(3)
+
+	// validator misses every block for more than two full windows
+	punishedAt := []int64{}
+	for height := int64(1); height <= 3*windowSize; height++ {
+		updateSigningWindow(validator, false, windowSize)
+		if validator.AbsentCommit > limitation {
+			punishedAt = append(punishedAt, height)
+			clearAbsentCommit(validator)
+		}
+	}
+	assert.Equal(t, []int64{4, 8, 12, 16, 20, 24, 28}, punishedAt)
+	assert.Equal(t, windowSize, validator.SigningWindow.MissedBlocks)
+	assert.Equal(t, int64(2), validator.AbsentCommit)
+}
+
+func TestValidatorStats(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, gm)
+	valManager.InitGenesis(ctx)
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	minBalance := types.NewCoinFromInt64(100000 * types.Decimals)
+	createTestAccount(ctx, am, "user1", minBalance.Plus(valParam.ValidatorMinCommittingDeposit))
+	voteManager.AddVoter(ctx, "user1", valParam.ValidatorMinVotingDeposit)
+	valKey := secp256k1.GenPrivKey().PubKey()
+	result := handler(ctx, NewValidatorDepositMsg(
+		"user1", coinToString(valParam.ValidatorMinCommittingDeposit.Plus(valParam.PenaltyMissVote)), valKey, ""))
+	assert.Equal(t, sdk.Result{}, result)
+
+	uptime, err := valManager.GetUptime(ctx, "user1")
+	assert.Nil(t, err)
+	assert.True(t, uptime.Equal(sdk.ZeroRat()))
+
+	signingList := []abci.SigningValidator{
+		{
+			Validator: abci.Validator{
+				Address: valKey.Address(),
+				PubKey:  tmtypes.TM2PB.PubKey(valKey),
+				Power:   1000},
+			SignedLastBlock: true,
+		},
+	}
+	for i := 0; i < 3; i++ {
+		err := valManager.UpdateSigningValidator(ctx, signingList)
+		assert.Nil(t, err)
+	}
+	signingList[0].SignedLastBlock = false
+	err = valManager.UpdateSigningValidator(ctx, signingList)
+	assert.Nil(t, err)
+	uptime, err = valManager.GetUptime(ctx, "user1")
+	assert.Nil(t, err)
+	assert.True(t, uptime.Equal(sdk.NewRat(3, 4)))
+	validator, _ := valManager.storage.GetValidator(ctx, "user1")
+	assert.Equal(t, model.InflationPeriod{SignedBlocks: 3, MissedBlocks: 1}, validator.InflationPeriod)
+
+	assert.Nil(t, valManager.RecordProposalParticipation(ctx, "user1", true))
+	assert.Nil(t, valManager.RecordProposalParticipation(ctx, "user1", false))
+	validator, _ = valManager.storage.GetValidator(ctx, "user1")
+	assert.Equal(t, int64(1), validator.VotedProposals)
+	assert.Equal(t, int64(1), validator.MissedProposals)
+
+	// penalty history records every penalty
+	history, err := valManager.GetPenaltyHistory(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(history.Details))
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Height: 5, Time: time.Unix(100, 0)})
+	_, err = valManager.PunishOncallValidator(ctx, "user1", valParam.PenaltyMissVote, types.PunishDidntVote)
+	assert.Nil(t, err)
+	_, err = valManager.PunishOncallValidator(ctx, "user1", valParam.PenaltyMissVote, types.PunishDidntVote)
+	assert.Nil(t, err)
+	history, err = valManager.GetPenaltyHistory(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, []model.PenaltyRecord{
+		{
+			PunishType: types.PunishDidntVote,
+			Penalty:    valParam.PenaltyMissVote,
+			Jailed:     false,
+			Height:     5,
+			CreatedAt:  100,
+		},
+		{
+			PunishType: types.PunishDidntVote,
+			Penalty:    valParam.PenaltyMissVote,
+			Jailed:     true,
+			Height:     5,
+			CreatedAt:  100,
+		},
+	}, history.Details)
+
+	// uptime statistics are kept after punishment
+	uptime, err = valManager.GetUptime(ctx, "user1")
+	assert.Nil(t, err)
+	assert.True(t, uptime.Equal(sdk.NewRat(3, 4)))
+}
+
+func TestGetWeightedInflation(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, gm)
+	valManager.InitGenesis(ctx)
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	for i := 1; i <= 3; i++ {
+		name := "user" + strconv.Itoa(i)
+		createTestAccount(ctx, am, name, minBalance.Plus(valParam.ValidatorMinCommittingDeposit))
+		voteManager.AddVoter(ctx, types.AccountKey(name), valParam.ValidatorMinVotingDeposit)
+		result := handler(ctx, NewValidatorDepositMsg(
+			name, coinToString(valParam.ValidatorMinCommittingDeposit), secp256k1.GenPrivKey().PubKey(), ""))
+		assert.Equal(t, sdk.Result{}, result)
+	}
+
+	// user1 signed all blocks, user2 signed 3/4 blocks with double deposit,
+	// user3 joined in the middle of the period and signed all blocks since then
+	testCases := []struct {
+		username     types.AccountKey
+		deposit      types.Coin
+		signedBlocks int64
+		missedBlocks int64
+	}{
+		{"user1", types.NewCoinFromInt64(100 * types.Decimals), 4, 0},
+		{"user2", types.NewCoinFromInt64(200 * types.Decimals), 3, 1},
+		{"user3", types.NewCoinFromInt64(100 * types.Decimals), 2, 0},
+	}
+	for _, tc := range testCases {
+		validator, _ := valManager.storage.GetValidator(ctx, tc.username)
+		validator.Deposit = tc.deposit
+		validator.InflationPeriod = model.InflationPeriod{
+			SignedBlocks: tc.signedBlocks,
+			MissedBlocks: tc.missedBlocks,
+		}
+		valManager.storage.SetValidator(ctx, tc.username, validator)
+	}
+
+	inflations, unearned, err := valManager.GetWeightedInflation(ctx, types.NewCoinFromInt64(400*types.Decimals))
+	assert.Nil(t, err)
+	assert.Equal(t, []ValidatorInflation{
+		{Validator: "user1", Inflation: types.NewCoinFromInt64(100 * types.Decimals)},
+		{Validator: "user2", Inflation: types.NewCoinFromInt64(150 * types.Decimals)},
+		{Validator: "user3", Inflation: types.NewCoinFromInt64(50 * types.Decimals)},
+	}, inflations)
+	assert.Equal(t, types.NewCoinFromInt64(100*types.Decimals), unearned)
+
+	// no block is recorded in new period
+	err = valManager.ResetInflationPeriod(ctx)
+	assert.Nil(t, err)
+	inflations, unearned, err = valManager.GetWeightedInflation(ctx, types.NewCoinFromInt64(400*types.Decimals))
+	assert.Nil(t, err)
+	assert.Equal(t, []ValidatorInflation{}, inflations)
+	assert.Equal(t, types.NewCoinFromInt64(400*types.Decimals), unearned)
+}
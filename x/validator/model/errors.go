@@ -25,6 +25,10 @@ func ErrFailedToMarshalValidatorList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToMarshalValidatorList, fmt.Sprintf("failed to marshal validator list: %s", err.Error()))
 }
 
+func ErrFailedToMarshalPenaltyHistory(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalPenaltyHistory, fmt.Sprintf("failed to marshal penalty history: %s", err.Error()))
+}
+
 // unmarshal error
 func ErrFailedToUnmarshalValidator(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalValidator, fmt.Sprintf("failed to unmarshal validator: %s", err.Error()))
@@ -33,3 +37,7 @@ func ErrFailedToUnmarshalValidator(err error) sdk.Error {
 func ErrFailedToUnmarshalValidatorList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalValidatorList, fmt.Sprintf("failed to unmarshal validator list: %s", err.Error()))
 }
+
+func ErrFailedToUnmarshalPenaltyHistory(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalPenaltyHistory, fmt.Sprintf("failed to unmarshal penalty history: %s", err.Error()))
+}
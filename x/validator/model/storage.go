@@ -7,8 +7,9 @@ import (
 )
 
 var (
-	validatorSubstore     = []byte{0x00}
-	validatorListSubstore = []byte{0x01}
+	validatorSubstore      = []byte{0x00}
+	validatorListSubstore  = []byte{0x01}
+	penaltyHistorySubstore = []byte{0x02}
 )
 
 type ValidatorStorage struct {
@@ -94,6 +95,32 @@ func (vs ValidatorStorage) SetValidatorList(ctx sdk.Context, lst *ValidatorList)
 	return nil
 }
 
+// GetPenaltyHistory - get validator penalty history, return nil if there is no penalty
+func (vs ValidatorStorage) GetPenaltyHistory(ctx sdk.Context, accKey types.AccountKey) (*PenaltyHistory, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	historyByte := store.Get(GetPenaltyHistoryKey(accKey))
+	if historyByte == nil {
+		return nil, nil
+	}
+	history := new(PenaltyHistory)
+	if err := vs.cdc.UnmarshalJSON(historyByte, history); err != nil {
+		return nil, ErrFailedToUnmarshalPenaltyHistory(err)
+	}
+	return history, nil
+}
+
+// SetPenaltyHistory - set validator penalty history
+func (vs ValidatorStorage) SetPenaltyHistory(
+	ctx sdk.Context, accKey types.AccountKey, history *PenaltyHistory) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	historyByte, err := vs.cdc.MarshalJSON(*history)
+	if err != nil {
+		return ErrFailedToMarshalPenaltyHistory(err)
+	}
+	store.Set(GetPenaltyHistoryKey(accKey), historyByte)
+	return nil
+}
+
 func GetValidatorKey(accKey types.AccountKey) []byte {
 	return append(validatorSubstore, accKey...)
 }
@@ -101,3 +128,7 @@ func GetValidatorKey(accKey types.AccountKey) []byte {
 func GetValidatorListKey() []byte {
 	return validatorListSubstore
 }
+
+func GetPenaltyHistoryKey(accKey types.AccountKey) []byte {
+	return append(penaltyHistorySubstore, accKey...)
+}
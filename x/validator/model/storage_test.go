@@ -121,3 +121,28 @@ func TestValidatorList(t *testing.T) {
 		}
 	}
 }
+
+func TestPenaltyHistory(t *testing.T) {
+	ctx, vs := setup(t)
+
+	history, err := vs.GetPenaltyHistory(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Nil(t, history)
+
+	expectHistory := &PenaltyHistory{
+		Details: []PenaltyRecord{
+			{
+				PunishType: types.PunishByzantine,
+				Penalty:    types.NewCoinFromInt64(100),
+				Jailed:     true,
+				Height:     10,
+				CreatedAt:  1000,
+			},
+		},
+	}
+	err = vs.SetPenaltyHistory(ctx, "user1", expectHistory)
+	assert.Nil(t, err)
+	history, err = vs.GetPenaltyHistory(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, expectHistory, history)
+}
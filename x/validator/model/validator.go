@@ -1,11 +1,13 @@
 package model
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/lino-network/lino/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
-// Validator is basic structure records all validator information
+// Validator is basic structure records all validator information,
+// AbsentCommit is the number of unpunished absent commits in signing window
 type Validator struct {
 	ABCIValidator   abci.Validator
 	Username        types.AccountKey `json:"username"`
@@ -14,12 +16,82 @@ type Validator struct {
 	ByzantineCommit int64            `json:"byzantine_commit"`
 	ProducedBlocks  int64            `json:"produced_blocks"`
 	Link            string           `json:"link"`
+	SigningWindow   SigningWindow    `json:"signing_window"`
+	IsJailed        bool             `json:"is_jailed"`
+	JailedUntil     int64            `json:"jailed_until"`
+	JailedTimes     int64            `json:"jailed_times"`
+	RetiredKeys     []RetiredKey     `json:"retired_keys"`
+	VotedProposals  int64            `json:"voted_proposals"`
+	MissedProposals int64            `json:"missed_proposals"`
+	Description     Description      `json:"description"`
+	Commission      *Commission      `json:"commission"`
+	LastHeartbeatAt int64            `json:"last_heartbeat_at"`
+	InflationPeriod InflationPeriod  `json:"inflation_period"`
 }
 
-// Validator list
+// Description - public profile of validator
+type Description struct {
+	Moniker  string `json:"moniker"`
+	Website  string `json:"website"`
+	Details  string `json:"details"`
+	Identity string `json:"identity"`
+}
+
+// Commission - share of validator inflation kept by validator,
+// MaxRate and MaxChangeRate are fixed once commission is set
+type Commission struct {
+	Rate          sdk.Rat `json:"rate"`
+	MaxRate       sdk.Rat `json:"max_rate"`
+	MaxChangeRate sdk.Rat `json:"max_change_rate"`
+	UpdatedAt     int64   `json:"updated_at"`
+}
+
+// SigningWindow - bitmap of signed blocks over the latest Size blocks,
+// Index is the position of next block in bitmap, UnpunishedBlocks is the
+// number of latest blocks recorded after last absent commit punishment
+type SigningWindow struct {
+	Size             int64  `json:"size"`
+	Bitmap           []byte `json:"bitmap"`
+	Index            int64  `json:"index"`
+	RecordedBlocks   int64  `json:"recorded_blocks"`
+	MissedBlocks     int64  `json:"missed_blocks"`
+	UnpunishedBlocks int64  `json:"unpunished_blocks"`
+}
+
+// InflationPeriod - blocks signed and missed by oncall validator since last hourly inflation
+type InflationPeriod struct {
+	SignedBlocks int64 `json:"signed_blocks"`
+	MissedBlocks int64 `json:"missed_blocks"`
+}
+
+// PenaltyRecord - a penalty applied to validator deposit
+type PenaltyRecord struct {
+	PunishType types.PunishType `json:"punish_type"`
+	Penalty    types.Coin       `json:"penalty"`
+	Jailed     bool             `json:"jailed"`
+	Height     int64            `json:"height"`
+	CreatedAt  int64            `json:"created_at"`
+}
+
+// PenaltyHistory - all penalties of a validator
+type PenaltyHistory struct {
+	Details []PenaltyRecord `json:"details"`
+}
+
+// RetiredKey - consensus key replaced by key rotation,
+// byzantine evidence against it is tracked till unbonding period ends
+type RetiredKey struct {
+	ABCIValidator abci.Validator `json:"abci_validator"`
+	RetiredAt     int64          `json:"retired_at"`
+	RetiredHeight int64          `json:"retired_height"`
+}
+
+// Validator list, StandbyValidators are ranked by deposit
+// and promoted in order when oncall validator list has vacancy
 type ValidatorList struct {
 	OncallValidators   []types.AccountKey `json:"oncall_validators"`
 	AllValidators      []types.AccountKey `json:"all_validators"`
+	StandbyValidators  []types.AccountKey `json:"standby_validators"`
 	PreBlockValidators []types.AccountKey `json:"pre_block_validators"`
 	LowestPower        types.Coin         `json:"lowest_power"`
 	LowestValidator    types.AccountKey   `json:"lowest_validator"`
@@ -12,6 +12,10 @@ import (
 var _ types.Msg = ValidatorDepositMsg{}
 var _ types.Msg = ValidatorWithdrawMsg{}
 var _ types.Msg = ValidatorRevokeMsg{}
+var _ types.Msg = ValidatorUnjailMsg{}
+var _ types.Msg = ValidatorRotateKeyMsg{}
+var _ types.Msg = ValidatorUpdateMsg{}
+var _ types.Msg = ValidatorHeartbeatMsg{}
 
 // ValidatorDepositMsg - deposit to become validator or add deposit
 type ValidatorDepositMsg struct {
@@ -32,6 +36,35 @@ type ValidatorRevokeMsg struct {
 	Username types.AccountKey `json:"username"`
 }
 
+// ValidatorUnjailMsg - unjail validator after jail period
+type ValidatorUnjailMsg struct {
+	Username types.AccountKey `json:"username"`
+}
+
+// ValidatorRotateKeyMsg - replace validator consensus public key
+type ValidatorRotateKeyMsg struct {
+	Username  types.AccountKey `json:"username"`
+	ValPubKey crypto.PubKey    `json:"validator_public_key"`
+}
+
+// ValidatorUpdateMsg - update validator description and commission,
+// empty field is left unchanged, commission limits can only be set with first commission rate
+type ValidatorUpdateMsg struct {
+	Username                types.AccountKey `json:"username"`
+	Moniker                 string           `json:"moniker"`
+	Website                 string           `json:"website"`
+	Details                 string           `json:"details"`
+	Identity                string           `json:"identity"`
+	CommissionRate          string           `json:"commission_rate"`
+	MaxCommissionRate       string           `json:"max_commission_rate"`
+	MaxCommissionChangeRate string           `json:"max_commission_change_rate"`
+}
+
+// ValidatorHeartbeatMsg - standby validator reports it is online
+type ValidatorHeartbeatMsg struct {
+	Username types.AccountKey `json:"username"`
+}
+
 // ValidatorDepositMsg Msg Implementations
 func NewValidatorDepositMsg(validator string, deposit types.LNO, pubKey crypto.PubKey, link string) ValidatorDepositMsg {
 	return ValidatorDepositMsg{
@@ -190,3 +223,245 @@ func (msg ValidatorRevokeMsg) GetSigners() []sdk.AccAddress {
 func (msg ValidatorRevokeMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+// ValidatorUnjailMsg Msg Implementations
+func NewValidatorUnjailMsg(validator string) ValidatorUnjailMsg {
+	return ValidatorUnjailMsg{
+		Username: types.AccountKey(validator),
+	}
+}
+
+// Type - implement sdk.Msg
+func (msg ValidatorUnjailMsg) Type() string { return types.ValidatorRouterName }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ValidatorUnjailMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	return nil
+}
+
+func (msg ValidatorUnjailMsg) String() string {
+	return fmt.Sprintf("ValidatorUnjailMsg{Username:%v}", msg.Username)
+}
+
+// GetPermission - implement types.Msg
+func (msg ValidatorUnjailMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ValidatorUnjailMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ValidatorUnjailMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ValidatorUnjailMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// ValidatorRotateKeyMsg Msg Implementations
+func NewValidatorRotateKeyMsg(validator string, pubKey crypto.PubKey) ValidatorRotateKeyMsg {
+	return ValidatorRotateKeyMsg{
+		Username:  types.AccountKey(validator),
+		ValPubKey: pubKey,
+	}
+}
+
+// Type - implement sdk.Msg
+func (msg ValidatorRotateKeyMsg) Type() string { return types.ValidatorRouterName }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ValidatorRotateKeyMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if msg.ValPubKey == nil {
+		return ErrInvalidValidatorPubKey()
+	}
+	return nil
+}
+
+func (msg ValidatorRotateKeyMsg) String() string {
+	return fmt.Sprintf("ValidatorRotateKeyMsg{Username:%v, PubKey:%v}", msg.Username, msg.ValPubKey)
+}
+
+// GetPermission - implement types.Msg
+func (msg ValidatorRotateKeyMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ValidatorRotateKeyMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ValidatorRotateKeyMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ValidatorRotateKeyMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// ValidatorUpdateMsg Msg Implementations
+func NewValidatorUpdateMsg(
+	validator, moniker, website, details, identity,
+	commissionRate, maxCommissionRate, maxCommissionChangeRate string) ValidatorUpdateMsg {
+	return ValidatorUpdateMsg{
+		Username:                types.AccountKey(validator),
+		Moniker:                 moniker,
+		Website:                 website,
+		Details:                 details,
+		Identity:                identity,
+		CommissionRate:          commissionRate,
+		MaxCommissionRate:       maxCommissionRate,
+		MaxCommissionChangeRate: maxCommissionChangeRate,
+	}
+}
+
+// Type - implement sdk.Msg
+func (msg ValidatorUpdateMsg) Type() string { return types.ValidatorRouterName }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ValidatorUpdateMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if len(msg.Moniker) > types.MaximumValidatorMonikerLength {
+		return ErrInvalidValidatorDescription("moniker")
+	}
+	if len(msg.Website) > types.MaximumLinkURL {
+		return ErrInvalidWebsite()
+	}
+	if len(msg.Details) > types.MaximumValidatorDetailsLength {
+		return ErrInvalidValidatorDescription("details")
+	}
+	if len(msg.Identity) > types.MaximumValidatorIdentityLength {
+		return ErrInvalidValidatorDescription("identity")
+	}
+	// commission limits can't be set without commission rate
+	if len(msg.CommissionRate) == 0 &&
+		(len(msg.MaxCommissionRate) != 0 || len(msg.MaxCommissionChangeRate) != 0) {
+		return ErrInvalidValidatorCommissionRate()
+	}
+	for _, rate := range []string{msg.CommissionRate, msg.MaxCommissionRate, msg.MaxCommissionChangeRate} {
+		if len(rate) == 0 {
+			continue
+		}
+		if _, err := parseCommissionRate(rate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (msg ValidatorUpdateMsg) String() string {
+	return fmt.Sprintf(
+		"ValidatorUpdateMsg{Username:%v, Moniker:%v, Website:%v, Details:%v, Identity:%v, "+
+			"CommissionRate:%v, MaxCommissionRate:%v, MaxCommissionChangeRate:%v}",
+		msg.Username, msg.Moniker, msg.Website, msg.Details, msg.Identity,
+		msg.CommissionRate, msg.MaxCommissionRate, msg.MaxCommissionChangeRate)
+}
+
+// GetPermission - implement types.Msg
+func (msg ValidatorUpdateMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ValidatorUpdateMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ValidatorUpdateMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ValidatorUpdateMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// ValidatorHeartbeatMsg Msg Implementations
+func NewValidatorHeartbeatMsg(validator string) ValidatorHeartbeatMsg {
+	return ValidatorHeartbeatMsg{
+		Username: types.AccountKey(validator),
+	}
+}
+
+// Type - implement sdk.Msg
+func (msg ValidatorHeartbeatMsg) Type() string { return types.ValidatorRouterName }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ValidatorHeartbeatMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	return nil
+}
+
+func (msg ValidatorHeartbeatMsg) String() string {
+	return fmt.Sprintf("ValidatorHeartbeatMsg{Username:%v}", msg.Username)
+}
+
+// GetPermission - implement types.Msg
+func (msg ValidatorHeartbeatMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ValidatorHeartbeatMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ValidatorHeartbeatMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ValidatorHeartbeatMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// parseCommissionRate - parse commission rate in decimal, rate should be in [0, 1]
+func parseCommissionRate(rate string) (sdk.Rat, sdk.Error) {
+	if len(rate) > types.MaximumSdkRatLength {
+		return sdk.ZeroRat(), ErrInvalidValidatorCommissionRate()
+	}
+	rat, err := sdk.NewRatFromDecimal(rate, types.NewRatFromDecimalPrecision)
+	if err != nil || rat.LT(sdk.ZeroRat()) || rat.GT(sdk.OneRat()) {
+		return sdk.ZeroRat(), ErrInvalidValidatorCommissionRate()
+	}
+	return rat, nil
+}
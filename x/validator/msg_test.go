@@ -36,6 +36,175 @@ func TestValidatorRevokeMsg(t *testing.T) {
 	}
 }
 
+func TestValidatorUnjailMsg(t *testing.T) {
+	testCases := []struct {
+		testName           string
+		validatorUnjailMsg ValidatorUnjailMsg
+		expectedError      sdk.Error
+	}{
+		{
+			testName:           "normal case",
+			validatorUnjailMsg: NewValidatorUnjailMsg("user1"),
+			expectedError:      nil,
+		},
+		{
+			testName:           "invalid username",
+			validatorUnjailMsg: NewValidatorUnjailMsg(""),
+			expectedError:      ErrInvalidUsername(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.validatorUnjailMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestValidatorRotateKeyMsg(t *testing.T) {
+	testCases := []struct {
+		testName              string
+		validatorRotateKeyMsg ValidatorRotateKeyMsg
+		expectedError         sdk.Error
+	}{
+		{
+			testName:              "normal case",
+			validatorRotateKeyMsg: NewValidatorRotateKeyMsg("user1", secp256k1.GenPrivKey().PubKey()),
+			expectedError:         nil,
+		},
+		{
+			testName:              "invalid username",
+			validatorRotateKeyMsg: NewValidatorRotateKeyMsg("", secp256k1.GenPrivKey().PubKey()),
+			expectedError:         ErrInvalidUsername(),
+		},
+		{
+			testName:              "empty public key",
+			validatorRotateKeyMsg: NewValidatorRotateKeyMsg("user1", nil),
+			expectedError:         ErrInvalidValidatorPubKey(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.validatorRotateKeyMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestValidatorUpdateMsg(t *testing.T) {
+	testCases := []struct {
+		testName           string
+		validatorUpdateMsg ValidatorUpdateMsg
+		expectedError      sdk.Error
+	}{
+		{
+			testName: "normal case",
+			validatorUpdateMsg: NewValidatorUpdateMsg(
+				"user1", "moniker", "https://lino.network", "details", "identity", "0.1", "0.2", "0.01"),
+			expectedError: nil,
+		},
+		{
+			testName:           "update description only",
+			validatorUpdateMsg: NewValidatorUpdateMsg("user1", "moniker", "", "", "", "", "", ""),
+			expectedError:      nil,
+		},
+		{
+			testName:           "invalid username",
+			validatorUpdateMsg: NewValidatorUpdateMsg("", "moniker", "", "", "", "", "", ""),
+			expectedError:      ErrInvalidUsername(),
+		},
+		{
+			testName: "moniker is too long",
+			validatorUpdateMsg: NewValidatorUpdateMsg(
+				"user1", string(make([]byte, types.MaximumValidatorMonikerLength+1)), "", "", "", "", "", ""),
+			expectedError: ErrInvalidValidatorDescription("moniker"),
+		},
+		{
+			testName: "website is too long",
+			validatorUpdateMsg: NewValidatorUpdateMsg(
+				"user1", "", string(make([]byte, types.MaximumLinkURL+1)), "", "", "", "", ""),
+			expectedError: ErrInvalidWebsite(),
+		},
+		{
+			testName: "details is too long",
+			validatorUpdateMsg: NewValidatorUpdateMsg(
+				"user1", "", "", string(make([]byte, types.MaximumValidatorDetailsLength+1)), "", "", "", ""),
+			expectedError: ErrInvalidValidatorDescription("details"),
+		},
+		{
+			testName: "identity is too long",
+			validatorUpdateMsg: NewValidatorUpdateMsg(
+				"user1", "", "", "", string(make([]byte, types.MaximumValidatorIdentityLength+1)), "", "", ""),
+			expectedError: ErrInvalidValidatorDescription("identity"),
+		},
+		{
+			testName:           "commission rate larger than one",
+			validatorUpdateMsg: NewValidatorUpdateMsg("user1", "", "", "", "", "1.1", "", ""),
+			expectedError:      ErrInvalidValidatorCommissionRate(),
+		},
+		{
+			testName:           "negative commission rate",
+			validatorUpdateMsg: NewValidatorUpdateMsg("user1", "", "", "", "", "-0.1", "", ""),
+			expectedError:      ErrInvalidValidatorCommissionRate(),
+		},
+		{
+			testName:           "commission rate is too long",
+			validatorUpdateMsg: NewValidatorUpdateMsg("user1", "", "", "", "", "0.1234567891", "", ""),
+			expectedError:      ErrInvalidValidatorCommissionRate(),
+		},
+		{
+			testName:           "invalid max commission rate",
+			validatorUpdateMsg: NewValidatorUpdateMsg("user1", "", "", "", "", "0.1", "a", ""),
+			expectedError:      ErrInvalidValidatorCommissionRate(),
+		},
+		{
+			testName:           "invalid max commission change rate",
+			validatorUpdateMsg: NewValidatorUpdateMsg("user1", "", "", "", "", "0.1", "", "2"),
+			expectedError:      ErrInvalidValidatorCommissionRate(),
+		},
+		{
+			testName:           "commission limits without commission rate",
+			validatorUpdateMsg: NewValidatorUpdateMsg("user1", "", "", "", "", "", "0.2", "0.01"),
+			expectedError:      ErrInvalidValidatorCommissionRate(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.validatorUpdateMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestValidatorHeartbeatMsg(t *testing.T) {
+	testCases := []struct {
+		testName              string
+		validatorHeartbeatMsg ValidatorHeartbeatMsg
+		expectedError         sdk.Error
+	}{
+		{
+			testName:              "normal case",
+			validatorHeartbeatMsg: NewValidatorHeartbeatMsg("user1"),
+			expectedError:         nil,
+		},
+		{
+			testName:              "invalid username",
+			validatorHeartbeatMsg: NewValidatorHeartbeatMsg(""),
+			expectedError:         ErrInvalidUsername(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.validatorHeartbeatMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestValidatorWithdrawMsg(t *testing.T) {
 	testCases := []struct {
 		testName             string
@@ -121,6 +290,26 @@ func TestMsgPermission(t *testing.T) {
 			msg:                NewValidatorRevokeMsg("test"),
 			expectedPermission: types.TransactionPermission,
 		},
+		{
+			testName:           "validator unjail msg",
+			msg:                NewValidatorUnjailMsg("test"),
+			expectedPermission: types.TransactionPermission,
+		},
+		{
+			testName:           "validator rotate key msg",
+			msg:                NewValidatorRotateKeyMsg("test", secp256k1.GenPrivKey().PubKey()),
+			expectedPermission: types.TransactionPermission,
+		},
+		{
+			testName:           "validator update msg",
+			msg:                NewValidatorUpdateMsg("test", "moniker", "", "", "", "0.1", "", ""),
+			expectedPermission: types.TransactionPermission,
+		},
+		{
+			testName:           "validator heartbeat msg",
+			msg:                NewValidatorHeartbeatMsg("test"),
+			expectedPermission: types.TransactionPermission,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -150,6 +339,22 @@ func TestGetSignBytes(t *testing.T) {
 			testName: "validator revoke msg",
 			msg:      NewValidatorRevokeMsg("test"),
 		},
+		{
+			testName: "validator unjail msg",
+			msg:      NewValidatorUnjailMsg("test"),
+		},
+		{
+			testName: "validator rotate key msg",
+			msg:      NewValidatorRotateKeyMsg("test", secp256k1.GenPrivKey().PubKey()),
+		},
+		{
+			testName: "validator update msg",
+			msg:      NewValidatorUpdateMsg("test", "moniker", "", "", "", "0.1", "", ""),
+		},
+		{
+			testName: "validator heartbeat msg",
+			msg:      NewValidatorHeartbeatMsg("test"),
+		},
 	}
 
 	for testName, tc := range testCases {
@@ -179,6 +384,26 @@ func TestGetSigners(t *testing.T) {
 			msg:           NewValidatorRevokeMsg("test"),
 			expectSigners: []types.AccountKey{"test"},
 		},
+		{
+			testName:      "validator unjail msg",
+			msg:           NewValidatorUnjailMsg("test"),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName:      "validator rotate key msg",
+			msg:           NewValidatorRotateKeyMsg("test", secp256k1.GenPrivKey().PubKey()),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName:      "validator update msg",
+			msg:           NewValidatorUpdateMsg("test", "moniker", "", "", "", "0.1", "", ""),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName:      "validator heartbeat msg",
+			msg:           NewValidatorHeartbeatMsg("test"),
+			expectSigners: []types.AccountKey{"test"},
+		},
 	}
 
 	for _, tc := range testCases {
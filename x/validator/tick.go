@@ -6,9 +6,10 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
-// BeginBlocker - execute before every block, update signing info and record validator set
+// BeginBlocker - execute before every block, update signing info and record validator set,
+// return penalty of punished validators and slashes to their delegators
 func BeginBlocker(
-	ctx sdk.Context, req abci.RequestBeginBlock, vm ValidatorManager) (panelty types.Coin) {
+	ctx sdk.Context, req abci.RequestBeginBlock, vm ValidatorManager) (panelty types.Coin, slashes []DelegatorSlash) {
 	validatorList, err := vm.GetValidatorList(ctx)
 	if err != nil {
 		panic(err)
@@ -20,6 +21,6 @@ func BeginBlocker(
 
 	vm.UpdateSigningValidator(ctx, req.LastCommitInfo.Validators)
 
-	panelty, _ = vm.FireIncompetentValidator(ctx, req.ByzantineValidators)
+	panelty, slashes, _ = vm.FireIncompetentValidator(ctx, req.ByzantineValidators)
 	return
 }
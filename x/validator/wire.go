@@ -9,6 +9,10 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(ValidatorDepositMsg{}, "lino/valDeposit", nil)
 	cdc.RegisterConcrete(ValidatorWithdrawMsg{}, "lino/valWithdraw", nil)
 	cdc.RegisterConcrete(ValidatorRevokeMsg{}, "lino/valRevoke", nil)
+	cdc.RegisterConcrete(ValidatorUnjailMsg{}, "lino/valUnjail", nil)
+	cdc.RegisterConcrete(ValidatorRotateKeyMsg{}, "lino/valRotateKey", nil)
+	cdc.RegisterConcrete(ValidatorUpdateMsg{}, "lino/valUpdate", nil)
+	cdc.RegisterConcrete(ValidatorHeartbeatMsg{}, "lino/valHeartbeat", nil)
 }
 
 var msgCdc = wire.NewCodec()
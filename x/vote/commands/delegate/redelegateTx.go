@@ -0,0 +1,49 @@
+package delegate
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/x/vote"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+// RedelegateTxCmd will create a redelegate tx and sign it with the given key
+func RedelegateTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redelegate",
+		Short: "move delegation from one voter to another",
+		RunE:  sendRedelegateTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "delegator")
+	cmd.Flags().String(client.FlagVoter, "", "move delegation from")
+	cmd.Flags().String(client.FlagDstVoter, "", "move delegation to")
+	cmd.Flags().String(client.FlagAmount, "", "amount to move")
+	return cmd
+}
+
+func sendRedelegateTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		user := viper.GetString(client.FlagUser)
+		srcVoter := viper.GetString(client.FlagVoter)
+		dstVoter := viper.GetString(client.FlagDstVoter)
+		// create the message
+		msg := vote.NewRedelegateMsg(user, srcVoter, dstVoter, viper.GetString(client.FlagAmount))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
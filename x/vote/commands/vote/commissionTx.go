@@ -0,0 +1,45 @@
+package vote
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/x/vote"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+// UpdateCommissionTxCmd will create an update commission tx and sign it with the given key
+func UpdateCommissionTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voter-commission",
+		Short: "set percentage of income kept by voter, the rest is shared with delegators",
+		RunE:  sendUpdateCommissionTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "voter")
+	cmd.Flags().String(client.FlagCommissionRate, "", "commission rate between 0 and 1")
+	return cmd
+}
+
+func sendUpdateCommissionTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		user := viper.GetString(client.FlagUser)
+		// create the message
+		msg := vote.NewUpdateCommissionMsg(user, viper.GetString(client.FlagCommissionRate))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
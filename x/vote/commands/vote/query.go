@@ -6,11 +6,15 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/client/core"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/vote/model"
+
+	proposalmodel "github.com/lino-network/lino/x/proposal/model"
 )
 
 // GetVoterCmd returns target voter information
@@ -39,6 +43,69 @@ func GetVoteCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetProposalTallyCmd returns the tally of a proposal, tally of an ongoing proposal
+// is calculated with voting power at its latest vote change
+func GetProposalTallyCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "proposal-tally <proposalID>",
+		Short: "Query tally of a proposal",
+		RunE:  cmdr.getProposalTallyCmd,
+	}
+}
+
+// GetProposalVotesCmd returns a query command that will display
+// votes on a proposal ordered by voter name
+func GetProposalVotesCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "proposal-votes <proposalID>",
+		Short: "Query votes on a proposal",
+		RunE:  cmdr.getProposalVotesCmd,
+	}
+	cmd.Flags().String(client.FlagCursor, "", "cursor returned by previous page, empty for the first page")
+	cmd.Flags().Int(client.FlagLimit, 20, "max number of votes in one page")
+	return cmd
+}
+
+// GetVoteHistoryCmd returns a query command that will display
+// proposals voted by a voter with proposal results, from newest to oldest
+func GetVoteHistoryCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "vote-history <voter>",
+		Short: "Query proposals voted by a voter",
+		RunE:  cmdr.getVoteHistoryCmd,
+	}
+	cmd.Flags().String(client.FlagCursor, "", "cursor returned by previous page, empty for the newest proposals")
+	cmd.Flags().Int(client.FlagLimit, 20, "max number of proposals in one page")
+	return cmd
+}
+
+// voteRecord - vote of a voter on a proposal with proposal status,
+// status is one of pending, ongoing, pass, not pass and revoked
+type voteRecord struct {
+	ProposalID types.ProposalKey `json:"proposal_id"`
+	Vote       model.Vote        `json:"vote"`
+	Status     string            `json:"status"`
+}
+
+type proposalTally struct {
+	AgreeVotes    types.Coin `json:"agree_votes"`
+	DisagreeVotes types.Coin `json:"disagree_votes"`
+	AbstainVotes  types.Coin `json:"abstain_votes"`
+	VetoVotes     types.Coin `json:"veto_votes"`
+}
+
 type commander struct {
 	storeName string
 	cdc       *wire.Codec
@@ -97,3 +164,160 @@ func (c commander) getVoteCmd(cmd *cobra.Command, args []string) error {
 	fmt.Println(string(output))
 	return nil
 }
+
+func (c commander) getProposalTallyCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide proposal ID")
+	}
+
+	// tally is stored in the proposal, decided proposal keeps the tally at decision time
+	proposalID := types.ProposalKey(args[0])
+	res, err := ctx.Query(proposalmodel.GetOngoingProposalKey(proposalID), types.ProposalKVStoreKey)
+	if err != nil {
+		return err
+	}
+	if len(res) == 0 {
+		res, err = ctx.Query(proposalmodel.GetExpiredProposalKey(proposalID), types.ProposalKVStoreKey)
+		if err != nil {
+			return err
+		}
+	}
+	if len(res) == 0 {
+		return errors.Errorf("proposal %s not found", proposalID)
+	}
+	proposal := new(proposalmodel.Proposal)
+	if err := c.cdc.UnmarshalJSON(res, proposal); err != nil {
+		return err
+	}
+	proposalInfo := (*proposal).GetProposalInfo()
+	tally := proposalTally{
+		AgreeVotes:    proposalInfo.AgreeVotes,
+		DisagreeVotes: proposalInfo.DisagreeVotes,
+		AbstainVotes:  proposalInfo.AbstainVotes,
+		VetoVotes:     proposalInfo.VetoVotes,
+	}
+
+	// print out tally
+	output, err := json.MarshalIndent(tally, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+func (c commander) getProposalVotesCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide proposal ID")
+	}
+
+	prefix := model.GetVotePrefix(types.ProposalKey(args[0]))
+	resKVs, err := ctx.QuerySubspace(c.cdc, prefix, c.storeName)
+	if err != nil {
+		return err
+	}
+	values, nextCursor, err := client.PageIndex(
+		resKVs, prefix, viper.GetString(client.FlagCursor), viper.GetInt(client.FlagLimit), nil)
+	if err != nil {
+		return err
+	}
+
+	votes := []model.Vote{}
+	for _, value := range values {
+		var vote model.Vote
+		if err := c.cdc.UnmarshalJSON(value, &vote); err != nil {
+			return err
+		}
+		votes = append(votes, vote)
+	}
+
+	if err := client.PrintIndent(votes, nextCursor); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getVoteHistoryCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a voter name")
+	}
+
+	voter := types.AccountKey(args[0])
+	prefix := model.GetVoteHistoryPrefix(voter)
+	resKVs, err := ctx.QuerySubspace(c.cdc, prefix, c.storeName)
+	if err != nil {
+		return err
+	}
+	values, nextCursor, err := client.PageIndex(
+		resKVs, prefix, viper.GetString(client.FlagCursor), viper.GetInt(client.FlagLimit), nil)
+	if err != nil {
+		return err
+	}
+
+	records := []voteRecord{}
+	for _, value := range values {
+		proposalID := types.ProposalKey(value)
+		res, err := ctx.Query(model.GetVoteKey(proposalID, voter), c.storeName)
+		if err != nil {
+			return err
+		}
+		var vote model.Vote
+		if err := c.cdc.UnmarshalJSON(res, &vote); err != nil {
+			return err
+		}
+		status, err := c.getProposalStatus(ctx, proposalID)
+		if err != nil {
+			return err
+		}
+		records = append(records, voteRecord{
+			ProposalID: proposalID,
+			Vote:       vote,
+			Status:     status,
+		})
+	}
+
+	if err := client.PrintIndent(records, nextCursor); err != nil {
+		return err
+	}
+	return nil
+}
+
+// getProposalStatus - get proposal status from proposal store, result is shown once decided
+func (c commander) getProposalStatus(ctx core.CoreContext, proposalID types.ProposalKey) (string, error) {
+	res, err := ctx.Query(proposalmodel.GetOngoingProposalKey(proposalID), types.ProposalKVStoreKey)
+	if err != nil {
+		return "", err
+	}
+	if len(res) > 0 {
+		return "ongoing", nil
+	}
+	res, err = ctx.Query(proposalmodel.GetPendingProposalKey(proposalID), types.ProposalKVStoreKey)
+	if err != nil {
+		return "", err
+	}
+	if len(res) > 0 {
+		return "pending", nil
+	}
+	res, err = ctx.Query(proposalmodel.GetExpiredProposalKey(proposalID), types.ProposalKVStoreKey)
+	if err != nil {
+		return "", err
+	}
+	if len(res) == 0 {
+		return "", errors.Errorf("proposal %s not found", proposalID)
+	}
+	proposal := new(proposalmodel.Proposal)
+	if err := c.cdc.UnmarshalJSON(res, proposal); err != nil {
+		return "", err
+	}
+	switch (*proposal).GetProposalInfo().Result {
+	case types.ProposalPass:
+		return "pass", nil
+	case types.ProposalRevoked:
+		return "revoked", nil
+	default:
+		return "not pass", nil
+	}
+}
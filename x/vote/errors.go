@@ -22,11 +22,6 @@ func ErrValidatorCannotRevoke() sdk.Error {
 	return types.NewError(types.CodeValidatorCannotRevoke, fmt.Sprintf("invalid revoke"))
 }
 
-// ErrVoteAlreadyExist - error if user already vote for a proposal
-func ErrVoteAlreadyExist() sdk.Error {
-	return types.NewError(types.CodeVoteAlreadyExist, fmt.Sprintf("Vote exist"))
-}
-
 // ErrVoteNotFound - error if voter is not found
 func ErrVoterNotFound() sdk.Error {
 	return types.NewError(types.CodeVoterNotFound, fmt.Sprintf("voter not found"))
@@ -46,3 +41,44 @@ func ErrInsufficientDeposit() sdk.Error {
 func ErrInvalidUsername() sdk.Error {
 	return types.NewError(types.CodeInvalidUsername, fmt.Sprintf("invalid username"))
 }
+
+// ErrRedelegateTooOften - error if delegator redelegates too often
+func ErrRedelegateTooOften() sdk.Error {
+	return types.NewError(types.CodeRedelegateTooOften, fmt.Sprintf("redelegate too often"))
+}
+
+// ErrRedelegateToSameVoter - error if source and destination voter are the same
+func ErrRedelegateToSameVoter() sdk.Error {
+	return types.NewError(types.CodeRedelegateToSameVoter, fmt.Sprintf("can't redelegate to the same voter"))
+}
+
+// ErrRedelegateToSelf - error if delegator redelegates to itself
+func ErrRedelegateToSelf() sdk.Error {
+	return types.NewError(types.CodeRedelegateToSelf, fmt.Sprintf("can't redelegate to delegator itself"))
+}
+
+// ErrDelegatorRewardNotFound - error if delegator has no reward from a voter
+func ErrDelegatorRewardNotFound() sdk.Error {
+	return types.NewError(types.CodeDelegatorRewardNotFound, fmt.Sprintf("delegator reward not found"))
+}
+
+// ErrInvalidCommissionRate - error if commission rate is not between 0 and 1
+func ErrInvalidCommissionRate() sdk.Error {
+	return types.NewError(types.CodeInvalidCommissionRate, fmt.Sprintf("commission rate must be between 0 and 1"))
+}
+
+// ErrCommissionUpdateTooOften - error if voter updates commission rate too often
+func ErrCommissionUpdateTooOften() sdk.Error {
+	return types.NewError(types.CodeVoterCommissionUpdateTooOften, fmt.Sprintf("commission rate update too often"))
+}
+
+// ErrValidatorCannotUpdateCommission - error if validator updates voter commission rate,
+// validator commission is set in validator module
+func ErrValidatorCannotUpdateCommission() sdk.Error {
+	return types.NewError(types.CodeValidatorCannotUpdateCommission, fmt.Sprintf("validator commission rate is set by validator update"))
+}
+
+// ErrCommissionRateChangeTooLarge - error if commission rate change exceeds max commission change rate
+func ErrCommissionRateChangeTooLarge() sdk.Error {
+	return types.NewError(types.CodeVoterCommissionChangeTooLarge, fmt.Sprintf("commission rate change too large"))
+}
@@ -24,6 +24,12 @@ func NewHandler(vm VoteManager, am acc.AccountManager, gm global.GlobalManager,
 			return handleDelegateMsg(ctx, vm, gm, am, rm, msg)
 		case DelegatorWithdrawMsg:
 			return handleDelegatorWithdrawMsg(ctx, vm, gm, am, rm, msg)
+		case RedelegateMsg:
+			return handleRedelegateMsg(ctx, vm, am, msg)
+		case UpdateCommissionMsg:
+			return handleUpdateCommissionMsg(ctx, vm, msg)
+		case ClaimDelegatorRewardMsg:
+			return handleClaimDelegatorRewardMsg(ctx, vm, am, msg)
 		case ClaimInterestMsg:
 			return handleClaimInterestMsg(ctx, vm, gm, am, msg)
 		default:
@@ -164,6 +170,71 @@ func handleDelegatorWithdrawMsg(
 	return sdk.Result{}
 }
 
+func handleRedelegateMsg(
+	ctx sdk.Context, vm VoteManager, am acc.AccountManager, msg RedelegateMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.DstVoter) {
+		return ErrAccountNotFound().Result()
+	}
+	// redelegate interval is tracked on delegator's voter record
+	if !vm.DoesVoterExist(ctx, msg.Delegator) {
+		return ErrVoterNotFound().Result()
+	}
+	coin, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	param, err := vm.paramHolder.GetVoteParam(ctx)
+	if err != nil {
+		return err.Result()
+	}
+	// moved stake is a new delegation to destination voter
+	if param.MinStakeIn.IsGT(coin) {
+		return ErrInsufficientDeposit().Result()
+	}
+	if !vm.IsLegalDelegatorWithdraw(ctx, msg.SrcVoter, msg.Delegator, coin) {
+		return ErrIllegalWithdraw().Result()
+	}
+	if !vm.CanRedelegate(ctx, msg.Delegator) {
+		return ErrRedelegateTooOften().Result()
+	}
+
+	// delegator's lino stake is unchanged, interest keeps accruing without interruption
+	if err := vm.Redelegate(ctx, msg.SrcVoter, msg.DstVoter, msg.Delegator, coin); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleUpdateCommissionMsg(ctx sdk.Context, vm VoteManager, msg UpdateCommissionMsg) sdk.Result {
+	if !vm.DoesVoterExist(ctx, msg.Username) {
+		return ErrVoterNotFound().Result()
+	}
+	if vm.IsInValidatorList(ctx, msg.Username) {
+		return ErrValidatorCannotUpdateCommission().Result()
+	}
+	commissionRate, err := sdk.NewRatFromDecimal(msg.CommissionRate, types.NewRatFromDecimalPrecision)
+	if err != nil {
+		return ErrInvalidCommissionRate().Result()
+	}
+	if err := vm.SetCommissionRate(ctx, msg.Username, commissionRate); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleClaimDelegatorRewardMsg(
+	ctx sdk.Context, vm VoteManager, am acc.AccountManager, msg ClaimDelegatorRewardMsg) sdk.Result {
+	reward, err := vm.ClaimDelegatorReward(ctx, msg.Voter, msg.Delegator)
+	if err != nil {
+		return err.Result()
+	}
+	if err := am.AddSavingCoin(
+		ctx, msg.Delegator, reward, msg.Voter, "", types.ClaimDelegatorReward); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
 func handleClaimInterestMsg(ctx sdk.Context, vm VoteManager, gm global.GlobalManager, am acc.AccountManager, msg ClaimInterestMsg) sdk.Result {
 	if err := calculateAndAddInterest(ctx, vm, gm, am, msg.Username); err != nil {
 		return err.Result()
@@ -225,6 +296,43 @@ func MinusStake(
 	return nil
 }
 
+// SlashDelegations - slash delegations under a punished voter by slash rate,
+// return total slashed coin which should be added back to inflation pool
+func SlashDelegations(
+	ctx sdk.Context, voterName types.AccountKey, slashRate sdk.Rat, vm VoteManager,
+	gm global.GlobalManager, am acc.AccountManager, rm rep.ReputationManager) (types.Coin, sdk.Error) {
+	totalSlash := types.NewCoinFromInt64(0)
+	delegators, err := vm.GetAllDelegators(ctx, voterName)
+	if err != nil {
+		return totalSlash, err
+	}
+	for _, delegator := range delegators {
+		delegation, err := vm.storage.GetDelegation(ctx, voterName, delegator)
+		if err != nil {
+			return totalSlash, err
+		}
+		slash := types.RatToCoin(delegation.Amount.ToRat().Mul(slashRate))
+		if slash.IsGT(delegation.Amount) {
+			slash = delegation.Amount
+		}
+		if !slash.IsPositive() {
+			continue
+		}
+		if err := vm.DelegatorWithdraw(ctx, voterName, delegator, slash); err != nil {
+			return totalSlash, err
+		}
+		if err := MinusStake(ctx, delegator, slash, vm, gm, am, rm); err != nil {
+			return totalSlash, err
+		}
+		if err := am.AddStakePenaltyHistory(
+			ctx, delegator, slash, voterName, "", types.DelegationSlash); err != nil {
+			return totalSlash, err
+		}
+		totalSlash = totalSlash.Plus(slash)
+	}
+	return totalSlash, nil
+}
+
 func calculateAndAddInterest(ctx sdk.Context, vm VoteManager, gm global.GlobalManager,
 	am acc.AccountManager, name types.AccountKey) sdk.Error {
 	userLinoStake, err := vm.GetLinoStake(ctx, name)
@@ -262,7 +370,7 @@ func returnCoinTo(
 		return err
 	}
 
-	events, err := acc.CreateCoinReturnEvents(ctx, name, times, interval, coin, returnType)
+	events, err := am.CreatePendingCoinReturnEvents(ctx, name, times, interval, coin, returnType)
 	if err != nil {
 		return err
 	}
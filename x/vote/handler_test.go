@@ -2,6 +2,7 @@ package vote
 
 import (
 	"testing"
+	"time"
 
 	"github.com/lino-network/lino/types"
 	globalModel "github.com/lino-network/lino/x/global/model"
@@ -9,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestVoterDepositBasic(t *testing.T) {
@@ -189,6 +191,36 @@ func TestRevokeBasic(t *testing.T) {
 	assert.Equal(t, linoStat.UnclaimedLinoStake, delegatedCoin)
 }
 
+func TestUpdateCommission(t *testing.T) {
+	ctx, am, vm, gm, rm := setupTest(t, 0)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(100, 0)})
+	handler := NewHandler(vm, am, gm, rm)
+	voteParam, _ := vm.paramHolder.GetVoteParam(ctx)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	user1 := createTestAccount(ctx, am, "user1", minBalance.Plus(voteParam.MinStakeIn))
+	user2 := createTestAccount(ctx, am, "user2", minBalance.Plus(voteParam.MinStakeIn))
+
+	// non-voter can't update commission rate
+	result := handler(ctx, NewUpdateCommissionMsg("user1", "0.1"))
+	assert.Equal(t, ErrVoterNotFound().Result(), result)
+
+	handler(ctx, NewStakeInMsg("user1", coinToString(voteParam.MinStakeIn)))
+	handler(ctx, NewStakeInMsg("user2", coinToString(voteParam.MinStakeIn)))
+	result = handler(ctx, NewUpdateCommissionMsg("user1", "0.15"))
+	assert.Equal(t, sdk.Result{}, result)
+	pool, _ := vm.GetRewardPool(ctx, user1)
+	assert.True(t, pool.CommissionRate.Equal(sdk.NewRat(15, 100)))
+
+	// validator commission rate is only set by validator update
+	vm.storage.SetReferenceList(ctx, &model.ReferenceList{
+		AllValidators: []types.AccountKey{user2},
+	})
+	result = handler(ctx, NewUpdateCommissionMsg("user2", "0.15"))
+	assert.Equal(t, ErrValidatorCannotUpdateCommission().Result(), result)
+	pool, _ = vm.GetRewardPool(ctx, user2)
+	assert.True(t, pool.CommissionRate.Equal(voteParam.DefaultCommissionRate))
+}
+
 func TestVoterWithdraw(t *testing.T) {
 	ctx, am, vm, gm, rm := setupTest(t, 0)
 	handler := NewHandler(vm, am, gm, rm)
@@ -304,6 +336,171 @@ func TestDelegatorWithdraw(t *testing.T) {
 	}
 }
 
+func TestRedelegate(t *testing.T) {
+	ctx, am, vm, gm, rm := setupTest(t, 0)
+	minBalance := types.NewCoinFromInt64(2000 * types.Decimals)
+	user1 := createTestAccount(ctx, am, "user1", minBalance)
+	user2 := createTestAccount(ctx, am, "user2", minBalance.Plus(minBalance))
+	user3 := createTestAccount(ctx, am, "user3", minBalance)
+	handler := NewHandler(vm, am, gm, rm)
+	param, _ := vm.paramHolder.GetVoteParam(ctx)
+	delegatedCoin := param.MinStakeIn.Plus(param.MinStakeIn)
+	delta := param.MinStakeIn
+	baseTime := int64(100)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime, 0)})
+
+	vm.AddVoter(ctx, user1, param.MinStakeIn)
+	vm.AddVoter(ctx, user3, param.MinStakeIn)
+	res := handler(ctx, NewDelegateMsg(string(user2), string(user1), coinToString(delegatedCoin)))
+	assert.Equal(t, sdk.Result{}, res)
+	linoStake, _ := vm.GetLinoStake(ctx, user2)
+
+	testCases := []struct {
+		testName           string
+		srcVoter           types.AccountKey
+		dstVoter           types.AccountKey
+		amount             types.Coin
+		atWhen             int64
+		expectedResult     sdk.Result
+		expectSrcDelegated types.Coin
+		expectDstDelegated types.Coin
+	}{
+		{
+			testName:           "can't redelegate more than delegation",
+			srcVoter:           user1,
+			dstVoter:           user3,
+			amount:             delegatedCoin.Plus(delta),
+			atWhen:             baseTime,
+			expectedResult:     ErrIllegalWithdraw().Result(),
+			expectSrcDelegated: delegatedCoin,
+			expectDstDelegated: types.NewCoinFromInt64(0),
+		},
+		{
+			testName:           "can't redelegate less than minimum stake in",
+			srcVoter:           user1,
+			dstVoter:           user3,
+			amount:             param.MinStakeIn.Minus(types.NewCoinFromInt64(1)),
+			atWhen:             baseTime,
+			expectedResult:     ErrInsufficientDeposit().Result(),
+			expectSrcDelegated: delegatedCoin,
+			expectDstDelegated: types.NewCoinFromInt64(0),
+		},
+		{
+			testName:           "destination voter doesn't exist",
+			srcVoter:           user1,
+			dstVoter:           "invalid",
+			amount:             delta,
+			atWhen:             baseTime,
+			expectedResult:     ErrAccountNotFound().Result(),
+			expectSrcDelegated: delegatedCoin,
+			expectDstDelegated: types.NewCoinFromInt64(0),
+		},
+		{
+			testName:           "normal redelegate",
+			srcVoter:           user1,
+			dstVoter:           user3,
+			amount:             delta,
+			atWhen:             baseTime,
+			expectedResult:     sdk.Result{},
+			expectSrcDelegated: delegatedCoin.Minus(delta),
+			expectDstDelegated: delta,
+		},
+		{
+			testName:           "redelegate too often",
+			srcVoter:           user1,
+			dstVoter:           user3,
+			amount:             delta,
+			atWhen:             baseTime + param.RedelegateIntervalSec - 1,
+			expectedResult:     ErrRedelegateTooOften().Result(),
+			expectSrcDelegated: delegatedCoin.Minus(delta),
+			expectDstDelegated: delta,
+		},
+		{
+			testName:           "redelegate all remaining delegation after interval",
+			srcVoter:           user1,
+			dstVoter:           user3,
+			amount:             delegatedCoin.Minus(delta),
+			atWhen:             baseTime + param.RedelegateIntervalSec,
+			expectedResult:     sdk.Result{},
+			expectSrcDelegated: types.NewCoinFromInt64(0),
+			expectDstDelegated: delegatedCoin,
+		},
+	}
+
+	for _, tc := range testCases {
+		ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(tc.atWhen, 0)})
+		msg := NewRedelegateMsg(string(user2), string(tc.srcVoter), string(tc.dstVoter), coinToString(tc.amount))
+		res := handler(ctx, msg)
+		if !assert.Equal(t, tc.expectedResult, res) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, res, tc.expectedResult)
+		}
+
+		srcVoter, _ := vm.storage.GetVoter(ctx, tc.srcVoter)
+		if !srcVoter.DelegatedPower.IsEqual(tc.expectSrcDelegated) {
+			t.Errorf("%s: diff src delegated power, got %v, want %v",
+				tc.testName, srcVoter.DelegatedPower, tc.expectSrcDelegated)
+		}
+		dstVoter, _ := vm.storage.GetVoter(ctx, user3)
+		if !dstVoter.DelegatedPower.IsEqual(tc.expectDstDelegated) {
+			t.Errorf("%s: diff dst delegated power, got %v, want %v",
+				tc.testName, dstVoter.DelegatedPower, tc.expectDstDelegated)
+		}
+
+		// delegator's stake is untouched by redelegation
+		delegator, _ := vm.storage.GetVoter(ctx, user2)
+		assert.Equal(t, linoStake, delegator.LinoStake)
+		assert.Equal(t, delegatedCoin, delegator.DelegateToOthers)
+	}
+	assert.False(t, vm.DoesDelegationExist(ctx, user1, user2))
+	delegation, _ := vm.storage.GetDelegation(ctx, user3, user2)
+	assert.Equal(t, delegatedCoin, delegation.Amount)
+	frozenMoneyList, _ := am.GetFrozenMoneyList(ctx, user2)
+	assert.Equal(t, 0, len(frozenMoneyList))
+
+	// delegator without voter record has nothing to redelegate
+	user4 := createTestAccount(ctx, am, "user4", minBalance)
+	res = handler(ctx, NewRedelegateMsg(string(user4), string(user3), string(user1), coinToString(delta)))
+	assert.Equal(t, ErrVoterNotFound().Result(), res)
+}
+
+func TestSlashDelegations(t *testing.T) {
+	ctx, am, vm, gm, rm := setupTest(t, 0)
+	minBalance := types.NewCoinFromInt64(5000 * types.Decimals)
+	user1 := createTestAccount(ctx, am, "user1", minBalance)
+	user2 := createTestAccount(ctx, am, "user2", minBalance)
+	user3 := createTestAccount(ctx, am, "user3", minBalance)
+	handler := NewHandler(vm, am, gm, rm)
+	param, _ := vm.paramHolder.GetVoteParam(ctx)
+
+	vm.AddVoter(ctx, user1, param.MinStakeIn)
+	res := handler(ctx, NewDelegateMsg(string(user2), string(user1), "1000"))
+	assert.Equal(t, sdk.Result{}, res)
+	res = handler(ctx, NewDelegateMsg(string(user3), string(user1), "3000"))
+	assert.Equal(t, sdk.Result{}, res)
+	saving2, _ := am.GetSavingFromBank(ctx, user2)
+
+	slashed, err := SlashDelegations(ctx, user1, sdk.NewRat(1, 10), vm, gm, am, rm)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(400*types.Decimals), slashed)
+
+	delegation2, _ := vm.storage.GetDelegation(ctx, user1, user2)
+	assert.Equal(t, types.NewCoinFromInt64(900*types.Decimals), delegation2.Amount)
+	delegation3, _ := vm.storage.GetDelegation(ctx, user1, user3)
+	assert.Equal(t, types.NewCoinFromInt64(2700*types.Decimals), delegation3.Amount)
+
+	voter, _ := vm.storage.GetVoter(ctx, user1)
+	assert.Equal(t, types.NewCoinFromInt64(3600*types.Decimals), voter.DelegatedPower)
+	delegator, _ := vm.storage.GetVoter(ctx, user2)
+	assert.Equal(t, types.NewCoinFromInt64(900*types.Decimals), delegator.LinoStake)
+	assert.Equal(t, types.NewCoinFromInt64(900*types.Decimals), delegator.DelegateToOthers)
+
+	// slashed coin is burned instead of being returned to the delegator
+	saving, _ := am.GetSavingFromBank(ctx, user2)
+	assert.Equal(t, saving2, saving)
+	frozenMoneyList, _ := am.GetFrozenMoneyList(ctx, user2)
+	assert.Equal(t, 0, len(frozenMoneyList))
+}
+
 func TestAddFrozenMoney(t *testing.T) {
 	ctx, am, vm, gm, _ := setupTest(t, 0)
 	vm.InitGenesis(ctx)
@@ -101,13 +101,25 @@ func (vm VoteManager) CanBecomeValidator(ctx sdk.Context, username types.Account
 	return voter.LinoStake.IsGTE(param.ValidatorMinVotingDeposit)
 }
 
-// AddVote - voter vote for a proposal
+// AddVote - voter vote for a proposal, previous vote on the same proposal is replaced
 func (vm VoteManager) AddVote(ctx sdk.Context, proposalID types.ProposalKey, voter types.AccountKey, res bool) sdk.Error {
-	// check if the vote exist
-	if vm.DoesVoteExist(ctx, proposalID, voter) {
-		return ErrVoteAlreadyExist()
-	}
+	return vm.setVote(ctx, proposalID, voter, res, false, false)
+}
 
+// AddAbstainVote - voter abstain from a proposal, counts toward quorum only
+func (vm VoteManager) AddAbstainVote(ctx sdk.Context, proposalID types.ProposalKey, voter types.AccountKey) sdk.Error {
+	return vm.setVote(ctx, proposalID, voter, false, true, false)
+}
+
+// AddVetoVote - voter vote no with veto, counts as disagree and
+// a large veto share forfeits the proposal deposit
+func (vm VoteManager) AddVetoVote(ctx sdk.Context, proposalID types.ProposalKey, voter types.AccountKey) sdk.Error {
+	return vm.setVote(ctx, proposalID, voter, false, false, true)
+}
+
+func (vm VoteManager) setVote(
+	ctx sdk.Context, proposalID types.ProposalKey, voter types.AccountKey,
+	res bool, abstain bool, veto bool) sdk.Error {
 	votingPower, err := vm.GetVotingPower(ctx, voter)
 	if err != nil {
 		return err
@@ -116,15 +128,32 @@ func (vm VoteManager) AddVote(ctx sdk.Context, proposalID types.ProposalKey, vot
 	vote := model.Vote{
 		Voter:       voter,
 		Result:      res,
+		Abstain:     abstain,
+		Veto:        veto,
 		VotingPower: votingPower,
 	}
 
 	if err := vm.storage.SetVote(ctx, proposalID, voter, &vote); err != nil {
 		return err
 	}
+	vm.storage.SetVoteHistory(ctx, voter, proposalID)
 	return nil
 }
 
+// DeleteVote - voter withdraw vote from a proposal
+func (vm VoteManager) DeleteVote(ctx sdk.Context, proposalID types.ProposalKey, voter types.AccountKey) sdk.Error {
+	if !vm.DoesVoteExist(ctx, proposalID, voter) {
+		return model.ErrVoteNotFound()
+	}
+	vm.storage.DeleteVoteHistory(ctx, voter, proposalID)
+	return vm.storage.DeleteVote(ctx, proposalID, voter)
+}
+
+// RebuildVoteHistory - index votes added before vote history was introduced
+func (vm VoteManager) RebuildVoteHistory(ctx sdk.Context) sdk.Error {
+	return vm.storage.RebuildVoteHistory(ctx)
+}
+
 // GetVote - get vote detail based on voter and proposal ID
 func (vm VoteManager) GetVote(ctx sdk.Context, proposalID types.ProposalKey, voter types.AccountKey) (*model.Vote, sdk.Error) {
 	return vm.storage.GetVote(ctx, proposalID, voter)
@@ -135,6 +164,11 @@ func (vm VoteManager) AddDelegation(ctx sdk.Context, voterName types.AccountKey,
 	var delegation *model.Delegation
 	var err sdk.Error
 
+	// settle reward before delegation amount changes
+	if err := vm.settleDelegatorReward(ctx, voterName, delegatorName); err != nil {
+		return err
+	}
+
 	if !vm.DoesDelegationExist(ctx, voterName, delegatorName) {
 		delegation = &model.Delegation{
 			Delegator: delegatorName,
@@ -232,6 +266,10 @@ func (vm VoteManager) DelegatorWithdraw(
 	if coin.IsZero() {
 		return ErrInvalidCoin()
 	}
+	// settle reward before delegation amount changes
+	if err := vm.settleDelegatorReward(ctx, voterName, delegatorName); err != nil {
+		return err
+	}
 	// change voter's delegated power
 	voter, err := vm.storage.GetVoter(ctx, voterName)
 	if err != nil {
@@ -270,6 +308,208 @@ func (vm VoteManager) DelegatorWithdraw(
 	return nil
 }
 
+// CanRedelegate - check if delegator has waited long enough since last redelegation
+func (vm VoteManager) CanRedelegate(ctx sdk.Context, delegatorName types.AccountKey) bool {
+	delegator, err := vm.storage.GetVoter(ctx, delegatorName)
+	if err != nil {
+		return false
+	}
+	param, err := vm.paramHolder.GetVoteParam(ctx)
+	if err != nil {
+		return false
+	}
+	if delegator.LastRedelegateAt == 0 {
+		return true
+	}
+	return delegator.LastRedelegateAt+param.RedelegateIntervalSec <= ctx.BlockHeader().Time.Unix()
+}
+
+// Redelegate - move delegation from source voter to destination voter,
+// caller should check if it is a legal withdraw from source voter
+func (vm VoteManager) Redelegate(
+	ctx sdk.Context, srcVoterName, dstVoterName types.AccountKey,
+	delegatorName types.AccountKey, coin types.Coin) sdk.Error {
+	if err := vm.DelegatorWithdraw(ctx, srcVoterName, delegatorName, coin); err != nil {
+		return err
+	}
+	if err := vm.AddDelegation(ctx, dstVoterName, delegatorName, coin); err != nil {
+		return err
+	}
+
+	delegator, err := vm.storage.GetVoter(ctx, delegatorName)
+	if err != nil {
+		return err
+	}
+	delegator.LastRedelegateAt = ctx.BlockHeader().Time.Unix()
+	if err := vm.storage.SetVoter(ctx, delegatorName, delegator); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetRewardPool - get reward pool of a voter, voter keeps default commission
+// rate of vote param before a commission rate is declared
+func (vm VoteManager) GetRewardPool(ctx sdk.Context, voterName types.AccountKey) (*model.RewardPool, sdk.Error) {
+	pool := &model.RewardPool{RewardPerCoin: sdk.ZeroRat()}
+	if vm.storage.DoesRewardPoolExist(ctx, voterName) {
+		var err sdk.Error
+		if pool, err = vm.storage.GetRewardPool(ctx, voterName); err != nil {
+			return nil, err
+		}
+	}
+	if pool.CommissionUpdatedAt == 0 {
+		param, err := vm.paramHolder.GetVoteParam(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pool.CommissionRate = param.DefaultCommissionRate
+	}
+	return pool, nil
+}
+
+// SetCommissionRate - set percentage of income kept by voter, rate can be changed
+// by at most MaxCommissionChangeRate once every CommissionUpdateIntervalSec
+func (vm VoteManager) SetCommissionRate(
+	ctx sdk.Context, voterName types.AccountKey, commissionRate sdk.Rat) sdk.Error {
+	param, err := vm.paramHolder.GetVoteParam(ctx)
+	if err != nil {
+		return err
+	}
+	pool, err := vm.GetRewardPool(ctx, voterName)
+	if err != nil {
+		return err
+	}
+	if pool.CommissionUpdatedAt != 0 &&
+		ctx.BlockHeader().Time.Unix() < pool.CommissionUpdatedAt+param.CommissionUpdateIntervalSec {
+		return ErrCommissionUpdateTooOften()
+	}
+	change := commissionRate.Sub(pool.CommissionRate)
+	if change.GT(param.MaxCommissionChangeRate) ||
+		change.LT(param.MaxCommissionChangeRate.Mul(sdk.NewRat(-1))) {
+		return ErrCommissionRateChangeTooLarge()
+	}
+	pool.CommissionRate = commissionRate
+	pool.CommissionUpdatedAt = ctx.BlockHeader().Time.Unix()
+	return vm.storage.SetRewardPool(ctx, voterName, pool)
+}
+
+// DistributeIncome - keep commission for voter and share the rest with delegators
+// pro rata, return the commission which should be paid to voter directly
+func (vm VoteManager) DistributeIncome(
+	ctx sdk.Context, voterName types.AccountKey, income types.Coin) (types.Coin, sdk.Error) {
+	return vm.distributeIncome(ctx, voterName, income, nil)
+}
+
+// DistributeIncomeWithCommissionRate - same as DistributeIncome but commission
+// is calculated by given rate instead of voter commission rate
+func (vm VoteManager) DistributeIncomeWithCommissionRate(
+	ctx sdk.Context, voterName types.AccountKey, income types.Coin, commissionRate sdk.Rat) (types.Coin, sdk.Error) {
+	return vm.distributeIncome(ctx, voterName, income, &commissionRate)
+}
+
+func (vm VoteManager) distributeIncome(
+	ctx sdk.Context, voterName types.AccountKey, income types.Coin, commissionRate *sdk.Rat) (types.Coin, sdk.Error) {
+	if !vm.DoesVoterExist(ctx, voterName) {
+		return income, nil
+	}
+	voter, err := vm.storage.GetVoter(ctx, voterName)
+	if err != nil {
+		return income, err
+	}
+	// no delegator to share with
+	if !voter.DelegatedPower.IsPositive() {
+		return income, nil
+	}
+	pool, err := vm.GetRewardPool(ctx, voterName)
+	if err != nil {
+		return income, err
+	}
+	if commissionRate == nil {
+		commissionRate = &pool.CommissionRate
+	}
+	commission := types.RatToCoin(income.ToRat().Mul(*commissionRate))
+	delegatorIncome := income.Minus(commission)
+	pool.RewardPerCoin = pool.RewardPerCoin.Add(
+		delegatorIncome.ToRat().Quo(voter.DelegatedPower.ToRat())).Round(types.PrecisionFactor)
+	if err := vm.storage.SetRewardPool(ctx, voterName, pool); err != nil {
+		return income, err
+	}
+	return commission, nil
+}
+
+// GetDelegatorReward - get unclaimed reward of a delegator from a voter
+func (vm VoteManager) GetDelegatorReward(
+	ctx sdk.Context, voterName, delegatorName types.AccountKey) (types.Coin, sdk.Error) {
+	if !vm.DoesDelegationExist(ctx, voterName, delegatorName) &&
+		!vm.storage.DoesDelegatorRewardExist(ctx, voterName, delegatorName) {
+		return types.NewCoinFromInt64(0), ErrDelegatorRewardNotFound()
+	}
+	if err := vm.settleDelegatorReward(ctx, voterName, delegatorName); err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	reward, err := vm.storage.GetDelegatorReward(ctx, voterName, delegatorName)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return reward.UnclaimedReward, nil
+}
+
+// ClaimDelegatorReward - claim all unclaimed reward of a delegator from a voter
+func (vm VoteManager) ClaimDelegatorReward(
+	ctx sdk.Context, voterName, delegatorName types.AccountKey) (types.Coin, sdk.Error) {
+	if !vm.DoesDelegationExist(ctx, voterName, delegatorName) &&
+		!vm.storage.DoesDelegatorRewardExist(ctx, voterName, delegatorName) {
+		return types.NewCoinFromInt64(0), ErrDelegatorRewardNotFound()
+	}
+	if err := vm.settleDelegatorReward(ctx, voterName, delegatorName); err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	reward, err := vm.storage.GetDelegatorReward(ctx, voterName, delegatorName)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	claimedReward := reward.UnclaimedReward
+	if !vm.DoesDelegationExist(ctx, voterName, delegatorName) {
+		vm.storage.DeleteDelegatorReward(ctx, voterName, delegatorName)
+		return claimedReward, nil
+	}
+	reward.UnclaimedReward = types.NewCoinFromInt64(0)
+	if err := vm.storage.SetDelegatorReward(ctx, voterName, delegatorName, reward); err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return claimedReward, nil
+}
+
+// settleDelegatorReward - move reward accumulated since last settlement to unclaimed reward
+func (vm VoteManager) settleDelegatorReward(
+	ctx sdk.Context, voterName, delegatorName types.AccountKey) sdk.Error {
+	pool, err := vm.GetRewardPool(ctx, voterName)
+	if err != nil {
+		return err
+	}
+	// delegation without reward record shares all income since reward pool creation
+	reward := &model.DelegatorReward{
+		RewardPerCoinAt: sdk.ZeroRat(),
+		UnclaimedReward: types.NewCoinFromInt64(0),
+	}
+	if vm.storage.DoesDelegatorRewardExist(ctx, voterName, delegatorName) {
+		reward, err = vm.storage.GetDelegatorReward(ctx, voterName, delegatorName)
+		if err != nil {
+			return err
+		}
+	}
+	if vm.DoesDelegationExist(ctx, voterName, delegatorName) {
+		delegation, err := vm.storage.GetDelegation(ctx, voterName, delegatorName)
+		if err != nil {
+			return err
+		}
+		pending := delegation.Amount.ToRat().Mul(pool.RewardPerCoin.Sub(reward.RewardPerCoinAt))
+		reward.UnclaimedReward = reward.UnclaimedReward.Plus(types.RatToCoin(pending))
+	}
+	reward.RewardPerCoinAt = pool.RewardPerCoin
+	return vm.storage.SetDelegatorReward(ctx, voterName, delegatorName, reward)
+}
+
 // ClaimInterest - add lino power interst to user balance
 func (vm VoteManager) ClaimInterest(
 	ctx sdk.Context, username types.AccountKey) (types.Coin, sdk.Error) {
@@ -309,6 +549,71 @@ func (vm VoteManager) GetVotingPower(ctx sdk.Context, voterName types.AccountKey
 	return res, nil
 }
 
+// GetProposalTally - tally all votes on a proposal with each voter's current voting power,
+// delegator who votes by itself overrides its voter's vote with the delegated amount.
+// veto votes are returned separately from disagree votes
+func (vm VoteManager) GetProposalTally(
+	ctx sdk.Context, proposalID types.ProposalKey) (types.Coin, types.Coin, types.Coin, types.Coin, sdk.Error) {
+	agreeVotes, disagreeVotes, abstainVotes, vetoVotes :=
+		types.NewCoinFromInt64(0), types.NewCoinFromInt64(0), types.NewCoinFromInt64(0), types.NewCoinFromInt64(0)
+	votes, err := vm.storage.GetAllVotes(ctx, proposalID)
+	if err != nil {
+		return agreeVotes, disagreeVotes, abstainVotes, vetoVotes, err
+	}
+
+	votingPowers := make(map[types.AccountKey]types.Coin)
+	for _, vote := range votes {
+		// voter who has withdrawn all stake doesn't have power anymore
+		if !vm.DoesVoterExist(ctx, vote.Voter) {
+			continue
+		}
+		votingPower, err := vm.GetVotingPower(ctx, vote.Voter)
+		if err != nil {
+			return agreeVotes, disagreeVotes, abstainVotes, vetoVotes, err
+		}
+		votingPowers[vote.Voter] = votingPower
+	}
+
+	// move delegated amount from voter to delegator who voted
+	for _, vote := range votes {
+		if _, ok := votingPowers[vote.Voter]; !ok {
+			continue
+		}
+		delegatees, err := vm.storage.GetAllDelegatees(ctx, vote.Voter)
+		if err != nil {
+			return agreeVotes, disagreeVotes, abstainVotes, vetoVotes, err
+		}
+		for _, delegatee := range delegatees {
+			delegation, err := vm.storage.GetDelegation(ctx, delegatee, vote.Voter)
+			if err != nil {
+				return agreeVotes, disagreeVotes, abstainVotes, vetoVotes, err
+			}
+			votingPowers[vote.Voter] = votingPowers[vote.Voter].Plus(delegation.Amount)
+			if votingPower, ok := votingPowers[delegatee]; ok {
+				votingPowers[delegatee] = votingPower.Minus(delegation.Amount)
+			}
+		}
+	}
+
+	for _, vote := range votes {
+		votingPower, ok := votingPowers[vote.Voter]
+		if !ok || !votingPower.IsPositive() {
+			continue
+		}
+		switch {
+		case vote.Abstain:
+			abstainVotes = abstainVotes.Plus(votingPower)
+		case vote.Veto:
+			vetoVotes = vetoVotes.Plus(votingPower)
+		case vote.Result:
+			agreeVotes = agreeVotes.Plus(votingPower)
+		default:
+			disagreeVotes = disagreeVotes.Plus(votingPower)
+		}
+	}
+	return agreeVotes, disagreeVotes, abstainVotes, vetoVotes, nil
+}
+
 // GetPenaltyList - get penalty list if voter is also validator doesn't vote
 func (vm VoteManager) GetPenaltyList(
 	ctx sdk.Context, proposalID types.ProposalKey, proposalType types.ProposalType,
@@ -331,12 +636,12 @@ func (vm VoteManager) GetPenaltyList(
 				break
 			}
 		}
-		// TODO: Decide if we wanna delete vote.
-		// vm.storage.DeleteVote(ctx, proposalID, vote.Voter)
+		// votes are kept after decision for vote listing and voter history
 	}
 
-	// put all validators who didn't vote on these two types proposal into penalty list
-	if proposalType == types.ChangeParam || proposalType == types.ProtocolUpgrade {
+	// put all validators who didn't vote on these types of proposal into penalty list
+	if proposalType == types.ChangeParam || proposalType == types.ProtocolUpgrade ||
+		proposalType == types.CommunityPoolSpend {
 		penaltyList.PenaltyList = oncallValidators
 	}
 	return penaltyList, nil
@@ -2,12 +2,14 @@ package vote
 
 import (
 	"testing"
+	"time"
 
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/vote/model"
 	"github.com/stretchr/testify/assert"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestAddVoter(t *testing.T) {
@@ -298,3 +300,297 @@ func TestIsLegalDelegatorWithdraw(t *testing.T) {
 		}
 	}
 }
+
+func TestDistributeIncomeToDelegators(t *testing.T) {
+	ctx, am, vm, _, _ := setupTest(t, 0)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(100, 0)})
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	voter := createTestAccount(ctx, am, "voter", minBalance)
+	delegator1 := createTestAccount(ctx, am, "delegator1", minBalance)
+	delegator2 := createTestAccount(ctx, am, "delegator2", minBalance)
+	c100 := types.NewCoinFromInt64(100 * types.Decimals)
+
+	vm.AddVoter(ctx, voter, c100)
+	vm.AddVoter(ctx, delegator1, c100)
+	vm.AddVoter(ctx, delegator2, c100)
+
+	// voter keeps all income without delegator
+	commission, err := vm.DistributeIncome(ctx, voter, c100)
+	assert.Nil(t, err)
+	assert.Equal(t, c100, commission)
+
+	// voter keeps default commission rate before declaring commission rate
+	err = vm.AddDelegation(ctx, voter, delegator1, c100)
+	assert.Nil(t, err)
+	commission, err = vm.DistributeIncome(ctx, voter, c100)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(10*types.Decimals), commission)
+
+	err = vm.SetCommissionRate(ctx, voter, sdk.NewRat(15, 100))
+	assert.Nil(t, err)
+	commission, err = vm.DistributeIncome(ctx, voter, c100)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(15*types.Decimals), commission)
+
+	// delegator2 doesn't share income before delegation
+	err = vm.AddDelegation(ctx, voter, delegator2, c100.Plus(c100))
+	assert.Nil(t, err)
+	commission, err = vm.DistributeIncome(ctx, voter, types.NewCoinFromInt64(60*types.Decimals))
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(9*types.Decimals), commission)
+
+	reward1, err := vm.GetDelegatorReward(ctx, voter, delegator1)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(192*types.Decimals), reward1)
+	reward2, err := vm.GetDelegatorReward(ctx, voter, delegator2)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(34*types.Decimals), reward2)
+
+	// withdraw keeps settled reward claimable
+	err = vm.DelegatorWithdraw(ctx, voter, delegator1, c100)
+	assert.Nil(t, err)
+	claimed, err := vm.ClaimDelegatorReward(ctx, voter, delegator1)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(192*types.Decimals), claimed)
+	_, err = vm.ClaimDelegatorReward(ctx, voter, delegator1)
+	assert.Equal(t, ErrDelegatorRewardNotFound(), err)
+
+	claimed, err = vm.ClaimDelegatorReward(ctx, voter, delegator2)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(34*types.Decimals), claimed)
+	reward2, err = vm.GetDelegatorReward(ctx, voter, delegator2)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(0), reward2)
+}
+
+func TestDistributeIncomeToDelegationWithoutRewardRecord(t *testing.T) {
+	ctx, am, vm, _, _ := setupTest(t, 0)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	voter := createTestAccount(ctx, am, "voter", minBalance)
+	delegator := createTestAccount(ctx, am, "delegator", minBalance)
+	c100 := types.NewCoinFromInt64(100 * types.Decimals)
+
+	vm.AddVoter(ctx, voter, c100)
+	vm.AddVoter(ctx, delegator, c100)
+	err := vm.AddDelegation(ctx, voter, delegator, c100)
+	assert.Nil(t, err)
+	// delegation is created before delegator reward is recorded
+	vm.storage.DeleteDelegatorReward(ctx, voter, delegator)
+
+	commission, err := vm.DistributeIncome(ctx, voter, c100)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(10*types.Decimals), commission)
+	reward, err := vm.GetDelegatorReward(ctx, voter, delegator)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(90*types.Decimals), reward)
+}
+
+func TestDistributeIncomeWithCommissionRate(t *testing.T) {
+	ctx, am, vm, _, _ := setupTest(t, 0)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	voter := createTestAccount(ctx, am, "voter", minBalance)
+	delegator := createTestAccount(ctx, am, "delegator", minBalance)
+	c100 := types.NewCoinFromInt64(100 * types.Decimals)
+
+	vm.AddVoter(ctx, voter, c100)
+	vm.AddVoter(ctx, delegator, c100)
+	err := vm.AddDelegation(ctx, voter, delegator, c100)
+	assert.Nil(t, err)
+
+	// given rate is used instead of voter commission rate
+	commission, err := vm.DistributeIncomeWithCommissionRate(ctx, voter, c100, sdk.NewRat(1, 4))
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(25*types.Decimals), commission)
+	reward, err := vm.GetDelegatorReward(ctx, voter, delegator)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(75*types.Decimals), reward)
+
+	// voter commission rate is not changed
+	param, err := vm.paramHolder.GetVoteParam(ctx)
+	assert.Nil(t, err)
+	pool, err := vm.GetRewardPool(ctx, voter)
+	assert.Nil(t, err)
+	assert.True(t, pool.CommissionRate.Equal(param.DefaultCommissionRate))
+}
+
+func TestSetCommissionRate(t *testing.T) {
+	ctx, am, vm, _, _ := setupTest(t, 0)
+	param, err := vm.paramHolder.GetVoteParam(ctx)
+	assert.Nil(t, err)
+	voter := createTestAccount(ctx, am, "voter", types.NewCoinFromInt64(1*types.Decimals))
+	vm.AddVoter(ctx, voter, types.NewCoinFromInt64(100*types.Decimals))
+	baseTime := int64(100)
+	defaultRate := param.DefaultCommissionRate
+	maxChange := param.MaxCommissionChangeRate
+
+	testCases := []struct {
+		testName     string
+		rate         sdk.Rat
+		atWhen       int64
+		expectErr    sdk.Error
+		expectRate   sdk.Rat
+		expectUpdate int64
+	}{
+		{
+			testName:     "change from default rate exceeds max change",
+			rate:         defaultRate.Add(maxChange).Add(sdk.NewRat(1, 100)),
+			atWhen:       baseTime,
+			expectErr:    ErrCommissionRateChangeTooLarge(),
+			expectRate:   defaultRate,
+			expectUpdate: 0,
+		},
+		{
+			testName:     "declare rate within max change",
+			rate:         defaultRate.Add(maxChange),
+			atWhen:       baseTime,
+			expectErr:    nil,
+			expectRate:   defaultRate.Add(maxChange),
+			expectUpdate: baseTime,
+		},
+		{
+			testName:     "update rate too often",
+			rate:         defaultRate,
+			atWhen:       baseTime + param.CommissionUpdateIntervalSec - 1,
+			expectErr:    ErrCommissionUpdateTooOften(),
+			expectRate:   defaultRate.Add(maxChange),
+			expectUpdate: baseTime,
+		},
+		{
+			testName:     "decrease exceeds max change",
+			rate:         defaultRate.Sub(sdk.NewRat(1, 100)),
+			atWhen:       baseTime + param.CommissionUpdateIntervalSec,
+			expectErr:    ErrCommissionRateChangeTooLarge(),
+			expectRate:   defaultRate.Add(maxChange),
+			expectUpdate: baseTime,
+		},
+		{
+			testName:     "update rate after interval",
+			rate:         defaultRate,
+			atWhen:       baseTime + param.CommissionUpdateIntervalSec,
+			expectErr:    nil,
+			expectRate:   defaultRate,
+			expectUpdate: baseTime + param.CommissionUpdateIntervalSec,
+		},
+	}
+
+	for _, tc := range testCases {
+		ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(tc.atWhen, 0)})
+		err := vm.SetCommissionRate(ctx, voter, tc.rate)
+		if !assert.Equal(t, tc.expectErr, err) {
+			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.expectErr)
+		}
+		pool, err := vm.GetRewardPool(ctx, voter)
+		if err != nil {
+			t.Errorf("%s: failed to get reward pool, got err %v", tc.testName, err)
+		}
+		if !pool.CommissionRate.Equal(tc.expectRate) {
+			t.Errorf("%s: diff commission rate, got %v, want %v", tc.testName, pool.CommissionRate, tc.expectRate)
+		}
+		if pool.CommissionUpdatedAt != tc.expectUpdate {
+			t.Errorf("%s: diff commission updated at, got %v, want %v",
+				tc.testName, pool.CommissionUpdatedAt, tc.expectUpdate)
+		}
+	}
+}
+
+func TestGetProposalTally(t *testing.T) {
+	ctx, am, vm, _, _ := setupTest(t, 0)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	user1 := createTestAccount(ctx, am, "user1", minBalance)
+	user2 := createTestAccount(ctx, am, "user2", minBalance)
+	user3 := createTestAccount(ctx, am, "user3", minBalance)
+	c50 := types.NewCoinFromInt64(50 * types.Decimals)
+	c100 := types.NewCoinFromInt64(100 * types.Decimals)
+	proposalID := types.ProposalKey("1")
+
+	vm.AddVoter(ctx, user1, c100)
+	vm.AddVoter(ctx, user2, c100)
+	vm.AddVoter(ctx, user3, c100)
+	assert.Nil(t, vm.AddVote(ctx, proposalID, user1, true))
+	assert.Nil(t, vm.AddVote(ctx, proposalID, user2, false))
+
+	agree, disagree, abstain, veto, err := vm.GetProposalTally(ctx, proposalID)
+	assert.Nil(t, err)
+	assert.Equal(t, c100, agree)
+	assert.Equal(t, c100, disagree)
+	assert.Equal(t, types.NewCoinFromInt64(0), abstain)
+	assert.Equal(t, types.NewCoinFromInt64(0), veto)
+
+	// power changed after voting is counted at tally time,
+	// delegator who doesn't vote inherits its voter's vote
+	err = vm.AddDelegation(ctx, user2, user3, c100)
+	assert.Nil(t, err)
+	err = vm.MinusLinoStake(ctx, user1, c50)
+	assert.Nil(t, err)
+
+	agree, disagree, abstain, veto, err = vm.GetProposalTally(ctx, proposalID)
+	assert.Nil(t, err)
+	assert.Equal(t, c50, agree)
+	assert.Equal(t, c100.Plus(c100), disagree)
+	assert.Equal(t, types.NewCoinFromInt64(0), abstain)
+	assert.Equal(t, types.NewCoinFromInt64(0), veto)
+
+	// delegator who votes overrides its voter's vote with the delegated amount
+	assert.Nil(t, vm.AddVote(ctx, proposalID, user3, true))
+	agree, disagree, abstain, veto, err = vm.GetProposalTally(ctx, proposalID)
+	assert.Nil(t, err)
+	assert.Equal(t, c50.Plus(c100), agree)
+	assert.Equal(t, c100, disagree)
+	assert.Equal(t, types.NewCoinFromInt64(0), abstain)
+	assert.Equal(t, types.NewCoinFromInt64(0), veto)
+
+	// vote snapshot is kept as it was
+	vote, err := vm.GetVote(ctx, proposalID, user2)
+	assert.Nil(t, err)
+	assert.Equal(t, c100, vote.VotingPower)
+
+	// vote again replaces previous choice
+	assert.Nil(t, vm.AddVote(ctx, proposalID, user1, false))
+	assert.Nil(t, vm.AddAbstainVote(ctx, proposalID, user2))
+	agree, disagree, abstain, veto, err = vm.GetProposalTally(ctx, proposalID)
+	assert.Nil(t, err)
+	assert.Equal(t, c100, agree)
+	assert.Equal(t, c50, disagree)
+	assert.Equal(t, c100, abstain)
+	assert.Equal(t, types.NewCoinFromInt64(0), veto)
+
+	// veto is counted separately from disagree
+	assert.Nil(t, vm.AddVetoVote(ctx, proposalID, user1))
+	agree, disagree, abstain, veto, err = vm.GetProposalTally(ctx, proposalID)
+	assert.Nil(t, err)
+	assert.Equal(t, c100, agree)
+	assert.Equal(t, types.NewCoinFromInt64(0), disagree)
+	assert.Equal(t, c100, abstain)
+	assert.Equal(t, c50, veto)
+
+	// delegator still votes with delegated amount after voter withdraws its vote
+	assert.Nil(t, vm.DeleteVote(ctx, proposalID, user2))
+	assert.Equal(t, model.ErrVoteNotFound(), vm.DeleteVote(ctx, proposalID, user2))
+	agree, disagree, abstain, veto, err = vm.GetProposalTally(ctx, proposalID)
+	assert.Nil(t, err)
+	assert.Equal(t, c100, agree)
+	assert.Equal(t, types.NewCoinFromInt64(0), disagree)
+	assert.Equal(t, types.NewCoinFromInt64(0), abstain)
+	assert.Equal(t, c50, veto)
+}
+
+func TestVoteHistory(t *testing.T) {
+	ctx, am, vm, _, _ := setupTest(t, 0)
+	user1 := createTestAccount(ctx, am, "user1", types.NewCoinFromInt64(1*types.Decimals))
+	vm.AddVoter(ctx, user1, types.NewCoinFromInt64(100*types.Decimals))
+
+	assert.Nil(t, vm.AddVote(ctx, types.ProposalKey("1"), user1, true))
+	assert.Nil(t, vm.AddAbstainVote(ctx, types.ProposalKey("2"), user1))
+	assert.Nil(t, vm.AddVetoVote(ctx, types.ProposalKey("3"), user1))
+	// vote again on the same proposal doesn't duplicate history
+	assert.Nil(t, vm.AddVote(ctx, types.ProposalKey("1"), user1, false))
+	assert.Equal(t, []types.ProposalKey{"3", "2", "1"}, getVoteHistory(ctx, user1))
+
+	// withdrawn vote is removed from history
+	assert.Nil(t, vm.DeleteVote(ctx, types.ProposalKey("2"), user1))
+	assert.Equal(t, []types.ProposalKey{"3", "1"}, getVoteHistory(ctx, user1))
+
+	// rebuild keeps history of existing votes only
+	assert.Nil(t, vm.RebuildVoteHistory(ctx))
+	assert.Equal(t, []types.ProposalKey{"3", "1"}, getVoteHistory(ctx, user1))
+}
@@ -27,6 +27,16 @@ func ErrDelegationNotFound() sdk.Error {
 	return types.NewError(types.CodeDelegationNotFound, fmt.Sprintf("delegation is not found"))
 }
 
+// ErrRewardPoolNotFound - error if reward pool is not found in KVStore
+func ErrRewardPoolNotFound() sdk.Error {
+	return types.NewError(types.CodeRewardPoolNotFound, fmt.Sprintf("reward pool is not found"))
+}
+
+// ErrDelegatorRewardNotFound - error if delegator reward is not found in KVStore
+func ErrDelegatorRewardNotFound() sdk.Error {
+	return types.NewError(types.CodeDelegatorRewardNotFound, fmt.Sprintf("delegator reward is not found"))
+}
+
 // ErrFailedToMarshalVoter - error if marshal voter failed
 func ErrFailedToMarshalVoter(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToMarshalVoter, fmt.Sprintf("failed to marshal voter: %s", err.Error()))
@@ -66,3 +76,23 @@ func ErrFailedToUnmarshalDelegation(err error) sdk.Error {
 func ErrFailedToUnmarshalReferenceList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalReferenceList, fmt.Sprintf("failed to unmarshal reference list: %s", err.Error()))
 }
+
+// ErrFailedToMarshalRewardPool - error if marshal reward pool failed
+func ErrFailedToMarshalRewardPool(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalRewardPool, fmt.Sprintf("failed to marshal reward pool: %s", err.Error()))
+}
+
+// ErrFailedToMarshalDelegatorReward - error if marshal delegator reward failed
+func ErrFailedToMarshalDelegatorReward(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalDelegatorReward, fmt.Sprintf("failed to marshal delegator reward: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalRewardPool - error if unmarshal reward pool failed
+func ErrFailedToUnmarshalRewardPool(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalRewardPool, fmt.Sprintf("failed to unmarshal reward pool: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalDelegatorReward - error if unmarshal delegator reward failed
+func ErrFailedToUnmarshalDelegatorReward(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalDelegatorReward, fmt.Sprintf("failed to unmarshal delegator reward: %s", err.Error()))
+}
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"strings"
+
 	"github.com/lino-network/lino/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -8,13 +10,19 @@ import (
 )
 
 var (
-	delegationSubstore    = []byte{0x00}
-	voterSubstore         = []byte{0x01}
-	voteSubstore          = []byte{0x02}
-	referenceListSubStore = []byte{0x03}
-	delegateeSubStore     = []byte{0x04}
+	delegationSubstore      = []byte{0x00}
+	voterSubstore           = []byte{0x01}
+	voteSubstore            = []byte{0x02}
+	referenceListSubStore   = []byte{0x03}
+	delegateeSubStore       = []byte{0x04}
+	rewardPoolSubStore      = []byte{0x05}
+	delegatorRewardSubStore = []byte{0x06}
+	voteHistorySubStore     = []byte{0x07}
 )
 
+// proposalIndexWidth - digits of zero padded proposal ID in vote history index
+const proposalIndexWidth = 20
+
 // VoteStorage - vote storage
 type VoteStorage struct {
 	key sdk.StoreKey
@@ -124,6 +132,37 @@ func (vs VoteStorage) DeleteVote(ctx sdk.Context, proposalID types.ProposalKey,
 	return nil
 }
 
+// SetVoteHistory - index proposal under voter's vote history
+func (vs VoteStorage) SetVoteHistory(ctx sdk.Context, voter types.AccountKey, proposalID types.ProposalKey) {
+	store := ctx.KVStore(vs.key)
+	store.Set(getVoteHistoryKey(voter, proposalID), []byte(proposalID))
+}
+
+// DeleteVoteHistory - remove proposal from voter's vote history
+func (vs VoteStorage) DeleteVoteHistory(ctx sdk.Context, voter types.AccountKey, proposalID types.ProposalKey) {
+	store := ctx.KVStore(vs.key)
+	store.Delete(getVoteHistoryKey(voter, proposalID))
+}
+
+// RebuildVoteHistory - index all existing votes under voters' vote history,
+// votes added before the vote history index existed are not indexed.
+func (vs VoteStorage) RebuildVoteHistory(ctx sdk.Context) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	iter := sdk.KVStorePrefixIterator(store, voteSubstore)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		// key is "vote substore" + "proposal ID" + "/" + "voter", proposal ID has no separator
+		ids := strings.SplitN(string(key[len(voteSubstore):]), types.KeySeparator, 2)
+		vs.SetVoteHistory(ctx, types.AccountKey(ids[1]), types.ProposalKey(ids[0]))
+	}
+	return nil
+}
+
 // GetDelegation - get delegation from KVStore
 func (vs VoteStorage) GetDelegation(ctx sdk.Context, voter types.AccountKey, delegator types.AccountKey) (*Delegation, sdk.Error) {
 	store := ctx.KVStore(vs.key)
@@ -178,10 +217,25 @@ func (vs VoteStorage) GetAllDelegators(ctx sdk.Context, voterName types.AccountK
 	return delegators, nil
 }
 
+// GetAllDelegatees - get all voters a delegator delegates to from KVStore
+func (vs VoteStorage) GetAllDelegatees(ctx sdk.Context, delegatorName types.AccountKey) ([]types.AccountKey, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	prefix := GetDelegateePrefix(delegatorName)
+	iterator := store.Iterator(subspace(prefix))
+
+	var delegatees []types.AccountKey
+
+	for ; iterator.Valid(); iterator.Next() {
+		delegatees = append(delegatees, types.AccountKey(iterator.Key()[len(prefix):]))
+	}
+	iterator.Close()
+	return delegatees, nil
+}
+
 // GetAllVotes - get all votes of a proposal from KVStore
 func (vs VoteStorage) GetAllVotes(ctx sdk.Context, proposalID types.ProposalKey) ([]Vote, sdk.Error) {
 	store := ctx.KVStore(vs.key)
-	iterator := store.Iterator(subspace(getVotePrefix(proposalID)))
+	iterator := store.Iterator(subspace(GetVotePrefix(proposalID)))
 
 	var votes []Vote
 
@@ -223,6 +277,76 @@ func (vs VoteStorage) SetReferenceList(ctx sdk.Context, lst *ReferenceList) sdk.
 	return nil
 }
 
+// DoesRewardPoolExist - check if reward pool of a voter exist in KVStore or not
+func (vs VoteStorage) DoesRewardPoolExist(ctx sdk.Context, voter types.AccountKey) bool {
+	store := ctx.KVStore(vs.key)
+	return store.Has(GetRewardPoolKey(voter))
+}
+
+// GetRewardPool - get reward pool of a voter from KVStore
+func (vs VoteStorage) GetRewardPool(ctx sdk.Context, voter types.AccountKey) (*RewardPool, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	poolByte := store.Get(GetRewardPoolKey(voter))
+	if poolByte == nil {
+		return nil, ErrRewardPoolNotFound()
+	}
+	pool := new(RewardPool)
+	if err := vs.cdc.UnmarshalJSON(poolByte, pool); err != nil {
+		return nil, ErrFailedToUnmarshalRewardPool(err)
+	}
+	return pool, nil
+}
+
+// SetRewardPool - set reward pool of a voter to KVStore
+func (vs VoteStorage) SetRewardPool(ctx sdk.Context, voter types.AccountKey, pool *RewardPool) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	poolByte, err := vs.cdc.MarshalJSON(*pool)
+	if err != nil {
+		return ErrFailedToMarshalRewardPool(err)
+	}
+	store.Set(GetRewardPoolKey(voter), poolByte)
+	return nil
+}
+
+// DoesDelegatorRewardExist - check if delegator reward exist in KVStore or not
+func (vs VoteStorage) DoesDelegatorRewardExist(ctx sdk.Context, voter, delegator types.AccountKey) bool {
+	store := ctx.KVStore(vs.key)
+	return store.Has(GetDelegatorRewardKey(voter, delegator))
+}
+
+// GetDelegatorReward - get delegator reward from KVStore
+func (vs VoteStorage) GetDelegatorReward(
+	ctx sdk.Context, voter, delegator types.AccountKey) (*DelegatorReward, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	rewardByte := store.Get(GetDelegatorRewardKey(voter, delegator))
+	if rewardByte == nil {
+		return nil, ErrDelegatorRewardNotFound()
+	}
+	reward := new(DelegatorReward)
+	if err := vs.cdc.UnmarshalJSON(rewardByte, reward); err != nil {
+		return nil, ErrFailedToUnmarshalDelegatorReward(err)
+	}
+	return reward, nil
+}
+
+// SetDelegatorReward - set delegator reward to KVStore
+func (vs VoteStorage) SetDelegatorReward(
+	ctx sdk.Context, voter, delegator types.AccountKey, reward *DelegatorReward) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	rewardByte, err := vs.cdc.MarshalJSON(*reward)
+	if err != nil {
+		return ErrFailedToMarshalDelegatorReward(err)
+	}
+	store.Set(GetDelegatorRewardKey(voter, delegator), rewardByte)
+	return nil
+}
+
+// DeleteDelegatorReward - delete delegator reward from KVStore
+func (vs VoteStorage) DeleteDelegatorReward(ctx sdk.Context, voter, delegator types.AccountKey) {
+	store := ctx.KVStore(vs.key)
+	store.Delete(GetDelegatorRewardKey(voter, delegator))
+}
+
 func getDelegationPrefix(me types.AccountKey) []byte {
 	return append(append(delegationSubstore, me...), types.KeySeparator...)
 }
@@ -232,13 +356,14 @@ func GetDelegationKey(me types.AccountKey, myDelegator types.AccountKey) []byte
 	return append(getDelegationPrefix(me), myDelegator...)
 }
 
-func getVotePrefix(id types.ProposalKey) []byte {
+// GetVotePrefix - "vote substore" + "proposalID"
+func GetVotePrefix(id types.ProposalKey) []byte {
 	return append(append(voteSubstore, id...), types.KeySeparator...)
 }
 
 // GetVoteKey - "vote substore" + "proposalID" + "voter"
 func GetVoteKey(proposalID types.ProposalKey, voter types.AccountKey) []byte {
-	return append(getVotePrefix(proposalID), voter...)
+	return append(GetVotePrefix(proposalID), voter...)
 }
 
 // GetVoterKey - "voter substore" + "voter"
@@ -250,12 +375,38 @@ func getReferenceListKey() []byte {
 	return referenceListSubStore
 }
 
-func getDelegateePrefix(me types.AccountKey) []byte {
+// GetDelegateePrefix - "delegatee substore" + "me(delegator)"
+func GetDelegateePrefix(me types.AccountKey) []byte {
 	return append(append(delegateeSubStore, me...), types.KeySeparator...)
 }
 
 func getDelegateeKey(me, delegatee types.AccountKey) []byte {
-	return append(getDelegateePrefix(me), delegatee...)
+	return append(GetDelegateePrefix(me), delegatee...)
+}
+
+// GetRewardPoolKey - "reward pool substore" + "voter"
+func GetRewardPoolKey(voter types.AccountKey) []byte {
+	return append(rewardPoolSubStore, voter...)
+}
+
+// GetDelegatorRewardKey - "delegator reward substore" + "voter" + "delegator"
+func GetDelegatorRewardKey(voter, delegator types.AccountKey) []byte {
+	return append(append(append(delegatorRewardSubStore, voter...), types.KeySeparator...), delegator...)
+}
+
+// GetVoteHistoryPrefix - "vote history substore" + "voter"
+func GetVoteHistoryPrefix(voter types.AccountKey) []byte {
+	return append(append(voteHistorySubStore, voter...), types.KeySeparator...)
+}
+
+// getVoteHistoryKey - "vote history substore" + "voter" + "proposal ID"
+func getVoteHistoryKey(voter types.AccountKey, proposalID types.ProposalKey) []byte {
+	return append(GetVoteHistoryPrefix(voter), getProposalIndex(proposalID)...)
+}
+
+// getProposalIndex - zero padded proposal ID keeps vote history sorted by proposal ID
+func getProposalIndex(proposalID types.ProposalKey) string {
+	return strings.Repeat("0", proposalIndexWidth-len(proposalID)) + string(proposalID)
 }
 
 func subspace(prefix []byte) (start, end []byte) {
@@ -511,4 +511,95 @@ func TestAllDelegation(t *testing.T) {
 			t.Errorf("%s: diff delegators, got %v, want %v", tc.testName, delegators, tc.expectedDelegators)
 		}
 	}
+
+	delegatees, err := vs.GetAllDelegatees(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.AccountKey{user2, user3}, delegatees)
+	delegatees, err = vs.GetAllDelegatees(ctx, user3)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.AccountKey{user1}, delegatees)
+}
+
+func TestRewardPool(t *testing.T) {
+	ctx, vs := setup(t)
+	user := types.AccountKey("user")
+	delegator := types.AccountKey("delegator")
+	pool := RewardPool{
+		CommissionRate: sdk.NewRat(1, 10),
+		RewardPerCoin:  sdk.NewRat(3, 100),
+	}
+	reward := DelegatorReward{
+		RewardPerCoinAt: sdk.NewRat(1, 100),
+		UnclaimedReward: types.NewCoinFromInt64(100),
+	}
+
+	assert.False(t, vs.DoesRewardPoolExist(ctx, user))
+	err := vs.SetRewardPool(ctx, user, &pool)
+	assert.Nil(t, err)
+	assert.True(t, vs.DoesRewardPoolExist(ctx, user))
+	poolPtr, err := vs.GetRewardPool(ctx, user)
+	assert.Nil(t, err)
+	assert.Equal(t, pool, *poolPtr)
+
+	assert.False(t, vs.DoesDelegatorRewardExist(ctx, user, delegator))
+	err = vs.SetDelegatorReward(ctx, user, delegator, &reward)
+	assert.Nil(t, err)
+	rewardPtr, err := vs.GetDelegatorReward(ctx, user, delegator)
+	assert.Nil(t, err)
+	assert.Equal(t, reward, *rewardPtr)
+
+	vs.DeleteDelegatorReward(ctx, user, delegator)
+	_, err = vs.GetDelegatorReward(ctx, user, delegator)
+	assert.Equal(t, ErrDelegatorRewardNotFound(), err)
+}
+
+func getVoteHistory(ctx sdk.Context, voter types.AccountKey) []types.ProposalKey {
+	proposalIDs := []types.ProposalKey{}
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(TestKVStoreKey), GetVoteHistoryPrefix(voter))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		proposalIDs = append([]types.ProposalKey{types.ProposalKey(iter.Value())}, proposalIDs...)
+	}
+	return proposalIDs
+}
+
+func TestVoteHistory(t *testing.T) {
+	ctx, vs := setup(t)
+	user := types.AccountKey("user")
+	other := types.AccountKey("other")
+
+	for _, id := range []types.ProposalKey{"1", "2", "10", "11"} {
+		vs.SetVoteHistory(ctx, user, id)
+	}
+	vs.SetVoteHistory(ctx, other, "3")
+
+	// vote history is ordered by proposal ID from newest
+	assert.Equal(t, []types.ProposalKey{"11", "10", "2", "1"}, getVoteHistory(ctx, user))
+	assert.Equal(t, []types.ProposalKey{"3"}, getVoteHistory(ctx, other))
+
+	vs.DeleteVoteHistory(ctx, user, "10")
+	assert.Equal(t, []types.ProposalKey{"11", "2", "1"}, getVoteHistory(ctx, user))
+}
+
+func TestRebuildVoteHistory(t *testing.T) {
+	ctx, vs := setup(t)
+	user := types.AccountKey("user")
+	other := types.AccountKey("other")
+
+	// votes added before vote history index existed
+	for _, id := range []types.ProposalKey{"1", "2", "10"} {
+		vote := Vote{Voter: user, Result: true}
+		assert.Nil(t, vs.SetVote(ctx, id, user, &vote))
+	}
+	vote := Vote{Voter: other, Result: true}
+	assert.Nil(t, vs.SetVote(ctx, "2", other, &vote))
+	assert.Equal(t, []types.ProposalKey{}, getVoteHistory(ctx, user))
+
+	assert.Nil(t, vs.RebuildVoteHistory(ctx))
+	assert.Equal(t, []types.ProposalKey{"10", "2", "1"}, getVoteHistory(ctx, user))
+	assert.Equal(t, []types.ProposalKey{"2"}, getVoteHistory(ctx, other))
+
+	// rebuild again doesn't duplicate history
+	assert.Nil(t, vs.RebuildVoteHistory(ctx))
+	assert.Equal(t, []types.ProposalKey{"10", "2", "1"}, getVoteHistory(ctx, user))
 }
@@ -2,9 +2,12 @@ package model
 
 import (
 	types "github.com/lino-network/lino/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Voter - a voter in blockchain is account with voter deposit, who can vote for a proposal
+// LastRedelegateAt - last time this voter moved its delegation to another voter
 type Voter struct {
 	Username          types.AccountKey `json:"username"`
 	LinoStake         types.Coin       `json:"lino_stake"`
@@ -12,6 +15,7 @@ type Voter struct {
 	DelegateToOthers  types.Coin       `json:"delegate_to_others"`
 	LastPowerChangeAt int64            `json:"last_power_change_at"`
 	Interest          types.Coin       `json:"interest"`
+	LastRedelegateAt  int64            `json:"last_redelegate_at"`
 }
 
 // Vote - a vote is created by a voter to a proposal
@@ -19,6 +23,8 @@ type Vote struct {
 	Voter       types.AccountKey `json:"voter"`
 	VotingPower types.Coin       `json:"voting_power"`
 	Result      bool             `json:"result"`
+	Abstain     bool             `json:"abstain"`
+	Veto        bool             `json:"veto"`
 }
 
 // Delegation - normal user can delegate money to a voter to increase voter's voting power
@@ -31,3 +37,22 @@ type Delegation struct {
 type ReferenceList struct {
 	AllValidators []types.AccountKey `json:"all_validators"`
 }
+
+// RewardPool - income of a voter shared with its delegators, delegators' share
+// is accounted lazily by accumulated reward per delegated coin
+// CommissionRate - percentage of income kept by the voter
+// RewardPerCoin - accumulated delegators' income per delegated coin
+// CommissionUpdatedAt - last time voter declared commission rate, 0 if never declared
+type RewardPool struct {
+	CommissionRate      sdk.Rat `json:"commission_rate"`
+	RewardPerCoin       sdk.Rat `json:"reward_per_coin"`
+	CommissionUpdatedAt int64   `json:"commission_updated_at"`
+}
+
+// DelegatorReward - reward of a delegator from a voter's reward pool
+// RewardPerCoinAt - reward per coin of the pool when the reward was last settled
+// UnclaimedReward - settled reward which hasn't been claimed
+type DelegatorReward struct {
+	RewardPerCoinAt sdk.Rat    `json:"reward_per_coin_at"`
+	UnclaimedReward types.Coin `json:"unclaimed_reward"`
+}
@@ -13,6 +13,9 @@ var _ types.Msg = StakeInMsg{}
 var _ types.Msg = StakeOutMsg{}
 var _ types.Msg = DelegateMsg{}
 var _ types.Msg = DelegatorWithdrawMsg{}
+var _ types.Msg = RedelegateMsg{}
+var _ types.Msg = UpdateCommissionMsg{}
+var _ types.Msg = ClaimDelegatorRewardMsg{}
 var _ types.Msg = ClaimInterestMsg{}
 
 // StakeInMsg - voter deposit
@@ -41,6 +44,27 @@ type DelegatorWithdrawMsg struct {
 	Amount    types.LNO        `json:"amount"`
 }
 
+// RedelegateMsg - delegator move delegation from one voter to another
+type RedelegateMsg struct {
+	Delegator types.AccountKey `json:"delegator"`
+	SrcVoter  types.AccountKey `json:"src_voter"`
+	DstVoter  types.AccountKey `json:"dst_voter"`
+	Amount    types.LNO        `json:"amount"`
+}
+
+// UpdateCommissionMsg - voter declare percentage of income kept by itself,
+// the rest is shared with delegators
+type UpdateCommissionMsg struct {
+	Username       types.AccountKey `json:"username"`
+	CommissionRate string           `json:"commission_rate"`
+}
+
+// ClaimDelegatorRewardMsg - delegator claim reward shared by a voter
+type ClaimDelegatorRewardMsg struct {
+	Delegator types.AccountKey `json:"delegator"`
+	Voter     types.AccountKey `json:"voter"`
+}
+
 // ClaimInterestMsg - claim interest generated from lino power
 type ClaimInterestMsg struct {
 	Username types.AccountKey `json:"username"`
@@ -262,6 +286,180 @@ func (msg DelegatorWithdrawMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
 
+// NewRedelegateMsg - return RedelegateMsg
+func NewRedelegateMsg(delegator string, srcVoter string, dstVoter string, amount types.LNO) RedelegateMsg {
+	return RedelegateMsg{
+		Delegator: types.AccountKey(delegator),
+		SrcVoter:  types.AccountKey(srcVoter),
+		DstVoter:  types.AccountKey(dstVoter),
+		Amount:    amount,
+	}
+}
+
+// Type - implements sdk.Msg
+func (msg RedelegateMsg) Type() string { return types.VoteRouterName }
+
+// ValidateBasic - implements sdk.Msg
+func (msg RedelegateMsg) ValidateBasic() sdk.Error {
+	if len(msg.Delegator) < types.MinimumUsernameLength ||
+		len(msg.Delegator) > types.MaximumUsernameLength ||
+		len(msg.SrcVoter) < types.MinimumUsernameLength ||
+		len(msg.SrcVoter) > types.MaximumUsernameLength ||
+		len(msg.DstVoter) < types.MinimumUsernameLength ||
+		len(msg.DstVoter) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if msg.SrcVoter == msg.DstVoter {
+		return ErrRedelegateToSameVoter()
+	}
+	if msg.DstVoter == msg.Delegator {
+		return ErrRedelegateToSelf()
+	}
+	_, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg RedelegateMsg) String() string {
+	return fmt.Sprintf(
+		"RedelegateMsg{Delegator:%v, SrcVoter:%v, DstVoter:%v, Amount:%v}",
+		msg.Delegator, msg.SrcVoter, msg.DstVoter, msg.Amount)
+}
+
+// GetPermission - implements types.Msg
+func (msg RedelegateMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg RedelegateMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg RedelegateMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Delegator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg RedelegateMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// NewUpdateCommissionMsg - return UpdateCommissionMsg
+func NewUpdateCommissionMsg(username string, commissionRate string) UpdateCommissionMsg {
+	return UpdateCommissionMsg{
+		Username:       types.AccountKey(username),
+		CommissionRate: commissionRate,
+	}
+}
+
+// Type - implements sdk.Msg
+func (msg UpdateCommissionMsg) Type() string { return types.VoteRouterName }
+
+// ValidateBasic - implements sdk.Msg
+func (msg UpdateCommissionMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if len(msg.CommissionRate) > types.MaximumSdkRatLength {
+		return ErrInvalidCommissionRate()
+	}
+	commissionRate, err := sdk.NewRatFromDecimal(msg.CommissionRate, types.NewRatFromDecimalPrecision)
+	if err != nil {
+		return err
+	}
+	if commissionRate.LT(sdk.ZeroRat()) || commissionRate.GT(sdk.OneRat()) {
+		return ErrInvalidCommissionRate()
+	}
+	return nil
+}
+
+func (msg UpdateCommissionMsg) String() string {
+	return fmt.Sprintf("UpdateCommissionMsg{Username:%v, CommissionRate:%v}", msg.Username, msg.CommissionRate)
+}
+
+// GetPermission - implements types.Msg
+func (msg UpdateCommissionMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg UpdateCommissionMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg UpdateCommissionMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg UpdateCommissionMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// NewClaimDelegatorRewardMsg - return ClaimDelegatorRewardMsg
+func NewClaimDelegatorRewardMsg(delegator string, voter string) ClaimDelegatorRewardMsg {
+	return ClaimDelegatorRewardMsg{
+		Delegator: types.AccountKey(delegator),
+		Voter:     types.AccountKey(voter),
+	}
+}
+
+// Type - implements sdk.Msg
+func (msg ClaimDelegatorRewardMsg) Type() string { return types.VoteRouterName }
+
+// ValidateBasic - implements sdk.Msg
+func (msg ClaimDelegatorRewardMsg) ValidateBasic() sdk.Error {
+	if len(msg.Delegator) < types.MinimumUsernameLength ||
+		len(msg.Delegator) > types.MaximumUsernameLength ||
+		len(msg.Voter) < types.MinimumUsernameLength ||
+		len(msg.Voter) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	return nil
+}
+
+func (msg ClaimDelegatorRewardMsg) String() string {
+	return fmt.Sprintf("ClaimDelegatorRewardMsg{Delegator:%v, Voter:%v}", msg.Delegator, msg.Voter)
+}
+
+// GetPermission - implements types.Msg
+func (msg ClaimDelegatorRewardMsg) GetPermission() types.Permission {
+	return types.AppPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg ClaimDelegatorRewardMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg ClaimDelegatorRewardMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Delegator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ClaimDelegatorRewardMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
 // NewClaimInterestMsg - return a ClaimInterestMsg
 func NewClaimInterestMsg(username string) ClaimInterestMsg {
 	return ClaimInterestMsg{
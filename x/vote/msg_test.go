@@ -177,6 +177,114 @@ func TestDelegatorWithdrawMsg(t *testing.T) {
 	}
 }
 
+func TestRedelegateMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		redelegateMsg RedelegateMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			redelegateMsg: NewRedelegateMsg("user1", "user2", "user3", "1"),
+			expectedError: nil,
+		},
+		{
+			testName:      "invalid username",
+			redelegateMsg: NewRedelegateMsg("user1", "user2", "", "1"),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "redelegate to same voter",
+			redelegateMsg: NewRedelegateMsg("user1", "user2", "user2", "1"),
+			expectedError: ErrRedelegateToSameVoter(),
+		},
+		{
+			testName:      "redelegate to delegator itself",
+			redelegateMsg: NewRedelegateMsg("user1", "user2", "user1", "1"),
+			expectedError: ErrRedelegateToSelf(),
+		},
+		{
+			testName:      "invalid redelegate amount",
+			redelegateMsg: NewRedelegateMsg("user1", "user2", "user3", "-1"),
+			expectedError: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.redelegateMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, expect %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestUpdateCommissionMsg(t *testing.T) {
+	testCases := []struct {
+		testName            string
+		updateCommissionMsg UpdateCommissionMsg
+		expectedError       sdk.Error
+	}{
+		{
+			testName:            "normal case",
+			updateCommissionMsg: NewUpdateCommissionMsg("user1", "0.1"),
+			expectedError:       nil,
+		},
+		{
+			testName:            "invalid username",
+			updateCommissionMsg: NewUpdateCommissionMsg("", "0.1"),
+			expectedError:       ErrInvalidUsername(),
+		},
+		{
+			testName:            "commission rate larger than 1",
+			updateCommissionMsg: NewUpdateCommissionMsg("user1", "1.1"),
+			expectedError:       ErrInvalidCommissionRate(),
+		},
+		{
+			testName:            "negative commission rate",
+			updateCommissionMsg: NewUpdateCommissionMsg("user1", "-0.1"),
+			expectedError:       ErrInvalidCommissionRate(),
+		},
+		{
+			testName:            "commission rate is too long",
+			updateCommissionMsg: NewUpdateCommissionMsg("user1", "0.1000000000"),
+			expectedError:       ErrInvalidCommissionRate(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.updateCommissionMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, expect %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestClaimDelegatorRewardMsg(t *testing.T) {
+	testCases := []struct {
+		testName                string
+		claimDelegatorRewardMsg ClaimDelegatorRewardMsg
+		expectedError           sdk.Error
+	}{
+		{
+			testName:                "normal case",
+			claimDelegatorRewardMsg: NewClaimDelegatorRewardMsg("user1", "user2"),
+			expectedError:           nil,
+		},
+		{
+			testName:                "invalid voter",
+			claimDelegatorRewardMsg: NewClaimDelegatorRewardMsg("user1", ""),
+			expectedError:           ErrInvalidUsername(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.claimDelegatorRewardMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, expect %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestMsgPermission(t *testing.T) {
 	testCases := []struct {
 		testName           string
@@ -203,6 +311,21 @@ func TestMsgPermission(t *testing.T) {
 			msg:                NewDelegatorWithdrawMsg("delegator", "voter", types.LNO("1")),
 			expectedPermission: types.TransactionPermission,
 		},
+		{
+			testName:           "redelegate",
+			msg:                NewRedelegateMsg("delegator", "voter", "voter2", types.LNO("1")),
+			expectedPermission: types.TransactionPermission,
+		},
+		{
+			testName:           "update commission",
+			msg:                NewUpdateCommissionMsg("voter", "0.1"),
+			expectedPermission: types.TransactionPermission,
+		},
+		{
+			testName:           "claim delegator reward",
+			msg:                NewClaimDelegatorRewardMsg("delegator", "voter"),
+			expectedPermission: types.AppPermission,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -234,6 +357,10 @@ func TestGetSignBytes(t *testing.T) {
 			testName: "delegate withdraw",
 			msg:      NewDelegatorWithdrawMsg("delegator", "voter", types.LNO("1")),
 		},
+		{
+			testName: "redelegate",
+			msg:      NewRedelegateMsg("delegator", "voter", "voter2", types.LNO("1")),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -267,6 +394,11 @@ func TestGetSigners(t *testing.T) {
 			msg:           NewDelegatorWithdrawMsg("delegator", "voter", types.LNO("1")),
 			expectSigners: []types.AccountKey{"delegator"},
 		},
+		{
+			testName:      "redelegate",
+			msg:           NewRedelegateMsg("delegator", "voter", "voter2", types.LNO("1")),
+			expectSigners: []types.AccountKey{"delegator"},
+		},
 	}
 
 	for _, tc := range testCases {
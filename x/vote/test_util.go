@@ -9,6 +9,7 @@ import (
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/global"
+	"github.com/lino-network/lino/x/vote/model"
 	"github.com/stretchr/testify/assert"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/libs/log"
@@ -81,3 +82,13 @@ func coinToString(coin types.Coin) string {
 	coinInInt64, _ := coin.ToInt64()
 	return strconv.FormatInt(coinInInt64/types.Decimals, 10)
 }
+
+func getVoteHistory(ctx sdk.Context, voter types.AccountKey) []types.ProposalKey {
+	proposalIDs := []types.ProposalKey{}
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(testVoteKVStoreKey), model.GetVoteHistoryPrefix(voter))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		proposalIDs = append([]types.ProposalKey{types.ProposalKey(iter.Value())}, proposalIDs...)
+	}
+	return proposalIDs
+}
@@ -10,6 +10,9 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(StakeOutMsg{}, "lino/stakeOut", nil)
 	cdc.RegisterConcrete(DelegateMsg{}, "lino/delegate", nil)
 	cdc.RegisterConcrete(DelegatorWithdrawMsg{}, "lino/delegateWithdraw", nil)
+	cdc.RegisterConcrete(RedelegateMsg{}, "lino/redelegate", nil)
+	cdc.RegisterConcrete(UpdateCommissionMsg{}, "lino/updateCommission", nil)
+	cdc.RegisterConcrete(ClaimDelegatorRewardMsg{}, "lino/claimDelegatorReward", nil)
 	cdc.RegisterConcrete(ClaimInterestMsg{}, "lino/claimInterest", nil)
 }
 